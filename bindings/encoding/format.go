@@ -0,0 +1,38 @@
+package encoding
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+// FormatBlockMetadata renders all fields of the given block metadata in a human-readable form.
+func FormatBlockMetadata(meta *bindings.TaikoDataBlockMetadata) string {
+	var (
+		b         strings.Builder
+		extraData = bytes.TrimRight(meta.ExtraData[:], "\x00")
+		field     = func(name string, value interface{}) { fmt.Fprintf(&b, "%-15s %v\n", name+":", value) }
+	)
+
+	field("ID", meta.Id)
+	field("L1Height", meta.L1Height)
+	field("L1Hash", common.Hash(meta.L1Hash))
+	field("Timestamp", fmt.Sprintf("%d (%s)", meta.Timestamp, time.Unix(int64(meta.Timestamp), 0).UTC()))
+	field("Coinbase", meta.Coinbase)
+	field("Sender", meta.Sender)
+	field("GasLimit", meta.GasLimit)
+	field("MinTier", meta.MinTier)
+	field("BlobUsed", meta.BlobUsed)
+	field("BlobHash", common.Hash(meta.BlobHash))
+	field("Difficulty", common.Hash(meta.Difficulty))
+	field("DepositsHash", common.Hash(meta.DepositsHash))
+	field("ParentMetaHash", common.Hash(meta.ParentMetaHash))
+	field("ExtraData", fmt.Sprintf("%q (%s)", extraData, common.Hash(meta.ExtraData)))
+
+	return b.String()
+}
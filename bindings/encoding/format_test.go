@@ -0,0 +1,43 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+func TestFormatBlockMetadata(t *testing.T) {
+	meta := &bindings.TaikoDataBlockMetadata{
+		L1Hash:    common.HexToHash("0x01"),
+		BlobHash:  common.HexToHash("0x0102"),
+		Coinbase:  common.HexToAddress("0x03"),
+		Id:        1024,
+		GasLimit:  15_000_000,
+		Timestamp: 1700000000,
+		L1Height:  2048,
+		MinTier:   TierSgxID,
+		BlobUsed:  true,
+		Sender:    common.HexToAddress("0x04"),
+	}
+	copy(meta.ExtraData[:], "test")
+
+	formatted := FormatBlockMetadata(meta)
+	for _, expected := range []string{
+		"ID:             1024\n",
+		"L1Height:       2048\n",
+		"L1Hash:         " + common.HexToHash("0x01").Hex() + "\n",
+		"Timestamp:      1700000000 (2023-11-14 22:13:20 +0000 UTC)\n",
+		"Coinbase:       " + common.HexToAddress("0x03").Hex() + "\n",
+		"Sender:         " + common.HexToAddress("0x04").Hex() + "\n",
+		"GasLimit:       15000000\n",
+		"MinTier:        200\n",
+		"BlobUsed:       true\n",
+		"BlobHash:       " + common.HexToHash("0x0102").Hex() + "\n",
+		"ExtraData:      \"test\"",
+	} {
+		require.Contains(t, formatted, expected)
+	}
+}
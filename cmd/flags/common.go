@@ -23,6 +23,25 @@ var (
 		Required: true,
 		Category: commonCategory,
 	}
+	L1FailoverWSEndpoints = &cli.StringSliceFlag{
+		Name: "l1.failover.ws",
+		Usage: "Comma separated websocket RPC endpoints of redundant L1 ethereum nodes, failed over to in order " +
+			"when --l1.ws errors or goes stale",
+		Category: commonCategory,
+	}
+	L1FailoverMaxHeadLag = &cli.Uint64Flag{
+		Name: "l1.failover.maxHeadLag",
+		Usage: "Maximum number of blocks the active L1 endpoint's head can lag behind the other L1 endpoints " +
+			"before failing over, 0 means never failing over for the head lag",
+		Category: commonCategory,
+		Value:    3,
+	}
+	L1FailoverCheckInterval = &cli.DurationFlag{
+		Name:     "l1.failover.checkInterval",
+		Usage:    "Interval to check the health of the redundant L1 endpoints",
+		Category: commonCategory,
+		Value:    12 * time.Second,
+	}
 	L2WSEndpoint = &cli.StringFlag{
 		Name:     "l2.ws",
 		Usage:    "Websocket RPC endpoint of a L2 taiko-geth execution engine",
@@ -40,6 +59,25 @@ var (
 		Usage:    "HTTP RPC endpoint of a L1 beacon node",
 		Category: commonCategory,
 	}
+	L1BeaconSidecarsPath = &cli.StringFlag{
+		Name: "l1.beacon.sidecarsPath",
+		Usage: "Path template of the L1 beacon node's blob sidecars API, relative to --l1.beacon, " +
+			"where {slot} is replaced with the requested slot",
+		Value:    "eth/v1/beacon/blob_sidecars/{slot}",
+		Category: commonCategory,
+	}
+	L1BeaconMaxConcurrentFetches = &cli.Uint64Flag{
+		Name:     "l1.beacon.maxConcurrentFetches",
+		Usage:    "Maximum number of concurrent blob fetches to each L1 beacon endpoint, 0 means no limit",
+		Value:    0,
+		Category: commonCategory,
+	}
+	L1BeaconFallback = &cli.StringFlag{
+		Name: "l1.beacon.fallback",
+		Usage: "HTTP endpoint of a secondary blob sidecars source serving the beacon blob sidecars API, " +
+			"e.g. a blob archive or a second beacon node, tried when --l1.beacon fails to serve a blob",
+		Category: commonCategory,
+	}
 	L2HTTPEndpoint = &cli.StringFlag{
 		Name:     "l2.http",
 		Usage:    "HTTP RPC endpoint of a L2 taiko-geth execution engine",
@@ -120,8 +158,117 @@ var (
 		Category: commonCategory,
 		Value:    1 * time.Minute,
 	}
+	ShutdownGracePeriod = &cli.DurationFlag{
+		Name: "shutdownGracePeriod",
+		Usage: "Maximum time to wait for the in-flight proposals or proofs to finish on shutdown, no new work " +
+			"is accepted meanwhile, 0 means shutting down immediately",
+		Category: commonCategory,
+		Value:    0,
+	}
+	ProtocolPausedPollInterval = &cli.DurationFlag{
+		Name: "l1.pausedPollInterval",
+		Usage: "Interval to poll the TaikoL1 contract's paused state, if set, no transactions will be sent " +
+			"while the contract is paused until it is unpaused, instead of repeatedly reverting",
+		Category: commonCategory,
+		Value:    0,
+	}
+	NonceStoreFile = &cli.StringFlag{
+		Name: "tx.nonceStoreFile",
+		Usage: "Path of a file to persist the transaction senders' nonces across restarts, on startup the higher " +
+			"of the persisted and the pending nonces is adopted, empty means only tracking the nonces in memory",
+		Category: commonCategory,
+	}
+	StuckTxTimeout = &cli.DurationFlag{
+		Name: "tx.stuckTimeout",
+		Usage: "Time after which a pending transaction is considered stuck, and replaced with a higher gas fee " +
+			"at the same nonce, 0 means never replacing the stuck transactions",
+		Category: commonCategory,
+		Value:    0,
+	}
+	StuckTxGasBumpRate = &cli.Uint64Flag{
+		Name:     "tx.stuckGasBumpRate",
+		Usage:    "Gas fee rate in percent to bump a stuck transaction by when replacing it",
+		Category: commonCategory,
+		Value:    10,
+	}
+	GasLimitMargin = &cli.Uint64Flag{
+		Name: "tx.gasLimitMargin",
+		Usage: "Safety margin rate in percent added to the estimated gas limits of the transactions, " +
+			"20 means 1.2x the estimation, 0 means no margin",
+		Category: commonCategory,
+		Value:    0,
+	}
+	GasLimitMarginCap = &cli.Uint64Flag{
+		Name:     "tx.gasLimitMarginCap",
+		Usage:    "Maximum gas limit after adding the safety margin, 0 means no cap",
+		Category: commonCategory,
+		Value:    0,
+	}
+	MaxTxsPerSecond = &cli.Uint64Flag{
+		Name: "tx.maxPerSecond",
+		Usage: "Maximum number of new transactions sent per second by each account, the transactions " +
+			"exceeding the rate are delayed instead of dropped, 0 means no limit",
+		Category: commonCategory,
+		Value:    0,
+	}
+	HealthMaxL1HeadAge = &cli.DurationFlag{
+		Name:     "health.maxL1HeadAge",
+		Usage:    "Maximum age of the L1 head block before the L1 connection is reported unhealthy, 0 means no limit",
+		Category: commonCategory,
+		Value:    2 * time.Minute,
+	}
+	HealthMaxL2BlockLag = &cli.Uint64Flag{
+		Name: "health.maxL2BlockLag",
+		Usage: "Maximum number of blocks the L2 head can lag behind the latest proposed block before the L2 " +
+			"connection is reported unhealthy, 0 means no limit",
+		Category: commonCategory,
+		Value:    32,
+	}
+	HealthMaxBeaconSyncDistance = &cli.Uint64Flag{
+		Name: "health.maxBeaconSyncDistance",
+		Usage: "Maximum sync distance in slots of the L1 beacon node before the L1 beacon connection is " +
+			"reported unhealthy, 0 means no limit",
+		Category: commonCategory,
+		Value:    8,
+	}
+	InspectBlockID = &cli.Uint64Flag{
+		Name:     "id",
+		Usage:    "ID of the L2 block to inspect",
+		Required: true,
+	}
+	BlobDecodeSlot = &cli.Uint64Flag{
+		Name:     "slot",
+		Usage:    "L1 slot of the blob sidecars to decode",
+		Required: true,
+	}
+	BlobDecodeHash = &cli.StringFlag{
+		Name:  "blobHash",
+		Usage: "Versioned hash of the blob to decode, all blobs in the slot are decoded if not set",
+	}
+	BlobDecodeOutput = &cli.StringFlag{
+		Name:  "output",
+		Usage: "File to write the decoded transactions lists bytes to, printed as hex if not set",
+	}
 )
 
+// InspectBlockFlags All `inspect-block` flags.
+var InspectBlockFlags = []cli.Flag{
+	L1WSEndpoint,
+	TaikoL1Address,
+	RPCTimeout,
+	InspectBlockID,
+}
+
+// BlobDecodeFlags All `blob-decode` flags.
+var BlobDecodeFlags = []cli.Flag{
+	L1BeaconEndpoint,
+	L1BeaconSidecarsPath,
+	RPCTimeout,
+	BlobDecodeSlot,
+	BlobDecodeHash,
+	BlobDecodeOutput,
+}
+
 // CommonFlags All common flags.
 var CommonFlags = []cli.Flag{
 	// Required
@@ -138,6 +285,13 @@ var CommonFlags = []cli.Flag{
 	BackOffRetryInterval,
 	RPCTimeout,
 	WaitReceiptTimeout,
+	ShutdownGracePeriod,
+	L1FailoverWSEndpoints,
+	L1FailoverMaxHeadLag,
+	L1FailoverCheckInterval,
+	HealthMaxL1HeadAge,
+	HealthMaxL2BlockLag,
+	HealthMaxBeaconSyncDistance,
 }
 
 // MergeFlags merges the given flag slices.
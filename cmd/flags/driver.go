@@ -43,15 +43,67 @@ var (
 		Usage:    "HTTP RPC endpoint of another synced L2 execution engine node",
 		Category: driverCategory,
 	}
+	SkipGenesisCheck = &cli.BoolFlag{
+		Name: "l2.skipGenesisCheck",
+		Usage: "Skip checking the L2 execution engine's genesis block against the genesis committed in the " +
+			"TaikoL1 contract on startup, only for the local development",
+		Value:    false,
+		Category: driverCategory,
+	}
+	MaxBlobTxListBytes = &cli.Uint64Flag{
+		Name: "blob.maxTxListBytes",
+		Usage: "Maximum size of a transactions list decoded from a blob, blobs exceeding it will be treated " +
+			"as invalid, 0 means no limit",
+		Value:    0,
+		Category: driverCategory,
+	}
+	MaxBlobTxs = &cli.Uint64Flag{
+		Name: "blob.maxTxs",
+		Usage: "Maximum number of transactions in a transactions list decoded from a blob, blobs exceeding it " +
+			"will be treated as invalid, 0 means no limit",
+		Value:    0,
+		Category: driverCategory,
+	}
+	MultiBlobTxList = &cli.BoolFlag{
+		Name: "blob.multiBlobTxList",
+		Usage: "Decode a block's transactions list from the proposing transaction's blobs starting at the block's " +
+			"blob hash, concatenated in order, instead of a single blob. It changes the derived blocks, so all " +
+			"nodes of a network must set it the same way",
+		Value:    false,
+		Category: driverCategory,
+	}
+	TxListCacheSize = &cli.Uint64Flag{
+		Name: "txList.cacheSize",
+		Usage: "Number of the latest fetched transactions lists cached, so the re-processed BlockProposed events " +
+			"won't fetch and decode them again, 0 disables the cache",
+		Value:    128,
+		Category: driverCategory,
+	}
+	ChainIDCheckSamples = &cli.Uint64Flag{
+		Name: "txList.chainIDCheckSamples",
+		Usage: "Number of the decoded transactions sampled from each transactions list to check their chain ID, " +
+			"transactions lists carrying another chain ID will be reported, 0 means no check",
+		Value:    0,
+		Category: driverCategory,
+	}
 )
 
 // DriverFlags All driver flags.
 var DriverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	L1BeaconEndpoint,
+	L1BeaconSidecarsPath,
+	L1BeaconMaxConcurrentFetches,
+	L1BeaconFallback,
 	L2WSEndpoint,
 	L2AuthEndpoint,
 	JWTSecret,
 	P2PSyncVerifiedBlocks,
 	P2PSyncTimeout,
 	CheckPointSyncURL,
+	SkipGenesisCheck,
+	MaxBlobTxListBytes,
+	MaxBlobTxs,
+	MultiBlobTxList,
+	TxListCacheSize,
+	ChainIDCheckSamples,
 })
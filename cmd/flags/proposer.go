@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-client/internal/version"
@@ -9,9 +11,20 @@ import (
 // Required flags used by proposer.
 var (
 	L1ProposerPrivKey = &cli.StringFlag{
-		Name:     "l1.proposerPrivKey",
-		Usage:    "Private key of the L1 proposer, who will send TaikoL1.proposeBlock transactions",
-		Required: true,
+		Name: "l1.proposerPrivKey",
+		Usage: "Private key of the L1 proposer, who will send TaikoL1.proposeBlock transactions, " +
+			"required unless --l1.proposerSigner is set",
+		Category: proposerCategory,
+	}
+	L1ProposerSigner = &cli.StringFlag{
+		Name: "l1.proposerSigner",
+		Usage: "Endpoint of an external signer serving `eth_signTransaction`, which signs the " +
+			"TaikoL1.proposeBlock transactions instead of --l1.proposerPrivKey, so the key is never held in process",
+		Category: proposerCategory,
+	}
+	L1ProposerSignerAddress = &cli.StringFlag{
+		Name:     "l1.proposerSignerAddress",
+		Usage:    "Address of the L1 proposer account held by --l1.proposerSigner",
 		Category: proposerCategory,
 	}
 	ProverEndpoints = &cli.StringFlag{
@@ -36,6 +49,24 @@ var (
 
 // Optional flags used by proposer.
 var (
+	L1ProposerPrivKeys = &cli.StringFlag{
+		Name: "l1.proposerPrivKeys",
+		Usage: "Comma-delineated list of additional private keys of L1 proposers, rotated with " +
+			"--l1.proposerPrivKey per proposal, each key tracks its own nonce",
+		Category: proposerCategory,
+	}
+	ProverEndpointFailureThreshold = &cli.Uint64Flag{
+		Name: "proverEndpoints.failureThreshold",
+		Usage: "Number of consecutive failures before a prover endpoint is temporarily removed from the rotation, " +
+			"0 means never removed",
+		Category: proposerCategory,
+	}
+	ProverEndpointCooldown = &cli.DurationFlag{
+		Name:     "proverEndpoints.cooldown",
+		Usage:    "Cooldown before a prover endpoint removed from the rotation is added back",
+		Value:    1 * time.Minute,
+		Category: proposerCategory,
+	}
 	// Tier fee related.
 	OptimisticTierFee = &cli.Uint64Flag{
 		Name:     "tierFee.optimistic",
@@ -59,6 +90,23 @@ var (
 		Category: proposerCategory,
 		Value:    3,
 	}
+	AdaptiveTierFee = &cli.BoolFlag{
+		Name: "tierFee.adaptive",
+		Usage: "Raise the initial tier fees by the price bump percentage once the recent assignments repeatedly " +
+			"required the max price bumps, and relax them once an assignment succeeds at the initial tier fees",
+		Value:    false,
+		Category: proposerCategory,
+	}
+	OptimisticTierFeeFloor = &cli.Uint64Flag{
+		Name:     "tierFee.floor.optimistic",
+		Usage:    "Minimum tier fee (in wei) offered to prover for an optimistic proof, 0 means no floor",
+		Category: proposerCategory,
+	}
+	SgxTierFeeFloor = &cli.Uint64Flag{
+		Name:     "tierFee.floor.sgx",
+		Usage:    "Minimum tier fee (in wei) offered to prover for a SGX proof, 0 means no floor",
+		Category: proposerCategory,
+	}
 	// Proposing epoch related.
 	ProposeInterval = &cli.DurationFlag{
 		Name:     "epoch.interval",
@@ -91,12 +139,39 @@ var (
 		Value:    false,
 		Category: proposerCategory,
 	}
+	TxListsAssemblyDeadline = &cli.DurationFlag{
+		Name: "txpool.assemblyDeadline",
+		Usage: "Maximum time to spend assembling the transactions lists in a proposing epoch, " +
+			"the lists assembled so far will be proposed when it is reached, 0 means no deadline",
+		Value:    0,
+		Category: proposerCategory,
+	}
 	MaxProposedTxListsPerEpoch = &cli.Uint64Flag{
 		Name:     "txpool.maxTxListsPerEpoch",
 		Usage:    "Maximum number of transaction lists which will be proposed inside one proposing epoch",
 		Value:    1,
 		Category: proposerCategory,
 	}
+	MinTxListBytes = &cli.Uint64Flag{
+		Name: "txpool.minTxListBytes",
+		Usage: "Minimum size (in bytes) of the transactions lists proposed inside one proposing epoch, smaller " +
+			"ones are deferred until enough transactions are accumulated or --txpool.minTxListMaxWait elapses, " +
+			"0 means no minimum size",
+		Category: proposerCategory,
+	}
+	MinTxListMaxWait = &cli.DurationFlag{
+		Name: "txpool.minTxListMaxWait",
+		Usage: "Maximum time to defer proposing the transactions lists smaller than --txpool.minTxListBytes, " +
+			"must be positive when --txpool.minTxListBytes is set",
+		Value:    1 * time.Minute,
+		Category: proposerCategory,
+	}
+	AnchorGasLimit = &cli.Uint64Flag{
+		Name:     "txpool.anchorGasLimit",
+		Usage:    "Gas limit reserved for the anchor transaction when assembling transaction lists",
+		Value:    0,
+		Category: proposerCategory,
+	}
 	// Transaction related.
 	ProposeBlockTxGasLimit = &cli.Uint64Flag{
 		Name:     "tx.gasLimit",
@@ -125,12 +200,70 @@ var (
 		Usage: "Send EIP-4844 blob transactions when proposing blocks",
 		Value: false,
 	}
+	ProposeMode = &cli.StringFlag{
+		Name: "l1.proposeMode",
+		Usage: "How to save the txList bytes when proposing blocks: calldata, blob or economic (the cheaper one " +
+			"under the current L1 fee conditions), defaults to blob if --l1.blobAllowed is set, otherwise calldata",
+		Category: proposerCategory,
+	}
+	CheckBlobAvailability = &cli.BoolFlag{
+		Name: "l1.checkBlobAvailability",
+		Usage: "Check whether the L1 node's blob pool will accept the blobs before sending a blob proposal, " +
+			"and fall back to calldata if not, so the drivers can always retrieve the txList",
+		Value:    false,
+		Category: proposerCategory,
+	}
 	L1BlockBuilderTip = &cli.Uint64Flag{
 		Name:     "l1.blockBuilderTip",
 		Usage:    "Amount you wish to tip the L1 block builder",
 		Value:    0,
 		Category: proposerCategory,
 	}
+	CheckProposerBond = &cli.BoolFlag{
+		Name: "l1.checkProposerBond",
+		Usage: "Check the proposer's Taiko token balance and allowance against the liveness bond before " +
+			"proposing, and skip the proposing epoch instead of sending a transaction which will revert",
+		Value:    false,
+		Category: proposerCategory,
+	}
+	ReceiptPollInterval = &cli.DurationFlag{
+		Name:     "l1.receiptPollInterval",
+		Usage:    "Interval to poll the receipts of the proposing transactions, within --rpc.waitReceiptTimeout",
+		Value:    3 * time.Second,
+		Category: proposerCategory,
+	}
+	MinProposalGap = &cli.DurationFlag{
+		Name: "epoch.minProposalGap",
+		Usage: "Minimum time between two consecutive proposals, enforced regardless of the other proposing " +
+			"triggers, including the empty block heartbeats",
+		Category: proposerCategory,
+		Value:    0,
+	}
+	MaxL1BaseFee = &cli.Uint64Flag{
+		Name: "l1.maxBaseFee",
+		Usage: "Maximum L1 base fee (in wei) to propose blocks with, proposing is deferred during a L1 base fee " +
+			"spike above this value, except the empty block heartbeats",
+		Category: proposerCategory,
+	}
+	SoftPauseBaseFee = &cli.Uint64Flag{
+		Name: "l1.softPauseBaseFee",
+		Usage: "L1 base fee (in wei) above which the proposer slows down, the proposing interval is doubled on " +
+			"each epoch until the L1 base fee subsides",
+		Category: proposerCategory,
+	}
+	SoftPauseMaxInterval = &cli.DurationFlag{
+		Name:     "l1.softPauseMaxInterval",
+		Usage:    "Maximum proposing interval during a L1 congestion soft-pause, 0 means no maximum",
+		Value:    10 * time.Minute,
+		Category: proposerCategory,
+	}
+	ForcePropose = &cli.BoolFlag{
+		Name: "l1.forcePropose",
+		Usage: "Propose blocks regardless of the L1 base fee, overriding --l1.maxBaseFee and " +
+			"--l1.softPauseBaseFee",
+		Value:    false,
+		Category: proposerCategory,
+	}
 )
 
 // ProposerFlags All proposer flags.
@@ -138,6 +271,8 @@ var ProposerFlags = MergeFlags(CommonFlags, []cli.Flag{
 	L2HTTPEndpoint,
 	TaikoTokenAddress,
 	L1ProposerPrivKey,
+	L1ProposerSigner,
+	L1ProposerSignerAddress,
 	L2SuggestedFeeRecipient,
 	ProposeInterval,
 	TxPoolLocals,
@@ -145,16 +280,42 @@ var ProposerFlags = MergeFlags(CommonFlags, []cli.Flag{
 	ExtraData,
 	ProposeEmptyBlocksInterval,
 	MaxProposedTxListsPerEpoch,
+	MinTxListBytes,
+	MinTxListMaxWait,
+	TxListsAssemblyDeadline,
+	AnchorGasLimit,
 	ProposeBlockTxGasLimit,
 	ProposeBlockTxReplacementMultiplier,
 	ProposeBlockTxGasTipCap,
 	ProverEndpoints,
+	L1ProposerPrivKeys,
+	ProverEndpointFailureThreshold,
+	ProverEndpointCooldown,
 	OptimisticTierFee,
 	SgxTierFee,
 	TierFeePriceBump,
 	MaxTierFeePriceBumps,
+	AdaptiveTierFee,
+	OptimisticTierFeeFloor,
+	SgxTierFeeFloor,
 	ProposeBlockIncludeParentMetaHash,
 	ProposerAssignmentHookAddress,
 	BlobAllowed,
+	ProposeMode,
+	CheckBlobAvailability,
 	L1BlockBuilderTip,
+	CheckProposerBond,
+	MaxL1BaseFee,
+	SoftPauseBaseFee,
+	SoftPauseMaxInterval,
+	ForcePropose,
+	ReceiptPollInterval,
+	MinProposalGap,
+	ProtocolPausedPollInterval,
+	NonceStoreFile,
+	StuckTxTimeout,
+	StuckTxGasBumpRate,
+	GasLimitMargin,
+	GasLimitMarginCap,
+	MaxTxsPerSecond,
 })
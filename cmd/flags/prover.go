@@ -14,6 +14,12 @@ var (
 		Required: true,
 		Category: proverCategory,
 	}
+	L1ProverSigner = &cli.StringFlag{
+		Name: "l1.proverSigner",
+		Usage: "Endpoint of an external signer serving `eth_signTransaction`, which signs the " +
+			"TaikoL1.proveBlock transactions for the --l1.proverPrivKey account",
+		Category: proverCategory,
+	}
 	ProverCapacity = &cli.Uint64Flag{
 		Name:     "prover.capacity",
 		Usage:    "Capacity of prover",
@@ -35,6 +41,23 @@ var (
 		Usage:    "RPC endpoint of a Raiko host service",
 		Category: proverCategory,
 	}
+	ZKProverEndpoint = &cli.StringFlag{
+		Name:     "zk.endpoint",
+		Usage:    "HTTP endpoint of a ZK proving service, required to prove the SGX + zkVM tier blocks",
+		Category: proverCategory,
+	}
+	ZKRequestTimeout = &cli.DurationFlag{
+		Name:     "zk.requestTimeout",
+		Usage:    "Timeout of each request to the ZK proving service, 0 means no timeout",
+		Category: proverCategory,
+		Value:    1 * time.Minute,
+	}
+	ZKPollingInterval = &cli.DurationFlag{
+		Name:     "zk.pollingInterval",
+		Usage:    "Interval between the polls of a ZK proof which is still generating",
+		Category: proverCategory,
+		Value:    10 * time.Second,
+	}
 	StartingBlockID = &cli.Uint64Flag{
 		Name:     "prover.startingBlockID",
 		Usage:    "If set, prover will start proving blocks from the block with this ID",
@@ -65,6 +88,130 @@ var (
 		Category: proverCategory,
 		Value:    0,
 	}
+	AssignmentWarmupMaxSyncLag = &cli.Uint64Flag{
+		Name: "assignment.warmupMaxSyncLag",
+		Usage: "Refuse the proof assignments with 503 after starting, until the L2 execution engine's sync lag " +
+			"falls within this number of blocks, no warmup if not set",
+		Category: proverCategory,
+	}
+	MaxSyncLag = &cli.Uint64Flag{
+		Name: "prover.maxSyncLag",
+		Usage: "Maximum number of L2 blocks the L2 execution engine can fall behind the protocol state, " +
+			"the proof production will be paused until it catches up, 0 means no limit",
+		Value:    0,
+		Category: proverCategory,
+	}
+	MaxProverReorgDepth = &cli.Uint64Flag{
+		Name: "prover.maxReorgDepth",
+		Usage: "Maximum number of handled L2 blocks a reorg can roll back, the prover halts with an alert " +
+			"instead of reconciling a deeper reorg, 0 means no limit",
+		Value:    0,
+		Category: proverCategory,
+	}
+	LeaseFile = &cli.StringFlag{
+		Name: "prover.leaseFile",
+		Usage: "Path of a lease file on a shared file system, for running standby provers in a HA setup, " +
+			"only the prover holding the lease is active, empty means always active",
+		Category: proverCategory,
+	}
+	LeaseTTL = &cli.DurationFlag{
+		Name:     "prover.leaseTTL",
+		Usage:    "Time to live of the prover lease, a standby prover takes over once the active one fails to renew it",
+		Value:    30 * time.Second,
+		Category: proverCategory,
+	}
+	AccountingSnapshotFile = &cli.StringFlag{
+		Name: "prover.accountingSnapshotFile",
+		Usage: "Path of the file to periodically snapshot the contest accounting state to, which is recovered " +
+			"on startup, empty means no snapshot",
+		Category: proverCategory,
+	}
+	AccountingSnapshotInterval = &cli.DurationFlag{
+		Name: "prover.accountingSnapshotInterval",
+		Usage: "Interval to snapshot the contest accounting state to --prover.accountingSnapshotFile, " +
+			"and the fee history to --prover.feeHistoryFile",
+		Value:    1 * time.Minute,
+		Category: proverCategory,
+	}
+	FeeHistoryFile = &cli.StringFlag{
+		Name: "prover.feeHistoryFile",
+		Usage: "Path of the file to periodically persist the observed tier fee history to, which is recovered " +
+			"on startup, empty means the history is kept in memory only",
+		Category: proverCategory,
+	}
+	MaxStartupBackfill = &cli.Uint64Flag{
+		Name: "prover.maxStartupBackfill",
+		Usage: "Maximum number of proposed blocks to backfill on startup, the older blocks will be skipped, " +
+			"0 means no limit",
+		Value:    0,
+		Category: proverCategory,
+	}
+	BondMonitorInterval = &cli.DurationFlag{
+		Name: "prover.bondMonitorInterval",
+		Usage: "Interval to check the prover's TaikoToken balance and the amount it can still bond for " +
+			"the assignments, 0 means no check",
+		Value:    0,
+		Category: proverCategory,
+	}
+	BondAlertThreshold = &cli.Uint64Flag{
+		Name:     "prover.bondAlertThreshold",
+		Usage:    "Bondable TaikoToken amount (in wei) below which a warning is logged, 0 means no warning",
+		Value:    0,
+		Category: proverCategory,
+	}
+	BalanceRunwayCheckInterval = &cli.DurationFlag{
+		Name: "prover.balanceRunwayCheckInterval",
+		Usage: "Interval to check whether the prover's balance can cover all queued and in-flight proof " +
+			"submissions, 0 means no check",
+		Value:    0,
+		Category: proverCategory,
+	}
+	BalanceAlertWebhook = &cli.StringFlag{
+		Name: "prover.balanceAlertWebhook",
+		Usage: "Webhook URL to POST a low balance alert to, when the prover's balance drops below " +
+			"prover.balanceAlertThreshold, checked every prover.balanceRunwayCheckInterval",
+		Category: proverCategory,
+	}
+	BalanceAlertThreshold = &cli.Uint64Flag{
+		Name:     "prover.balanceAlertThreshold",
+		Usage:    "Prover balance (in wei) below which a low balance alert is posted to the webhook",
+		Value:    0,
+		Category: proverCategory,
+	}
+	ProofRequestConcurrency = &cli.Uint64Flag{
+		Name: "prover.proofRequestConcurrency",
+		Usage: "Maximum number of concurrent proof requests, the requests closer to their proving deadline " +
+			"will be served first. 0 means no limit",
+		Category: proverCategory,
+		Value:    0,
+	}
+	ProducerConcurrency = &cli.Uint64Flag{
+		Name: "prover.producerConcurrency",
+		Usage: "Maximum number of proof requests in flight against each tier's proof producer at the same time, " +
+			"0 means no limit",
+		Category: proverCategory,
+		Value:    0,
+	}
+	ProofRequestDedupWindow = &cli.DurationFlag{
+		Name: "prover.proofRequestDedupWindow",
+		Usage: "Time window to skip the duplicated proof requests of a block which was just proven, " +
+			"the requests of a block being proven are always skipped if set, 0 means no deduplication",
+		Category: proverCategory,
+		Value:    0,
+	}
+	ProofRequestTimeout = &cli.DurationFlag{
+		Name: "prover.proofRequestTimeout",
+		Usage: "Timeout of each proof request, from fetching the block to producing its proof, " +
+			"independent of the block's proving window, 0 means no timeout",
+		Category: proverCategory,
+		Value:    0,
+	}
+	OrderedProofResults = &cli.BoolFlag{
+		Name:     "prover.orderedProofResults",
+		Usage:    "Submit the proofs of each tier in the order of their requests, instead of as soon as produced",
+		Category: proverCategory,
+		Value:    false,
+	}
 	// Tier fee related.
 	MinOptimisticTierFee = &cli.Uint64Flag{
 		Name:     "minTierFee.optimistic",
@@ -100,6 +247,52 @@ var (
 		Value:    3,
 		Category: proverCategory,
 	}
+	SubmissionCooldown = &cli.DurationFlag{
+		Name:     "tx.submissionCooldown",
+		Usage:    "Cooldown before retrying a block's failed proof submission, doubled on each repeated failure",
+		Value:    0 * time.Second,
+		Category: proverCategory,
+	}
+	MaxProofAge = &cli.DurationFlag{
+		Name:     "tx.maxProofAge",
+		Usage:    "Maximum age of a generated proof to submit, older proofs will be discarded and requested again",
+		Value:    0 * time.Second,
+		Category: proverCategory,
+	}
+	AbandonNotAssigned = &cli.BoolFlag{
+		Name: "tx.abandonNotAssigned",
+		Usage: "Abandon the block instead of retrying when a proof submission reverts because " +
+			"the current prover is not the assigned one",
+		Value:    false,
+		Category: proverCategory,
+	}
+	ProofSubmissionDryRun = &cli.BoolFlag{
+		Name: "tx.dryRun",
+		Usage: "Only build and log the proof submission transactions, with their calldata, gas estimates " +
+			"and tier validity bonds, without broadcasting them",
+		Value:    false,
+		Category: proverCategory,
+	}
+	LateProofGraceWindow = &cli.DurationFlag{
+		Name: "prover.lateProofGraceWindow",
+		Usage: "Grace window after a proof request's deadline, during which a late proof is still accepted " +
+			"and submitted if the block's proving window is still open",
+		Value:    0 * time.Second,
+		Category: proverCategory,
+	}
+	BlockShards = &cli.Uint64Flag{
+		Name: "prover.blockShards",
+		Usage: "Number of shards the L2 block space is partitioned into across a prover fleet, " +
+			"the current prover only handles the blocks whose ID modulo this value equals --prover.blockShardIndex",
+		Value:    0,
+		Category: proverCategory,
+	}
+	BlockShardIndex = &cli.Uint64Flag{
+		Name:     "prover.blockShardIndex",
+		Usage:    "Index of the block shard handled by the current prover, used with --prover.blockShards",
+		Value:    0,
+		Category: proverCategory,
+	}
 	ProveBlockTxGasLimit = &cli.Uint64Flag{
 		Name:     "tx.gasLimit",
 		Usage:    "Gas limit will be used for TaikoL1.proveBlock transactions",
@@ -116,6 +309,104 @@ var (
 		Usage:    "Gas tip growth rate when replacing a TaikoL1.proveBlock transaction with same nonce",
 		Category: proverCategory,
 	}
+	VerifySubmittedProof = &cli.BoolFlag{
+		Name:     "tx.verifySubmittedProof",
+		Usage:    "Whether to check the on-chain transition after each proof submission is confirmed",
+		Category: proverCategory,
+		Value:    false,
+	}
+	BroadcastEndpoints = &cli.StringSliceFlag{
+		Name:     "tx.broadcastEndpoints",
+		Usage:    "Comma separated extra L1 RPC endpoints which the proof transactions will also be broadcasted to",
+		Category: proverCategory,
+	}
+	TxNonceStrategy = &cli.StringFlag{
+		Name: "tx.nonceStrategy",
+		Usage: "Nonce allocation strategy of the proof transactions, " +
+			"`sequential` sends them one by one, `pooled` sends them in parallel with pre-allocated nonces",
+		Category: proverCategory,
+		Value:    "sequential",
+	}
+	TxDuplicateNonceRetrys = &cli.Uint64Flag{
+		Name: "tx.duplicateNonceRetrys",
+		Usage: "Maximum retry times with a fresh nonce, when a proof transaction's nonce has already been used " +
+			"by another sender sharing the account, 0 means no retry",
+		Category: proverCategory,
+		Value:    0,
+	}
+	ProofSubmissionConcurrency = &cli.Uint64Flag{
+		Name: "tx.submissionConcurrency",
+		Usage: "Maximum number of the independent proofs submitted in parallel, " +
+			"only takes effect with the `pooled` nonce strategy, 0 or 1 disables the parallel submission",
+		Category: proverCategory,
+		Value:    0,
+	}
+	ResubmissionMaxRetrys = &cli.Uint64Flag{
+		Name: "tx.resubmissionMaxRetrys",
+		Usage: "Maximum resubmission attempts of a replaced or timed out proof transaction, " +
+			"with an exponential backoff plus jitter, 0 means no resubmission",
+		Category: proverCategory,
+		Value:    0,
+	}
+	ResubmissionMaxInterval = &cli.DurationFlag{
+		Name:     "tx.resubmissionMaxInterval",
+		Usage:    "Cap of the backoff interval between two resubmission attempts of a proof transaction",
+		Category: proverCategory,
+		Value:    1 * time.Minute,
+	}
+	ResubmissionGasBump = &cli.Uint64Flag{
+		Name:     "tx.resubmissionGasBump",
+		Usage:    "Percentage to bump the gas fee cap and gas tip cap by on each resubmission attempt",
+		Category: proverCategory,
+		Value:    10,
+	}
+	// Gas price ceiling related.
+	GasPriceCeilingOptimistic = &cli.Uint64Flag{
+		Name:     "tx.gasPriceCeiling.optimistic",
+		Usage:    "L1 base fee (in wei) above which the optimistic proof submissions and contests are deferred",
+		Category: proverCategory,
+	}
+	GasPriceCeilingSgx = &cli.Uint64Flag{
+		Name:     "tx.gasPriceCeiling.sgx",
+		Usage:    "L1 base fee (in wei) above which the SGX proof submissions and contests are deferred",
+		Category: proverCategory,
+	}
+	GasPriceCeilingSgxAndZkVM = &cli.Uint64Flag{
+		Name:     "tx.gasPriceCeiling.sgxAndZkvm",
+		Usage:    "L1 base fee (in wei) above which the SGX + zkVM proof submissions and contests are deferred",
+		Category: proverCategory,
+	}
+	GasPriceCeilingGuardian = &cli.Uint64Flag{
+		Name:     "tx.gasPriceCeiling.guardian",
+		Usage:    "L1 base fee (in wei) above which the guardian proof submissions are deferred",
+		Category: proverCategory,
+	}
+	// Proof tier fallback related.
+	FallbackTiersOptimistic = &cli.Uint64SliceFlag{
+		Name:     "prover.fallbackTiers.optimistic",
+		Usage:    "Tier IDs to fall back to, in order, when the optimistic proof producer is unavailable or fails",
+		Category: proverCategory,
+	}
+	FallbackTiersSgx = &cli.Uint64SliceFlag{
+		Name:     "prover.fallbackTiers.sgx",
+		Usage:    "Tier IDs to fall back to, in order, when the SGX proof producer is unavailable or fails",
+		Category: proverCategory,
+	}
+	FallbackTiersSgxAndZkVM = &cli.Uint64SliceFlag{
+		Name:     "prover.fallbackTiers.sgxAndZkvm",
+		Usage:    "Tier IDs to fall back to, in order, when the SGX + zkVM proof producer is unavailable or fails",
+		Category: proverCategory,
+	}
+	FallbackTiersGuardian = &cli.Uint64SliceFlag{
+		Name:     "prover.fallbackTiers.guardian",
+		Usage:    "Tier IDs to fall back to, in order, when the guardian proof producer is unavailable or fails",
+		Category: proverCategory,
+	}
+	L1ContesterPrivKey = &cli.StringFlag{
+		Name:     "l1.contesterPrivKey",
+		Usage:    "Private key of a dedicated L1 account for sending contest transactions, defaults to the prover's one",
+		Category: proverCategory,
+	}
 	// Running mode
 	ContesterMode = &cli.BoolFlag{
 		Name:     "mode.contester",
@@ -123,6 +414,12 @@ var (
 		Category: proverCategory,
 		Value:    false,
 	}
+	SkipInsufficientContestBond = &cli.BoolFlag{
+		Name:     "contester.skipInsufficientBond",
+		Usage:    "Skip the contests reverted due to an insufficient contest bond with a warning, instead of retrying them",
+		Category: proverCategory,
+		Value:    false,
+	}
 	// HTTP server related.
 	ProverHTTPServerPort = &cli.Uint64Flag{
 		Name:     "http.port",
@@ -130,6 +427,29 @@ var (
 		Category: proverCategory,
 		Value:    9876,
 	}
+	ConfigAPIToken = &cli.StringFlag{
+		Name: "http.configAPIToken",
+		Usage: "Bearer token required by the admin endpoints (GET /config, PUT /graffiti and GET /audit), " +
+			"the endpoints are disabled if not set",
+		Category: proverCategory,
+	}
+	AuditConcurrency = &cli.Uint64Flag{
+		Name:     "http.auditConcurrency",
+		Usage:    "Maximum number of the L2 blocks audited in parallel by the GET /audit endpoint",
+		Value:    4,
+		Category: proverCategory,
+	}
+	AuditBlocksPerSecond = &cli.Uint64Flag{
+		Name:     "http.auditBlocksPerSecond",
+		Usage:    "Maximum number of the L2 blocks audited per second by the GET /audit endpoint, 0 means no limit",
+		Value:    0,
+		Category: proverCategory,
+	}
+	GRPCAddr = &cli.StringFlag{
+		Name:     "grpc.addr",
+		Usage:    "Address to expose the gRPC status server on (e.g. :9877), the gRPC server is disabled if not set",
+		Category: proverCategory,
+	}
 	MaxExpiry = &cli.DurationFlag{
 		Name:     "http.maxExpiry",
 		Usage:    "Maximum accepted expiry in seconds for accepting proving a block",
@@ -200,7 +520,11 @@ var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	L2WSEndpoint,
 	L2HTTPEndpoint,
 	RaikoHostEndpoint,
+	ZKProverEndpoint,
+	ZKRequestTimeout,
+	ZKPollingInterval,
 	L1ProverPrivKey,
+	L1ProverSigner,
 	MinOptimisticTierFee,
 	MinSgxTierFee,
 	MinSgxAndZkVMTierFee,
@@ -212,14 +536,69 @@ var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	GuardianProofSubmissionDelay,
 	GuardianProverHealthCheckServerEndpoint,
 	ProofSubmissionMaxRetry,
+	SubmissionCooldown,
+	MaxProofAge,
+	AbandonNotAssigned,
+	ProofSubmissionDryRun,
+	LateProofGraceWindow,
+	BlockShards,
+	BlockShardIndex,
 	TxReplacementGasGrowthRate,
 	ProveBlockMaxTxGasFeeCap,
+	VerifySubmittedProof,
+	BroadcastEndpoints,
+	TxNonceStrategy,
+	TxDuplicateNonceRetrys,
+	ProofSubmissionConcurrency,
+	ResubmissionMaxRetrys,
+	ResubmissionMaxInterval,
+	ResubmissionGasBump,
+	GasPriceCeilingOptimistic,
+	GasPriceCeilingSgx,
+	GasPriceCeilingSgxAndZkVM,
+	GasPriceCeilingGuardian,
+	FallbackTiersOptimistic,
+	FallbackTiersSgx,
+	FallbackTiersSgxAndZkVM,
+	FallbackTiersGuardian,
 	Graffiti,
 	ProveUnassignedBlocks,
 	ContesterMode,
+	SkipInsufficientContestBond,
+	L1ContesterPrivKey,
 	ProveBlockTxGasLimit,
 	ProverHTTPServerPort,
+	ConfigAPIToken,
+	AuditConcurrency,
+	AuditBlocksPerSecond,
+	GRPCAddr,
 	ProverCapacity,
+	ProofRequestConcurrency,
+	ProducerConcurrency,
+	OrderedProofResults,
+	ProofRequestDedupWindow,
+	ProofRequestTimeout,
+	BalanceRunwayCheckInterval,
+	BondMonitorInterval,
+	BondAlertThreshold,
+	BalanceAlertWebhook,
+	BalanceAlertThreshold,
+	MaxSyncLag,
+	AssignmentWarmupMaxSyncLag,
+	MaxProverReorgDepth,
+	ProtocolPausedPollInterval,
+	NonceStoreFile,
+	StuckTxTimeout,
+	StuckTxGasBumpRate,
+	GasLimitMargin,
+	GasLimitMarginCap,
+	MaxTxsPerSecond,
+	LeaseFile,
+	LeaseTTL,
+	AccountingSnapshotFile,
+	AccountingSnapshotInterval,
+	FeeHistoryFile,
+	MaxStartupBackfill,
 	MaxExpiry,
 	MaxProposedIn,
 	TaikoTokenAddress,
@@ -230,3 +609,36 @@ var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	L2NodeVersion,
 	BlockConfirmations,
 })
+
+// Flags used by the `bench` command.
+var (
+	BenchTier = &cli.UintFlag{
+		Name:     "bench.tier",
+		Usage:    "Proof tier to benchmark",
+		Value:    uint(100),
+		Category: proverCategory,
+	}
+	BenchCount = &cli.IntFlag{
+		Name:     "bench.count",
+		Usage:    "Number of proofs to produce",
+		Value:    10,
+		Category: proverCategory,
+	}
+	BenchStartingBlockID = &cli.Uint64Flag{
+		Name:     "bench.startingBlockID",
+		Usage:    "ID of the first block to prove, only useful when benchmarking an external proof producer",
+		Value:    1,
+		Category: proverCategory,
+	}
+)
+
+// ProverBenchFlags All `bench` flags, L1 / L2 endpoints are only needed by external proof producers.
+var ProverBenchFlags = []cli.Flag{
+	BenchTier,
+	BenchCount,
+	BenchStartingBlockID,
+	RaikoHostEndpoint,
+	L1BeaconEndpoint,
+	&cli.StringFlag{Name: L1HTTPEndpoint.Name, Usage: L1HTTPEndpoint.Usage, Category: commonCategory},
+	&cli.StringFlag{Name: L2HTTPEndpoint.Name, Usage: L2HTTPEndpoint.Usage, Category: commonCategory},
+}
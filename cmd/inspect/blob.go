@@ -0,0 +1,149 @@
+package inspect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-client/cmd/flags"
+	txlistfetcher "github.com/taikoxyz/taiko-client/driver/txlist_fetcher"
+	"github.com/taikoxyz/taiko-client/internal/utils"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+var (
+	errBeaconEndpointNotSet = errors.New("L1 beacon endpoint not set")
+	errBlobNotFound         = errors.New("blob not found")
+)
+
+// DecodedBlob is a blob decoded by the `blob-decode` command.
+type DecodedBlob struct {
+	Index    int
+	BlobHash common.Hash
+	// The decompressed transactions list, or the blob data as is if it can't be decompressed
+	TxList []byte
+	// The number of the transactions in the transactions list, and the error if the transactions list
+	// can't be decoded
+	TxCount   int
+	DecodeErr error
+}
+
+// BlobDecodeAction is the action of the `blob-decode` command.
+func BlobDecodeAction(c *cli.Context) error {
+	if c.String(flags.L1BeaconEndpoint.Name) == "" {
+		return errBeaconEndpointNotSet
+	}
+
+	beacon, err := rpc.NewBeaconClient(c.String(flags.L1BeaconEndpoint.Name), c.Duration(flags.RPCTimeout.Name))
+	if err != nil {
+		return err
+	}
+	if err := beacon.SetSidecarsPathTemplate(c.String(flags.L1BeaconSidecarsPath.Name)); err != nil {
+		return err
+	}
+
+	var blobHash *common.Hash
+	if c.IsSet(flags.BlobDecodeHash.Name) {
+		h := common.HexToHash(c.String(flags.BlobDecodeHash.Name))
+		blobHash = &h
+	}
+
+	blobs, err := DecodeBlobs(c.Context, beacon, c.Uint64(flags.BlobDecodeSlot.Name), blobHash)
+	if err != nil {
+		return err
+	}
+
+	var txLists []byte
+	for _, b := range blobs {
+		printDecodedBlob(c.App.Writer, b, !c.IsSet(flags.BlobDecodeOutput.Name))
+		txLists = append(txLists, b.TxList...)
+	}
+
+	if c.IsSet(flags.BlobDecodeOutput.Name) {
+		return os.WriteFile(c.String(flags.BlobDecodeOutput.Name), txLists, 0o600)
+	}
+
+	return nil
+}
+
+// DecodeBlobs fetches the blob sidecars of the given L1 slot, and decodes the transactions lists in the
+// blobs, only the blob with the given hash is decoded if it is not nil.
+func DecodeBlobs(
+	ctx context.Context,
+	beacon *rpc.BeaconClient,
+	slot uint64,
+	blobHash *common.Hash,
+) ([]*DecodedBlob, error) {
+	sidecars, err := beacon.GetBlobs(ctx, new(big.Int).SetUint64(slot))
+	if err != nil {
+		return nil, err
+	}
+
+	var blobs []*DecodedBlob
+	for i, sidecar := range sidecars {
+		hash, err := txlistfetcher.SidecarBlobHash(sidecar)
+		if err != nil {
+			return nil, fmt.Errorf("sidecar %d: %w", i, err)
+		}
+		if blobHash != nil && hash != *blobHash {
+			continue
+		}
+
+		blob := rpc.Blob(common.FromHex(sidecar.Blob))
+		data, err := blob.ToData()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode blob %s: %w", hash, err)
+		}
+
+		blobs = append(blobs, decodeTxList(i, hash, data))
+	}
+
+	if blobHash != nil && len(blobs) == 0 {
+		return nil, fmt.Errorf("%w: blob hash %s, slot %d", errBlobNotFound, blobHash, slot)
+	}
+
+	return blobs, nil
+}
+
+// decodeTxList decompresses and decodes the transactions list in the given blob data, in the same way as
+// the driver does.
+func decodeTxList(index int, blobHash common.Hash, data []byte) *DecodedBlob {
+	decoded := &DecodedBlob{Index: index, BlobHash: blobHash, TxList: data}
+
+	txList, err := utils.Decompress(data)
+	if err != nil {
+		decoded.DecodeErr = fmt.Errorf("failed to decompress: %w", err)
+		return decoded
+	}
+	decoded.TxList = txList
+
+	var txs []*types.Transaction
+	if decoded.DecodeErr = rlp.DecodeBytes(txList, &txs); decoded.DecodeErr == nil {
+		decoded.TxCount = len(txs)
+	}
+
+	return decoded
+}
+
+// printDecodedBlob prints the given decoded blob, with its transactions list bytes if printTxList is set.
+func printDecodedBlob(w io.Writer, b *DecodedBlob, printTxList bool) {
+	fmt.Fprintf(w, "Blob %d\n", b.Index)
+	fmt.Fprintf(w, "  BlobHash:     %s\n", b.BlobHash)
+	fmt.Fprintf(w, "  TxListBytes:  %d\n", len(b.TxList))
+	if b.DecodeErr != nil {
+		fmt.Fprintf(w, "  Transactions: invalid transactions list (%v)\n", b.DecodeErr)
+	} else {
+		fmt.Fprintf(w, "  Transactions: %d\n", b.TxCount)
+	}
+	if printTxList {
+		fmt.Fprintf(w, "  TxList:       %#x\n", b.TxList)
+	}
+}
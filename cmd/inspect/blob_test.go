@@ -0,0 +1,76 @@
+package inspect
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/blob"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/internal/testutils"
+	"github.com/taikoxyz/taiko-client/internal/utils"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+func TestDecodeBlobs(t *testing.T) {
+	txList, err := rlp.EncodeToBytes([]*types.Transaction{
+		types.NewTx(&types.DynamicFeeTx{Nonce: 1}),
+		types.NewTx(&types.DynamicFeeTx{Nonce: 2}),
+	})
+	require.Nil(t, err)
+	compressed, err := utils.Compress(txList)
+	require.Nil(t, err)
+
+	var (
+		res        = new(blob.SidecarsResponse)
+		blobHashes []common.Hash
+	)
+	for _, data := range [][]byte{compressed, []byte("not a txList")} {
+		sidecar, err := rpc.MakeSidecar(data)
+		require.Nil(t, err)
+
+		res.Data = append(res.Data, &blob.Sidecar{
+			Blob:          common.Bytes2Hex(sidecar.Blobs[0][:]),
+			KzgCommitment: common.Bytes2Hex(sidecar.Commitments[0][:]),
+		})
+		blobHashes = append(blobHashes, kzg4844.CalcBlobHashV1(sha256.New(), &sidecar.Commitments[0]))
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		require.Nil(t, json.NewEncoder(w).Encode(res))
+	}))
+	defer srv.Close()
+
+	beacon, err := rpc.NewBeaconClient(srv.URL, time.Second)
+	require.Nil(t, err)
+
+	// All blobs in the slot are decoded.
+	blobs, err := DecodeBlobs(context.Background(), beacon, 1, nil)
+	require.Nil(t, err)
+	require.Len(t, blobs, 2)
+	require.Equal(t, blobHashes[0], blobs[0].BlobHash)
+	require.Equal(t, txList, blobs[0].TxList)
+	require.Equal(t, 2, blobs[0].TxCount)
+	require.Nil(t, blobs[0].DecodeErr)
+	require.Equal(t, []byte("not a txList"), blobs[1].TxList)
+	require.ErrorContains(t, blobs[1].DecodeErr, "failed to decompress")
+
+	// Only the blob with the given hash is decoded.
+	blobs, err = DecodeBlobs(context.Background(), beacon, 1, &blobHashes[1])
+	require.Nil(t, err)
+	require.Len(t, blobs, 1)
+	require.Equal(t, 1, blobs[0].Index)
+
+	blobHash := testutils.RandomHash()
+	_, err = DecodeBlobs(context.Background(), beacon, 1, &blobHash)
+	require.ErrorIs(t, err, errBlobNotFound)
+}
@@ -0,0 +1,72 @@
+package inspect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+var errBlockProposedEventNotFound = errors.New("BlockProposed event not found")
+
+// BlockAction is the action of the `inspect-block` command.
+func BlockAction(c *cli.Context) error {
+	l1Client, err := rpc.NewEthClient(c.Context, c.String(flags.L1WSEndpoint.Name), c.Duration(flags.RPCTimeout.Name))
+	if err != nil {
+		return err
+	}
+
+	taikoL1, err := bindings.NewTaikoL1Client(common.HexToAddress(c.String(flags.TaikoL1Address.Name)), l1Client)
+	if err != nil {
+		return err
+	}
+
+	meta, err := FetchBlockMetadata(c.Context, taikoL1, c.Uint64(flags.InspectBlockID.Name))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(c.App.Writer, encoding.FormatBlockMetadata(meta))
+
+	return nil
+}
+
+// FetchBlockMetadata fetches the metadata of the given L2 block from its BlockProposed event.
+func FetchBlockMetadata(
+	ctx context.Context,
+	taikoL1 *bindings.TaikoL1Client,
+	blockID uint64,
+) (*bindings.TaikoDataBlockMetadata, error) {
+	blockInfo, err := taikoL1.GetBlock(&bind.CallOpts{Context: ctx}, blockID)
+	if err != nil {
+		return nil, encoding.TryParsingCustomError(err)
+	}
+
+	iter, err := taikoL1.FilterBlockProposed(
+		&bind.FilterOpts{Start: blockInfo.Blk.ProposedIn, End: &blockInfo.Blk.ProposedIn, Context: ctx},
+		[]*big.Int{new(big.Int).SetUint64(blockID)},
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	if iter.Next() {
+		return &iter.Event.Meta, nil
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("%w: block %d, L1 height %d", errBlockProposedEventNotFound, blockID, blockInfo.Blk.ProposedIn)
+}
@@ -7,11 +7,13 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-client/cmd/inspect"
 	"github.com/taikoxyz/taiko-client/cmd/utils"
 	"github.com/taikoxyz/taiko-client/driver"
 	"github.com/taikoxyz/taiko-client/internal/version"
 	"github.com/taikoxyz/taiko-client/proposer"
 	"github.com/taikoxyz/taiko-client/prover"
+	"github.com/taikoxyz/taiko-client/prover/bench"
 )
 
 func main() {
@@ -48,6 +50,27 @@ func main() {
 			Description: "Taiko prover software",
 			Action:      utils.SubcommandAction(new(prover.Prover)),
 		},
+		{
+			Name:        "bench",
+			Flags:       flags.ProverBenchFlags,
+			Usage:       "Benchmarks the prover's proof production",
+			Description: "Runs proof productions against synthetic blocks for capacity planning",
+			Action:      bench.Action,
+		},
+		{
+			Name:        "inspect-block",
+			Flags:       flags.InspectBlockFlags,
+			Usage:       "Prints the metadata of a proposed L2 block",
+			Description: "Fetches the given L2 block's metadata from its BlockProposed event and prints it",
+			Action:      inspect.BlockAction,
+		},
+		{
+			Name:        "blob-decode",
+			Flags:       flags.BlobDecodeFlags,
+			Usage:       "Decodes the transactions lists in the blobs of a L1 slot",
+			Description: "Fetches the given L1 slot's blob sidecars, decodes their blobs and prints the transactions lists",
+			Action:      inspect.BlobDecodeAction,
+		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -9,8 +10,10 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/urfave/cli/v2"
 
+	"github.com/taikoxyz/taiko-client/cmd/flags"
 	"github.com/taikoxyz/taiko-client/cmd/logger"
 	"github.com/taikoxyz/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
 )
 
 type SubcommandApplication interface {
@@ -20,6 +23,18 @@ type SubcommandApplication interface {
 	Close(context.Context)
 }
 
+// HealthChecker is implemented by the applications which report the health of their RPC connections, the
+// result is served at `/healthz` of the metrics server.
+type HealthChecker interface {
+	HealthCheck(context.Context) *rpc.HealthCheckResult
+}
+
+// Drainer is implemented by the applications which can shut down gracefully, Drain stops accepting new
+// work, and waits for the in-flight work to finish until the given context is done.
+type Drainer interface {
+	Drain(context.Context)
+}
+
 func SubcommandAction(app SubcommandApplication) cli.ActionFunc {
 	return func(c *cli.Context) error {
 		logger.InitLogger(c)
@@ -38,12 +53,22 @@ func SubcommandAction(app SubcommandApplication) cli.ActionFunc {
 			return err
 		}
 
-		if err := metrics.Serve(ctx, c); err != nil {
+		var healthHandler http.Handler
+		if checker, ok := app.(HealthChecker); ok {
+			healthHandler = rpc.HealthHandler(checker.HealthCheck)
+		}
+
+		if err := metrics.Serve(ctx, c, healthHandler); err != nil {
 			log.Error("Starting metrics server error", "error", err)
 			return err
 		}
 
 		defer func() {
+			if drainer, ok := app.(Drainer); ok && c.Duration(flags.ShutdownGracePeriod.Name) != 0 {
+				drainCtx, cancel := context.WithTimeout(ctx, c.Duration(flags.ShutdownGracePeriod.Name))
+				drainer.Drain(drainCtx)
+				cancel()
+			}
 			ctxClose()
 			app.Close(ctx)
 			log.Info("Application stopped", "name", app.Name())
@@ -56,6 +56,70 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "503": {
+                        "description": "prover is warming up",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/config": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get the prover's effective configuration",
+                "operationId": "get-config",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/graffiti": {
+            "put": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Update the prover's graffiti",
+                "operationId": "set-graffiti",
+                "parameters": [
+                    {
+                        "description": "graffiti request body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/server.SetGraffitiRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/server.SetGraffitiRequestBody"
+                        }
+                    },
+                    "400": {
+                        "description": "graffiti too long",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
@@ -139,6 +203,14 @@ const docTemplate = `{
                 }
             }
         },
+        "server.SetGraffitiRequestBody": {
+            "type": "object",
+            "properties": {
+                "graffiti": {
+                    "type": "string"
+                }
+            }
+        },
         "server.Status": {
             "type": "object",
             "properties": {
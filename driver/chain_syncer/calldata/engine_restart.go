@@ -0,0 +1,122 @@
+package calldata
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// insertedPayloadsCacheSize is the number of the latest inserted L2 blocks' payloads kept for
+// reapplying them after the L2 execution engine restarts.
+const insertedPayloadsCacheSize = 1024
+
+// reapplyRegressedBlocks checks whether the L2 execution engine's head has regressed below the
+// last inserted block, which happens when the engine restarts and loses its unpersisted state. If so,
+// the missing blocks are reapplied from the cached payloads without re-fetching them from L1, and
+// if some of them are no longer cached, the L1Current cursor is reset so that the remaining blocks
+// will be derived from L1 again.
+func (s *Syncer) reapplyRegressedBlocks(ctx context.Context) error {
+	if s.lastInsertedBlockID == nil || s.progressTracker.Triggered() {
+		return nil
+	}
+
+	head, err := s.rpc.L2.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L2 execution engine's head: %w", err)
+	}
+	if head.Number.Cmp(s.lastInsertedBlockID) >= 0 {
+		return nil
+	}
+
+	log.Warn(
+		"L2 execution engine's head regressed, reapplying the missing blocks",
+		"head", head.Number,
+		"lastInsertedBlockID", s.lastInsertedBlockID,
+	)
+
+	reappliedTo, err := reapplyPayloads(
+		ctx,
+		head,
+		s.lastInsertedBlockID.Uint64(),
+		s.insertedPayloads.Get,
+		s.applyPayload,
+	)
+	if err != nil {
+		return err
+	}
+	if reappliedTo == s.lastInsertedBlockID.Uint64() {
+		log.Info("Reapplied all missing blocks", "from", head.Number.Uint64()+1, "to", reappliedTo)
+		return nil
+	}
+
+	log.Info(
+		"Missing blocks not cached, deriving them from L1 again",
+		"from", reappliedTo+1,
+		"to", s.lastInsertedBlockID,
+	)
+
+	lastInsertedBlockID := new(big.Int).SetUint64(reappliedTo)
+	if err := s.state.ResetL1Current(ctx, lastInsertedBlockID); err != nil {
+		return fmt.Errorf("failed to reset L1Current cursor: %w", err)
+	}
+	s.lastInsertedBlockID = lastInsertedBlockID
+	s.appliedEvents.Purge()
+
+	return nil
+}
+
+// reapplyPayloads reapplies the payloads of the blocks after the given head up to the given block ID,
+// stops at the first block whose payload is not cached or doesn't extend the current head, and returns
+// the ID of the last block reapplied.
+func reapplyPayloads(
+	ctx context.Context,
+	head *types.Header,
+	to uint64,
+	getPayload func(uint64) (*engine.ExecutableData, bool),
+	apply func(context.Context, *engine.ExecutableData) error,
+) (uint64, error) {
+	var (
+		headNumber = head.Number.Uint64()
+		headHash   = head.Hash()
+	)
+	for headNumber < to {
+		payload, ok := getPayload(headNumber + 1)
+		if !ok || payload.ParentHash != headHash {
+			break
+		}
+
+		if err := apply(ctx, payload); err != nil {
+			return headNumber, fmt.Errorf("failed to reapply block %d: %w", payload.Number, err)
+		}
+
+		log.Info("🔗 L2 block reapplied", "height", payload.Number, "hash", payload.BlockHash)
+		headNumber, headHash = payload.Number, payload.BlockHash
+	}
+
+	return headNumber, nil
+}
+
+// applyPayload executes the given payload in the L2 execution engine, and sets it as the new head.
+func (s *Syncer) applyPayload(ctx context.Context, payload *engine.ExecutableData) error {
+	execStatus, err := s.rpc.L2Engine.NewPayload(ctx, payload)
+	if err != nil {
+		return fmt.Errorf("failed to create a new payload: %w", err)
+	}
+	if execStatus.Status != engine.VALID {
+		return fmt.Errorf("unexpected NewPayload response status: %s", execStatus.Status)
+	}
+
+	fcRes, err := s.rpc.L2Engine.ForkchoiceUpdate(ctx, &engine.ForkchoiceStateV1{HeadBlockHash: payload.BlockHash}, nil)
+	if err != nil {
+		return err
+	}
+	if fcRes.PayloadStatus.Status != engine.VALID {
+		return fmt.Errorf("unexpected ForkchoiceUpdate response status: %s", fcRes.PayloadStatus.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package calldata
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReapplyPayloads(t *testing.T) {
+	// The L2 execution engine's head regressed to block 5, while the driver has inserted blocks up to 8.
+	var (
+		head     = &types.Header{Number: big.NewInt(5)}
+		payloads = make(map[uint64]*engine.ExecutableData)
+		parent   = head.Hash()
+	)
+	for i := uint64(6); i <= 8; i++ {
+		payloads[i] = &engine.ExecutableData{
+			Number:     i,
+			ParentHash: parent,
+			BlockHash:  common.BigToHash(new(big.Int).SetUint64(i)),
+		}
+		parent = payloads[i].BlockHash
+	}
+	getPayload := func(number uint64) (*engine.ExecutableData, bool) {
+		payload, ok := payloads[number]
+		return payload, ok
+	}
+
+	var applied []uint64
+	apply := func(_ context.Context, payload *engine.ExecutableData) error {
+		applied = append(applied, payload.Number)
+		return nil
+	}
+
+	// All missing blocks are reapplied in order.
+	reappliedTo, err := reapplyPayloads(context.Background(), head, 8, getPayload, apply)
+	require.Nil(t, err)
+	require.Equal(t, uint64(8), reappliedTo)
+	require.Equal(t, []uint64{6, 7, 8}, applied)
+
+	// Stops at the first block which is not cached.
+	applied = nil
+	delete(payloads, 7)
+	reappliedTo, err = reapplyPayloads(context.Background(), head, 8, getPayload, apply)
+	require.Nil(t, err)
+	require.Equal(t, uint64(6), reappliedTo)
+	require.Equal(t, []uint64{6}, applied)
+
+	// Stops at the first block which doesn't extend the engine's head.
+	applied = nil
+	forked := &types.Header{Number: big.NewInt(5), Time: 1}
+	reappliedTo, err = reapplyPayloads(context.Background(), forked, 8, getPayload, apply)
+	require.Nil(t, err)
+	require.Equal(t, uint64(5), reappliedTo)
+	require.Empty(t, applied)
+
+	// Returns the engine errors.
+	failed := func(context.Context, *engine.ExecutableData) error { return errors.New("engine error") }
+	_, err = reapplyPayloads(context.Background(), head, 8, getPayload, failed)
+	require.ErrorContains(t, err, "engine error")
+}
@@ -0,0 +1,81 @@
+package calldata
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+// DefaultSyncHookTimeout is the timeout used for each hook invocation if no timeout is configured.
+const DefaultSyncHookTimeout = 5 * time.Second
+
+// SyncHook lets embedders run custom logic (e.g. indexing) while the syncer applies
+// each L2 block to the execution engine. Hook errors are only logged, they will never
+// stop the syncer.
+type SyncHook interface {
+	// BeforeApply is called right before the block proposed by the given event is
+	// inserted into the L2 execution engine.
+	BeforeApply(ctx context.Context, event *bindings.TaikoL1ClientBlockProposed) error
+	// AfterApply is called after the given block has been inserted into the L2 execution engine.
+	AfterApply(ctx context.Context, event *bindings.TaikoL1ClientBlockProposed, block *engine.ExecutableData) error
+}
+
+// SetSyncHooks registers the hooks which will be invoked around each L2 block insertion,
+// every invocation will be cancelled after the given timeout.
+func (s *Syncer) SetSyncHooks(timeout time.Duration, hooks ...SyncHook) {
+	if timeout == 0 {
+		timeout = DefaultSyncHookTimeout
+	}
+	s.syncHooks = hooks
+	s.syncHookTimeout = timeout
+}
+
+// runBeforeApplyHooks invokes all registered hooks' BeforeApply method.
+func (s *Syncer) runBeforeApplyHooks(ctx context.Context, event *bindings.TaikoL1ClientBlockProposed) {
+	for _, hook := range s.syncHooks {
+		s.runSyncHook(ctx, "BeforeApply", event, func(ctx context.Context) error {
+			return hook.BeforeApply(ctx, event)
+		})
+	}
+}
+
+// runAfterApplyHooks invokes all registered hooks' AfterApply method.
+func (s *Syncer) runAfterApplyHooks(
+	ctx context.Context,
+	event *bindings.TaikoL1ClientBlockProposed,
+	block *engine.ExecutableData,
+) {
+	for _, hook := range s.syncHooks {
+		s.runSyncHook(ctx, "AfterApply", event, func(ctx context.Context) error {
+			return hook.AfterApply(ctx, event, block)
+		})
+	}
+}
+
+// runSyncHook runs a single hook invocation, it returns once the hook finishes or the
+// configured timeout is reached, so a misbehaving hook can not block the syncer indefinitely.
+func (s *Syncer) runSyncHook(
+	ctx context.Context,
+	name string,
+	event *bindings.TaikoL1ClientBlockProposed,
+	fn func(ctx context.Context) error,
+) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, s.syncHookTimeout)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- fn(ctxWithTimeout) }()
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			log.Warn("Sync hook error", "hook", name, "blockID", event.BlockId, "error", err)
+		}
+	case <-ctxWithTimeout.Done():
+		log.Warn("Sync hook timed out", "hook", name, "blockID", event.BlockId, "timeout", s.syncHookTimeout)
+	}
+}
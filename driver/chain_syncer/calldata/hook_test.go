@@ -0,0 +1,86 @@
+package calldata
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+type recordingHook struct {
+	mu      sync.Mutex
+	before  []uint64
+	applied []uint64
+}
+
+func (h *recordingHook) BeforeApply(_ context.Context, event *bindings.TaikoL1ClientBlockProposed) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.before = append(h.before, event.BlockId.Uint64())
+	return nil
+}
+
+func (h *recordingHook) AfterApply(
+	_ context.Context,
+	_ *bindings.TaikoL1ClientBlockProposed,
+	block *engine.ExecutableData,
+) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.applied = append(h.applied, block.Number)
+	return errors.New("ignored")
+}
+
+type blockingHook struct{}
+
+func (h *blockingHook) BeforeApply(ctx context.Context, _ *bindings.TaikoL1ClientBlockProposed) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (h *blockingHook) AfterApply(
+	_ context.Context,
+	_ *bindings.TaikoL1ClientBlockProposed,
+	_ *engine.ExecutableData,
+) error {
+	select {}
+}
+
+func TestSyncHooksRecordAppliedBlocks(t *testing.T) {
+	var (
+		s    = new(Syncer)
+		hook = new(recordingHook)
+	)
+	s.SetSyncHooks(0, hook)
+	require.Equal(t, DefaultSyncHookTimeout, s.syncHookTimeout)
+
+	for i := uint64(1); i <= 3; i++ {
+		event := &bindings.TaikoL1ClientBlockProposed{BlockId: new(big.Int).SetUint64(i)}
+		s.runBeforeApplyHooks(context.Background(), event)
+		s.runAfterApplyHooks(context.Background(), event, &engine.ExecutableData{Number: i})
+	}
+
+	require.Equal(t, []uint64{1, 2, 3}, hook.before)
+	require.Equal(t, []uint64{1, 2, 3}, hook.applied)
+}
+
+func TestSyncHooksTimeout(t *testing.T) {
+	var (
+		s     = new(Syncer)
+		event = &bindings.TaikoL1ClientBlockProposed{BlockId: common.Big1}
+	)
+	s.SetSyncHooks(50*time.Millisecond, new(blockingHook))
+
+	start := time.Now()
+	s.runBeforeApplyHooks(context.Background(), event)
+	s.runAfterApplyHooks(context.Background(), event, &engine.ExecutableData{Number: 1})
+	require.Less(t, time.Since(start), time.Second)
+}
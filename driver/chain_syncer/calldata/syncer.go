@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
@@ -28,6 +29,17 @@ import (
 	txListValidator "github.com/taikoxyz/taiko-client/pkg/txlist_validator"
 )
 
+// appliedEventsCacheSize is the number of the latest applied `BlockProposed` events kept
+// for duplicate events detection.
+const appliedEventsCacheSize = 1024
+
+// appliedEventKey identifies a `BlockProposed` event which has been applied to the L2 execution engine.
+type appliedEventKey struct {
+	blockID     uint64
+	txHash      common.Hash
+	l1BlockHash common.Hash
+}
+
 // Syncer responsible for letting the L2 execution engine catching up with protocol's latest
 // pending block through deriving L1 calldata.
 type Syncer struct {
@@ -40,6 +52,17 @@ type Syncer struct {
 	// Used by BlockInserter
 	lastInsertedBlockID *big.Int
 	reorgDetectedFlag   bool
+	// Used to skip the replayed events, e.g. after resubscribing with backfill
+	appliedEvents *lru.Cache[appliedEventKey, struct{}]
+	// Used to reapply the latest inserted blocks after the L2 execution engine restarts
+	insertedPayloads *lru.Cache[uint64, *engine.ExecutableData]
+	// Hooks invoked around each L2 block insertion
+	syncHooks       []SyncHook
+	syncHookTimeout time.Duration
+	// Used to skip re-fetching the transactions lists of the re-processed events, nil means disabled
+	txListCache *txlistfetcher.TxListCache
+	// Whether a block's transactions list can span more than one blob
+	multiBlobTxList bool
 }
 
 // NewSyncer creates a new syncer instance.
@@ -70,12 +93,44 @@ func NewSyncer(
 			rpc.BlockMaxTxListBytes,
 			client.L2.ChainID,
 		),
+		appliedEvents:    lru.NewCache[appliedEventKey, struct{}](appliedEventsCacheSize),
+		insertedPayloads: lru.NewCache[uint64, *engine.ExecutableData](insertedPayloadsCacheSize),
+		syncHookTimeout:  DefaultSyncHookTimeout,
+		txListCache:      txlistfetcher.NewTxListCache(txlistfetcher.DefaultTxListCacheSize),
 	}, nil
 }
 
+// SetBlobDecodeLimits sets the limits of the transactions lists decoded from blobs, blobs exceeding
+// the limits will be treated as invalid, 0 means no limit.
+func (s *Syncer) SetBlobDecodeLimits(maxTxListBytes uint64, maxTxs uint64) {
+	s.txListValidator.SetBlobDecodeLimits(maxTxListBytes, maxTxs)
+}
+
+// SetMultiBlobTxList sets whether a block's transactions list spans the proposing transaction's blobs
+// starting at the block metadata's blob hash, instead of a single blob.
+func (s *Syncer) SetMultiBlobTxList(enabled bool) {
+	s.multiBlobTxList = enabled
+}
+
+// SetTxListCacheSize sets the number of the latest fetched transactions lists cached, so the re-processed
+// `BlockProposed` events won't fetch and decode their transactions lists again, 0 disables the cache.
+func (s *Syncer) SetTxListCacheSize(size uint64) {
+	s.txListCache = txlistfetcher.NewTxListCache(size)
+}
+
+// SetChainIDCheckSamples sets the number of the decoded transactions sampled from each transactions list
+// to check their chain ID, transactions lists carrying another chain ID will be reported, 0 means no check.
+func (s *Syncer) SetChainIDCheckSamples(samples uint64) {
+	s.txListValidator.SetChainIDCheckSamples(samples)
+}
+
 // ProcessL1Blocks fetches all `TaikoL1.BlockProposed` events between given
 // L1 block heights, and then tries inserting them into L2 execution engine's blockchain.
 func (s *Syncer) ProcessL1Blocks(ctx context.Context, l1End *types.Header) error {
+	if err := s.reapplyRegressedBlocks(ctx); err != nil {
+		return err
+	}
+
 	for {
 		if err := s.processL1Blocks(ctx, l1End); err != nil {
 			return err
@@ -114,6 +169,7 @@ func (s *Syncer) processL1Blocks(ctx context.Context, l1End *types.Header) error
 
 		s.state.SetL1Current(newL1Current)
 		s.lastInsertedBlockID = nil
+		s.txListCache.InvalidateFrom(newL1Current.Number.Uint64())
 	}
 
 	iter, err := eventIterator.NewBlockProposedIterator(ctx, &eventIterator.BlockProposedIteratorConfig{
@@ -174,6 +230,7 @@ func (s *Syncer) onBlockProposed(
 			s.state.SetL1Current(reorgCheckResult.L1CurrentToReset)
 			s.lastInsertedBlockID = reorgCheckResult.LastHandledBlockIDToReset
 			s.reorgDetectedFlag = true
+			s.txListCache.InvalidateFrom(reorgCheckResult.L1CurrentToReset.Number.Uint64())
 			endIter()
 
 			return nil
@@ -184,6 +241,22 @@ func (s *Syncer) onBlockProposed(
 		return nil
 	}
 
+	// Ignore the replayed events which have already been applied.
+	eventKey := appliedEventKey{
+		blockID:     event.BlockId.Uint64(),
+		txHash:      event.Raw.TxHash,
+		l1BlockHash: event.Raw.BlockHash,
+	}
+	if s.appliedEvents.Contains(eventKey) {
+		log.Info(
+			"Skip duplicate BlockProposed event",
+			"blockID", event.BlockId,
+			"l1Height", event.Raw.BlockNumber,
+			"txHash", event.Raw.TxHash,
+		)
+		return nil
+	}
+
 	log.Info(
 		"New BlockProposed event",
 		"l1Height", event.Raw.BlockNumber,
@@ -192,6 +265,22 @@ func (s *Syncer) onBlockProposed(
 		"removed", event.Raw.Removed,
 	)
 
+	// A `BlockProposed` log should never come from a reverted transaction, but we still double-check
+	// the proposing transaction's receipt, in case of a buggy L1 node.
+	receipt, err := s.rpc.L1.TransactionReceipt(ctx, event.Raw.TxHash)
+	if err != nil {
+		return fmt.Errorf("failed to fetch TaikoL1.proposeBlock transaction receipt: %w", err)
+	}
+	if isProposingTxReverted(receipt) {
+		log.Warn(
+			"Skip BlockProposed event from a reverted transaction",
+			"blockID", event.BlockId,
+			"l1Height", event.Raw.BlockNumber,
+			"txHash", event.Raw.TxHash,
+		)
+		return nil
+	}
+
 	// If the event's timestamp is in the future, we wait until the timestamp is reached, should
 	// only happen when testing.
 	if event.Meta.Timestamp > uint64(time.Now().Unix()) {
@@ -201,10 +290,7 @@ func (s *Syncer) onBlockProposed(
 
 	// Fetch the L2 parent block, if the node is just finished a P2P sync, we simply use the tracker's
 	// last synced verified block as the parent, otherwise, we fetch the parent block from L2 EE.
-	var (
-		parent *types.Header
-		err    error
-	)
+	var parent *types.Header
 	if s.progressTracker.Triggered() {
 		// Already synced through beacon sync, just skip this event.
 		if event.BlockId.Cmp(s.progressTracker.LastSyncedVerifiedBlockID()) <= 0 {
@@ -234,11 +320,11 @@ func (s *Syncer) onBlockProposed(
 	// Decode transactions list.
 	var txListDecoder txlistfetcher.TxListFetcher
 	if event.Meta.BlobUsed {
-		txListDecoder = txlistfetcher.NewBlobTxListFetcher(s.rpc)
+		txListDecoder = txlistfetcher.NewBlobTxListFetcher(s.rpc).SetMultiBlobTxList(s.multiBlobTxList)
 	} else {
 		txListDecoder = new(txlistfetcher.CalldataFetcher)
 	}
-	txListBytes, err := txListDecoder.Fetch(ctx, tx, &event.Meta)
+	txListBytes, err := s.txListCache.Wrap(txListDecoder).Fetch(ctx, tx, &event.Meta)
 	if err != nil {
 		if errors.Is(err, rpc.ErrBlobInvalid) {
 			log.Info("Invalid blob detected", "blockID", event.BlockId)
@@ -258,6 +344,8 @@ func (s *Syncer) onBlockProposed(
 		txListBytes = []byte{}
 	}
 
+	s.runBeforeApplyHooks(ctx, event)
+
 	payloadData, err := s.insertNewHead(
 		ctx,
 		event,
@@ -289,6 +377,10 @@ func (s *Syncer) onBlockProposed(
 
 	metrics.DriverL1CurrentHeightGauge.Update(int64(event.Raw.BlockNumber))
 	s.lastInsertedBlockID = event.BlockId
+	s.appliedEvents.Add(eventKey, struct{}{})
+	s.insertedPayloads.Add(payloadData.Number, payloadData)
+
+	s.runAfterApplyHooks(ctx, event, payloadData)
 
 	if s.progressTracker.Triggered() {
 		s.progressTracker.ClearMeta()
@@ -297,6 +389,11 @@ func (s *Syncer) onBlockProposed(
 	return nil
 }
 
+// isProposingTxReverted checks whether the given proposing transaction receipt has a failed status.
+func isProposingTxReverted(receipt *types.Receipt) bool {
+	return receipt.Status != types.ReceiptStatusSuccessful
+}
+
 // insertNewHead tries to insert a new head block to the L2 execution engine's local
 // block chain through Engine APIs.
 func (s *Syncer) insertNewHead(
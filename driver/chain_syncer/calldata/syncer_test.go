@@ -2,14 +2,22 @@ package calldata
 
 import (
 	"context"
+	"errors"
 	"math/big"
+	"net/http/httptest"
 	"os"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/lru"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/taikoxyz/taiko-client/bindings"
@@ -109,6 +117,22 @@ func (s *CalldataSyncerTestSuite) TestOnBlockProposed() {
 	))
 }
 
+func (s *CalldataSyncerTestSuite) TestReplayedBlockProposedEvent() {
+	events := s.ProposeAndInsertEmptyBlocks(s.p, s.s)
+	s.NotEmpty(events)
+
+	headBefore, err := s.RPCClient.L2.HeaderByNumber(context.Background(), nil)
+	s.Nil(err)
+
+	// Replay the last event, as if it was delivered again after a resubscription.
+	s.s.lastInsertedBlockID = nil
+	s.Nil(s.s.onBlockProposed(context.Background(), events[len(events)-1], func() {}))
+
+	headAfter, err := s.RPCClient.L2.HeaderByNumber(context.Background(), nil)
+	s.Nil(err)
+	s.Equal(headBefore.Hash(), headAfter.Hash())
+}
+
 func (s *CalldataSyncerTestSuite) TestInsertNewHead() {
 	parent, err := s.s.rpc.L2.HeaderByNumber(context.Background(), nil)
 	s.Nil(err)
@@ -211,6 +235,75 @@ func (s *CalldataSyncerTestSuite) TestTreasuryIncome() {
 	s.Zero(balanceAfter.Cmp(balance))
 }
 
+// receiptService serves the chain ID and the proposing transactions' receipts with the given status.
+type receiptService struct {
+	status atomic.Uint64
+}
+
+func (s *receiptService) ChainId() *hexutil.Big { return (*hexutil.Big)(big.NewInt(1)) }
+
+func (s *receiptService) GetTransactionReceipt(txHash common.Hash) *types.Receipt {
+	return &types.Receipt{Status: s.status.Load(), TxHash: txHash, Logs: []*types.Log{}}
+}
+
+// parentService serves the chain ID, and counts the L2 parent block requests.
+type parentService struct {
+	calls atomic.Uint64
+}
+
+func (s *parentService) ChainId() *hexutil.Big { return (*hexutil.Big)(big.NewInt(2)) }
+
+func (s *parentService) GetBlockByHash(_ common.Hash, _ bool) (*types.Header, error) {
+	s.calls.Add(1)
+	return nil, errors.New("parent not found")
+}
+
+func TestOnBlockProposedRevertedTx(t *testing.T) {
+	newClient := func(service interface{}) *rpc.EthClient {
+		server := gethRPC.NewServer()
+		require.Nil(t, server.RegisterName("eth", service))
+		httpServer := httptest.NewServer(server)
+		t.Cleanup(httpServer.Close)
+
+		client, err := rpc.NewEthClient(context.Background(), httpServer.URL, time.Second)
+		require.Nil(t, err)
+		return client
+	}
+
+	var (
+		l1      = new(receiptService)
+		l2      = new(parentService)
+		tracker = beaconsync.NewSyncProgressTracker(nil, time.Hour)
+		syncer  = &Syncer{
+			rpc:             &rpc.Client{L1: newClient(l1), L2: newClient(l2)},
+			progressTracker: tracker,
+			appliedEvents:   lru.NewCache[appliedEventKey, struct{}](appliedEventsCacheSize),
+		}
+		event = &bindings.TaikoL1ClientBlockProposed{
+			BlockId: big.NewInt(5),
+			Meta:    bindings.TaikoDataBlockMetadata{Id: 5},
+			Raw:     types.Log{TxHash: testutils.RandomHash(), BlockNumber: 100},
+		}
+	)
+	// Skip the reorg check, which is out of the scope of this test.
+	tracker.UpdateMeta(common.Big1, testutils.RandomHash())
+
+	// The event from a reverted transaction is skipped, without fetching its L2 parent block.
+	l1.status.Store(types.ReceiptStatusFailed)
+	require.Nil(t, syncer.onBlockProposed(context.Background(), event, func() {}))
+	require.Zero(t, l2.calls.Load())
+	require.Nil(t, syncer.lastInsertedBlockID)
+
+	// The event from a successful transaction is processed.
+	l1.status.Store(types.ReceiptStatusSuccessful)
+	require.ErrorContains(
+		t,
+		syncer.onBlockProposed(context.Background(), event, func() {}),
+		"failed to fetch L2 parent block",
+	)
+	require.Equal(t, uint64(1), l2.calls.Load())
+}
+
 func TestCalldataSyncerTestSuite(t *testing.T) {
 	suite.Run(t, new(CalldataSyncerTestSuite))
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-client/driver/chain_syncer/calldata"
 	"github.com/taikoxyz/taiko-client/pkg/jwt"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
 )
@@ -20,6 +21,15 @@ type Config struct {
 	P2PSyncTimeout        time.Duration
 	RPCTimeout            time.Duration
 	RetryInterval         time.Duration
+	MaxBlobTxListBytes    uint64
+	MaxBlobTxs            uint64
+	MultiBlobTxList       bool
+	TxListCacheSize       uint64
+	ChainIDCheckSamples   uint64
+	// SyncHooks will be invoked around each L2 block insertion, only settable
+	// when embedding the driver.
+	SyncHooks       []calldata.SyncHook
+	SyncHookTimeout time.Duration
 }
 
 // NewConfigFromCliContext creates a new config instance from
@@ -46,19 +56,36 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 	var timeout = c.Duration(flags.RPCTimeout.Name)
 	return &Config{
 		ClientConfig: &rpc.ClientConfig{
-			L1Endpoint:       c.String(flags.L1WSEndpoint.Name),
-			L1BeaconEndpoint: c.String(flags.L1BeaconEndpoint.Name),
-			L2Endpoint:       c.String(flags.L2WSEndpoint.Name),
-			L2CheckPoint:     l2CheckPoint,
-			TaikoL1Address:   common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
-			TaikoL2Address:   common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
-			L2EngineEndpoint: c.String(flags.L2AuthEndpoint.Name),
-			JwtSecret:        string(jwtSecret),
-			Timeout:          timeout,
+			L1Endpoint:                   c.String(flags.L1WSEndpoint.Name),
+			L1FailoverEndpoints:          c.StringSlice(flags.L1FailoverWSEndpoints.Name),
+			L1FailoverMaxHeadLag:         c.Uint64(flags.L1FailoverMaxHeadLag.Name),
+			L1FailoverCheckInterval:      c.Duration(flags.L1FailoverCheckInterval.Name),
+			L1BeaconEndpoint:             c.String(flags.L1BeaconEndpoint.Name),
+			L1BeaconSidecarsPath:         c.String(flags.L1BeaconSidecarsPath.Name),
+			L1BeaconMaxConcurrentFetches: c.Uint64(flags.L1BeaconMaxConcurrentFetches.Name),
+			L1BeaconFallback:             c.String(flags.L1BeaconFallback.Name),
+			L2Endpoint:                   c.String(flags.L2WSEndpoint.Name),
+			L2CheckPoint:                 l2CheckPoint,
+			TaikoL1Address:               common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
+			TaikoL2Address:               common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
+			L2EngineEndpoint:             c.String(flags.L2AuthEndpoint.Name),
+			JwtSecret:                    string(jwtSecret),
+			Timeout:                      timeout,
+			SkipGenesisCheck:             c.Bool(flags.SkipGenesisCheck.Name),
+			HealthCheck: rpc.HealthCheckConfig{
+				MaxL1HeadAge:          c.Duration(flags.HealthMaxL1HeadAge.Name),
+				MaxL2BlockLag:         c.Uint64(flags.HealthMaxL2BlockLag.Name),
+				MaxBeaconSyncDistance: c.Uint64(flags.HealthMaxBeaconSyncDistance.Name),
+			},
 		},
 		RetryInterval:         c.Duration(flags.BackOffRetryInterval.Name),
 		P2PSyncVerifiedBlocks: p2pSyncVerifiedBlocks,
 		P2PSyncTimeout:        c.Duration(flags.P2PSyncTimeout.Name),
 		RPCTimeout:            timeout,
+		MaxBlobTxListBytes:    c.Uint64(flags.MaxBlobTxListBytes.Name),
+		MaxBlobTxs:            c.Uint64(flags.MaxBlobTxs.Name),
+		MultiBlobTxList:       c.Bool(flags.MultiBlobTxList.Name),
+		TxListCacheSize:       c.Uint64(flags.TxListCacheSize.Name),
+		ChainIDCheckSamples:   c.Uint64(flags.ChainIDCheckSamples.Name),
 	}, nil
 }
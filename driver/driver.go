@@ -2,6 +2,7 @@ package driver
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 
 	chainSyncer "github.com/taikoxyz/taiko-client/driver/chain_syncer"
 	"github.com/taikoxyz/taiko-client/driver/state"
+	"github.com/taikoxyz/taiko-client/internal/errlog"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
 	"github.com/urfave/cli/v2"
 )
@@ -25,6 +27,9 @@ const (
 	exchangeTransitionConfigInterval = 1 * time.Minute
 )
 
+// engineChainIDCheckInterval is the interval to verify the L2 execution engine's chain ID.
+var engineChainIDCheckInterval = 1 * time.Minute
+
 // Driver keeps the L2 execution engine's local block chain in sync with the TaikoL1
 // contract.
 type Driver struct {
@@ -37,8 +42,9 @@ type Driver struct {
 	l1HeadSub  event.Subscription
 	syncNotify chan struct{}
 
-	ctx context.Context
-	wg  sync.WaitGroup
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
 }
 
 // InitFromCli initializes the given driver instance based on the command line flags.
@@ -55,13 +61,17 @@ func (d *Driver) InitFromCli(ctx context.Context, c *cli.Context) error {
 func (d *Driver) InitFromConfig(ctx context.Context, cfg *Config) (err error) {
 	d.l1HeadCh = make(chan *types.Header, 1024)
 	d.syncNotify = make(chan struct{}, 1)
-	d.ctx = ctx
+	d.ctx, d.cancel = context.WithCancel(ctx)
 	d.Config = cfg
 
 	if d.rpc, err = rpc.NewClient(d.ctx, cfg.ClientConfig); err != nil {
 		return err
 	}
 
+	if err := d.checkEngineChainID(d.ctx); err != nil {
+		return err
+	}
+
 	if d.state, err = state.New(d.ctx, d.rpc); err != nil {
 		return err
 	}
@@ -85,6 +95,14 @@ func (d *Driver) InitFromConfig(ctx context.Context, cfg *Config) (err error) {
 		return err
 	}
 
+	if len(cfg.SyncHooks) != 0 {
+		d.l2ChainSyncer.CalldataSyncer().SetSyncHooks(cfg.SyncHookTimeout, cfg.SyncHooks...)
+	}
+	d.l2ChainSyncer.CalldataSyncer().SetBlobDecodeLimits(cfg.MaxBlobTxListBytes, cfg.MaxBlobTxs)
+	d.l2ChainSyncer.CalldataSyncer().SetMultiBlobTxList(cfg.MultiBlobTxList)
+	d.l2ChainSyncer.CalldataSyncer().SetTxListCacheSize(cfg.TxListCacheSize)
+	d.l2ChainSyncer.CalldataSyncer().SetChainIDCheckSamples(cfg.ChainIDCheckSamples)
+
 	d.l1HeadSub = d.state.SubL1HeadsFeed(d.l1HeadCh)
 
 	return nil
@@ -95,6 +113,11 @@ func (d *Driver) Start() error {
 	go d.eventLoop()
 	go d.reportProtocolStatus()
 	go d.exchangeTransitionConfigLoop()
+	go func() {
+		if err := d.engineChainIDCheckLoop(); err != nil {
+			log.Crit("L2 execution engine chain ID changed, driver halted", "error", err)
+		}
+	}()
 
 	return nil
 }
@@ -157,6 +180,7 @@ func (d *Driver) doSync() error {
 
 	if err := d.l2ChainSyncer.Sync(d.state.GetL1Head()); err != nil {
 		log.Error("Process new L1 blocks error", "error", err)
+		errlog.Record(errlog.ComponentDriver, "Process new L1 blocks error", err)
 		return err
 	}
 
@@ -254,7 +278,52 @@ func (d *Driver) exchangeTransitionConfigLoop() {
 	}
 }
 
+// engineChainIDCheckLoop keeps verifying the L2 execution engine's chain ID, and returns the mismatch error
+// once the L2 execution engine has been switched to another chain, so that the driver process can be halted.
+func (d *Driver) engineChainIDCheckLoop() error {
+	ticker := time.NewTicker(engineChainIDCheckInterval)
+	d.wg.Add(1)
+
+	defer func() {
+		ticker.Stop()
+		d.wg.Done()
+	}()
+
+	for {
+		select {
+		case <-d.ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := d.checkEngineChainID(d.ctx); err != nil {
+				if errors.Is(err, rpc.ErrChainIDMismatch) {
+					return err
+				}
+				log.Warn("Failed to check L2 execution engine chain ID", "error", err)
+			}
+		}
+	}
+}
+
+// checkEngineChainID checks whether the L2 execution engine's chain ID matches the L2 node's chain ID.
+func (d *Driver) checkEngineChainID(ctx context.Context) error {
+	if d.rpc.L2Engine == nil {
+		return nil
+	}
+
+	chainID, err := d.rpc.L2Engine.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return rpc.CheckChainID(d.rpc.L2.ChainID, chainID)
+}
+
 // Name returns the application name.
 func (d *Driver) Name() string {
 	return "driver"
 }
+
+// HealthCheck checks the health of the RPC connections, it implements the utils.HealthChecker interface.
+func (d *Driver) HealthCheck(ctx context.Context) *rpc.HealthCheckResult {
+	return d.rpc.HealthCheck(ctx)
+}
@@ -3,12 +3,17 @@ package driver
 import (
 	"context"
 	"math/big"
+	"net/http/httptest"
 	"os"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
@@ -323,6 +328,65 @@ func (s *DriverTestSuite) TestL1Current() {
 	s.Nil(s.d.state.ResetL1Current(s.d.ctx, common.Big1))
 }
 
+// switchableEngineService is a L2 execution engine whose chain ID can be changed at runtime.
+type switchableEngineService struct {
+	chainID atomic.Int64
+}
+
+func (s *switchableEngineService) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(s.chainID.Load()))
+}
+
+func TestEngineChainIDCheck(t *testing.T) {
+	defer func(interval time.Duration) { engineChainIDCheckInterval = interval }(engineChainIDCheckInterval)
+	engineChainIDCheckInterval = 10 * time.Millisecond
+
+	service := new(switchableEngineService)
+	service.chainID.Store(167)
+
+	server := gethRPC.NewServer()
+	require.Nil(t, server.RegisterName("eth", service))
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	engineClient, err := gethRPC.Dial(httpServer.URL)
+	require.Nil(t, err)
+
+	d := &Driver{rpc: &rpc.Client{
+		L2:       &rpc.EthClient{ChainID: big.NewInt(167)},
+		L2Engine: &rpc.EngineClient{Client: engineClient},
+	}}
+	d.ctx, d.cancel = context.WithCancel(context.Background())
+	defer d.cancel()
+
+	require.Nil(t, d.checkEngineChainID(d.ctx))
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- d.engineChainIDCheckLoop() }()
+
+	// The driver keeps running while the chain ID matches.
+	select {
+	case err := <-errCh:
+		t.Fatalf("driver halted with a matched engine chain ID: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// The engine is switched to another chain mid-run, the loop returns the mismatch error to halt the driver.
+	service.chainID.Store(168)
+
+	select {
+	case err := <-errCh:
+		require.ErrorIs(t, err, rpc.ErrChainIDMismatch)
+	case <-time.After(time.Second):
+		t.Fatal("driver not halted after the engine chain ID changed")
+	}
+	d.wg.Wait()
+
+	// The loop exits without an error once the driver is stopped.
+	d.cancel()
+	require.Nil(t, d.engineChainIDCheckLoop())
+}
+
 func TestDriverTestSuite(t *testing.T) {
 	suite.Run(t, new(DriverTestSuite))
 }
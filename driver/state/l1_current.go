@@ -2,14 +2,21 @@ package state
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 )
 
+// ErrL1HashMismatch is returned when the L1 block to reset the l1Current cursor to doesn't have the
+// expected hash.
+var ErrL1HashMismatch = errors.New("L1 block hash mismatch")
+
 // GetL1Current reads the L1 current cursor concurrent safely.
 func (s *State) GetL1Current() *types.Header {
 	return s.l1Current.Load().(*types.Header)
@@ -59,3 +66,88 @@ func (s *State) ResetL1Current(ctx context.Context, blockID *big.Int) error {
 
 	return nil
 }
+
+// ResetL1CurrentTo resets the l1Current cursor to the given L1 block, after verifying that its hash
+// matches the expected one, and rewinds the L2 execution engine's head to the last L2 block derived
+// from an L1 block not after it, so that the L1 and L2 views stay consistent, and the rewound L2
+// blocks will be derived from L1 again.
+func (s *State) ResetL1CurrentTo(ctx context.Context, blockNumber *big.Int, expectedHash common.Hash) error {
+	if blockNumber == nil {
+		return fmt.Errorf("empty L1 block number")
+	}
+
+	l1Current, err := s.rpc.L1.HeaderByNumber(ctx, blockNumber)
+	if err != nil {
+		return err
+	}
+	if l1Current.Hash() != expectedHash {
+		return fmt.Errorf(
+			"%w: height %d, expected %s, actual %s",
+			ErrL1HashMismatch,
+			blockNumber,
+			expectedHash,
+			l1Current.Hash(),
+		)
+	}
+
+	l2Head, err := s.rewindL2Head(ctx, blockNumber)
+	if err != nil {
+		return fmt.Errorf("failed to rewind L2 head: %w", err)
+	}
+	s.SetL1Current(l1Current)
+
+	log.Info(
+		"Reset L1 current cursor",
+		"height", l1Current.Number,
+		"hash", l1Current.Hash(),
+		"l2Head", l2Head.Number,
+		"l2HeadHash", l2Head.Hash(),
+	)
+
+	return nil
+}
+
+// rewindL2Head sets the L2 execution engine's head to the last L2 block derived from an L1 block not after
+// the given L1 height, and returns the new head. The L2 blocks just synced through P2P don't have the L1Origin
+// information recorded, they are treated as derived before the given L1 height.
+func (s *State) rewindL2Head(ctx context.Context, l1Height *big.Int) (*types.Header, error) {
+	head, err := s.rpc.L2.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	newHead := head
+	for newHead.Number.Cmp(common.Big0) > 0 {
+		l1Origin, err := s.rpc.L2.L1OriginByID(ctx, newHead.Number)
+		if err != nil {
+			if err.Error() == ethereum.NotFound.Error() {
+				break
+			}
+			return nil, err
+		}
+		if l1Origin.L1BlockHeight.Cmp(l1Height) <= 0 {
+			break
+		}
+
+		if newHead, err = s.rpc.L2.HeaderByHash(ctx, newHead.ParentHash); err != nil {
+			return nil, err
+		}
+	}
+
+	if newHead.Hash() == head.Hash() {
+		return head, nil
+	}
+
+	fcRes, err := s.rpc.L2Engine.ForkchoiceUpdate(ctx, &engine.ForkchoiceStateV1{HeadBlockHash: newHead.Hash()}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if fcRes.PayloadStatus.Status != engine.VALID {
+		return nil, fmt.Errorf("unexpected ForkchoiceUpdate response status: %s", fcRes.PayloadStatus.Status)
+	}
+	s.setL2Head(newHead)
+
+	log.Info("Rewound L2 head", "from", head.Number, "to", newHead.Number, "hash", newHead.Hash())
+
+	return newHead, nil
+}
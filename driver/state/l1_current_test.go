@@ -35,3 +35,24 @@ func (s *DriverStateTestSuite) TestResetL1CurrentCtxErr() {
 	cancel()
 	s.ErrorContains(s.s.ResetL1Current(ctx, common.Big0), "context canceled")
 }
+
+func (s *DriverStateTestSuite) TestResetL1CurrentTo() {
+	l1Current := s.s.GetL1Current()
+	l2Head, err := s.RPCClient.L2.HeaderByNumber(context.Background(), nil)
+	s.Nil(err)
+
+	// Invalid expected hash, the cursor is kept.
+	s.ErrorIs(
+		s.s.ResetL1CurrentTo(context.Background(), l1Current.Number, testutils.RandomHash()),
+		ErrL1HashMismatch,
+	)
+	s.Equal(l1Current.Hash(), s.s.GetL1Current().Hash())
+
+	// Valid expected hash, the L2 head's L1 origin is not after the cursor, so it is kept.
+	s.Nil(s.s.ResetL1CurrentTo(context.Background(), l1Current.Number, l1Current.Hash()))
+	s.Equal(l1Current.Hash(), s.s.GetL1Current().Hash())
+
+	newL2Head, err := s.RPCClient.L2.HeaderByNumber(context.Background(), nil)
+	s.Nil(err)
+	s.Equal(l2Head.Hash(), newL2Head.Hash())
+}
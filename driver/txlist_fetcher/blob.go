@@ -3,46 +3,173 @@ package txlistdecoder
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"math/big"
+	"strings"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/blob"
 
 	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-client/internal/utils"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
 )
 
+const (
+	// slotDuration is the duration of a L1 beacon chain slot.
+	slotDuration = 12 * time.Second
+	// recentBlobSlots is the number of slots a proposed block is considered as a recent one, the
+	// beacon node may not have indexed the sidecars of such a block yet.
+	recentBlobSlots = 4
+	// maxBlobsPerBlock is the maximum number of the blobs a multi-blob txList can span, which is the maximum
+	// number of the blobs a L1 block can carry, so all the blobs of a proposing transaction fit in.
+	maxBlobsPerBlock = params.MaxBlobGasPerBlock / params.BlobTxBlobGasPerBlob
+)
+
+// Sources of the blob sidecars, used to label the logs and metrics.
+const (
+	blobSourceBeacon   = "beacon"
+	blobSourceFallback = "fallback"
+)
+
 // BlobFetcher is responsible for fetching the txList blob from the L1 block sidecar.
 type BlobFetcher struct {
 	rpc *rpc.Client
+	// Whether a block's txList spans the proposing transaction's blobs starting at the block's blob hash,
+	// instead of only the blob of the block's blob hash
+	multiBlobTxList bool
 }
 
 // NewBlobTxListFetcher creates a new BlobFetcher instance based on the given rpc client.
 func NewBlobTxListFetcher(rpc *rpc.Client) *BlobFetcher {
-	return &BlobFetcher{rpc}
+	return &BlobFetcher{rpc: rpc}
+}
+
+// SetMultiBlobTxList sets whether a block's txList spans the proposing transaction's blobs starting at the
+// block metadata's blob hash, their decoded data is then concatenated in order. It changes the derived
+// txLists of the blocks proposed with more than one blob, so all nodes of a network must agree on it.
+func (d *BlobFetcher) SetMultiBlobTxList(enabled bool) *BlobFetcher {
+	d.multiBlobTxList = enabled
+	return d
 }
 
 // Fetch implements the TxListFetcher interface.
 func (d *BlobFetcher) Fetch(
 	ctx context.Context,
-	_ *types.Transaction,
+	tx *types.Transaction,
 	meta *bindings.TaikoDataBlockMetadata,
 ) ([]byte, error) {
 	if !meta.BlobUsed {
+		recordBlobFetchError(blobSourceBeacon, errBlobUnused)
 		return nil, errBlobUnused
 	}
 
+	// Ensure the blob hash is a well-formed EIP-4844 versioned hash.
+	if !kzg4844.IsValidVersionedHash(meta.BlobHash[:]) {
+		return nil, fmt.Errorf("%w: version byte %#x", errMalformedBlobHash, meta.BlobHash[0])
+	}
+
+	maxBlobs := uint64(1)
+	if d.multiBlobTxList {
+		maxBlobs = maxBlobsPerBlock
+	}
+	blobHashes, err := blockBlobHashes(tx, meta, maxBlobs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The sidecars of an old block should already be available, fail fast.
+	if !isRecentlyProposed(meta, time.Now()) {
+		return d.fetchBlob(ctx, meta, blobHashes)
+	}
+
+	// The beacon node may not have indexed the sidecars of a recently proposed block yet,
+	// so keep retrying until the blob shows up.
+	var b []byte
+	retryBackOff := backoff.NewExponentialBackOff()
+	retryBackOff.MaxElapsedTime = recentBlobSlots * slotDuration
+	if retryErr := backoff.Retry(func() error {
+		if b, err = d.fetchBlob(ctx, meta, blobHashes); err != nil {
+			log.Debug("Blob sidecar not available yet", "slot", meta.L1Height+1, "error", err)
+		}
+		return err
+	}, backoff.WithContext(retryBackOff, ctx)); retryErr != nil {
+		return nil, retryErr
+	}
+
+	return b, nil
+}
+
+// fetchBlob fetches the blobs of the given block from the L1 beacon node, and from the fallback sidecars
+// source if the L1 beacon node fails to serve them.
+func (d *BlobFetcher) fetchBlob(
+	ctx context.Context,
+	meta *bindings.TaikoDataBlockMetadata,
+	blobHashes []common.Hash,
+) ([]byte, error) {
+	b, err := d.fetchBlobFrom(ctx, d.rpc.L1Beacon, blobSourceBeacon, meta, blobHashes)
+	if err == nil {
+		log.Info("Blob fetched", "slot", meta.L1Height+1, "source", blobSourceBeacon)
+		return b, nil
+	}
+
+	// A matched blob which can not be decoded is invalid in any source.
+	var permanentErr *backoff.PermanentError
+	if errors.As(err, &permanentErr) {
+		recordBlobFetchError(blobSourceBeacon, err)
+		return nil, err
+	}
+
+	err = d.checkBlobRetention(ctx, meta, err)
+	recordBlobFetchError(blobSourceBeacon, err)
+	if d.rpc.L1BeaconFallback == nil {
+		return nil, err
+	}
+
+	log.Warn("Failed to fetch blob from L1 beacon node, trying the fallback source", "slot", meta.L1Height+1, "error", err)
+	b, fallbackErr := d.fetchBlobFrom(ctx, d.rpc.L1BeaconFallback, blobSourceFallback, meta, blobHashes)
+	if fallbackErr != nil {
+		recordBlobFetchError(blobSourceFallback, fallbackErr)
+		return nil, fmt.Errorf("%w, fallback: %w", err, fallbackErr)
+	}
+
+	log.Info("Blob fetched", "slot", meta.L1Height+1, "source", blobSourceFallback)
+	return b, nil
+}
+
+// fetchBlobFrom fetches the L1 block sidecars from the given source, decodes the blobs which match the
+// given blob hashes, and concatenates their data in order.
+func (d *BlobFetcher) fetchBlobFrom(
+	ctx context.Context,
+	source *rpc.BeaconClient,
+	sourceName string,
+	meta *bindings.TaikoDataBlockMetadata,
+	blobHashes []common.Hash,
+) ([]byte, error) {
 	// Fetch the L1 block sidecars.
-	sidecars, err := d.rpc.L1Beacon.GetBlobs(ctx, new(big.Int).SetUint64(meta.L1Height+1))
+	start := time.Now()
+	sidecars, err := source.GetBlobs(ctx, new(big.Int).SetUint64(meta.L1Height+1))
+	metrics.DriverBlobFetchLatencyHistogram(sourceName).Update(time.Since(start).Milliseconds())
 	if err != nil {
 		return nil, err
 	}
 
-	log.Info("Fetch sidecars", "slot", meta.L1Height+1, "sidecars", len(sidecars))
+	log.Info("Fetch sidecars", "slot", meta.L1Height+1, "sidecars", len(sidecars), "blobs", len(blobHashes))
 
-	// Compare the blob hash with the sidecar's kzg commitment.
+	// Index the sidecars by the blob hashes of their kzg commitments.
+	var (
+		blobs        = make(map[common.Hash]*blob.Sidecar, len(sidecars))
+		malformedErr error
+	)
 	for i, sidecar := range sidecars {
 		log.Info(
 			"Block sidecar",
@@ -51,15 +178,173 @@ func (d *BlobFetcher) Fetch(
 			"blobHash", common.Bytes2Hex(meta.BlobHash[:]),
 		)
 
-		commitment := kzg4844.Commitment(common.FromHex(sidecar.KzgCommitment))
-		if kzg4844.CalcBlobHashV1(
-			sha256.New(),
-			&commitment,
-		) == common.BytesToHash(meta.BlobHash[:]) {
-			blob := rpc.Blob(common.FromHex(sidecar.Blob))
-			return blob.ToData()
+		blobHash, err := SidecarBlobHash(sidecar)
+		if err != nil {
+			log.Warn("Skip the sidecar with a malformed KZG commitment", "index", i, "error", err)
+			malformedErr = err
+			continue
+		}
+		blobs[blobHash] = sidecar
+	}
+
+	// Decode the blobs in order, all of them must be available, partial data is never returned.
+	var data []byte
+	for i, blobHash := range blobHashes {
+		sidecar, ok := blobs[blobHash]
+		if !ok {
+			err := fmt.Errorf("%w: blob %d of %d (%s)", errSidecarNotFound, i+1, len(blobHashes), blobHash)
+			// Report the malformed commitment, since the sidecar of the blob may be the malformed one.
+			if malformedErr != nil {
+				return nil, fmt.Errorf("%w: %w", err, malformedErr)
+			}
+			return nil, err
+		}
+
+		blob, err := verifySidecarBlob(sidecar)
+		if err != nil {
+			return nil, fmt.Errorf("blob %d of %d (%s): %w", i+1, len(blobHashes), blobHash, err)
+		}
+		b, err := blob.ToData()
+		if err != nil {
+			return nil, backoff.Permanent(err)
+		}
+		data = append(data, b...)
+	}
+
+	return data, nil
+}
+
+// verifySidecarBlob decodes the given sidecar's blob, and verifies it against the sidecar's KZG commitment,
+// so that a source can't serve an arbitrary blob along with the commitment of a proposed blob.
+func verifySidecarBlob(sidecar *blob.Sidecar) (*rpc.Blob, error) {
+	commitment, err := decodeCommitment(sidecar.KzgCommitment)
+	if err != nil {
+		return nil, err
+	}
+
+	b := common.FromHex(sidecar.Blob)
+	if len(b) != rpc.BlobSize {
+		return nil, fmt.Errorf("%w: blob length %d, expected %d", errBlobCommitmentMismatch, len(b), rpc.BlobSize)
+	}
+
+	blob := rpc.Blob(b)
+	computed, err := blob.ComputeKZGCommitment()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errBlobCommitmentMismatch, err)
+	}
+	if computed != commitment {
+		return nil, errBlobCommitmentMismatch
+	}
+
+	return &blob, nil
+}
+
+// checkBlobRetention wraps the given L1 beacon node fetching error with errBlobLikelyPruned, if the given
+// block was proposed before the beacon node's blob retention horizon. The error is returned as is if the
+// horizon can't be determined.
+func (d *BlobFetcher) checkBlobRetention(
+	ctx context.Context,
+	meta *bindings.TaikoDataBlockMetadata,
+	err error,
+) error {
+	horizon, horizonErr := d.rpc.L1Beacon.BlobRetentionHorizon(ctx)
+	if horizonErr != nil {
+		log.Debug("Failed to get the L1 beacon node's blob retention horizon", "error", horizonErr)
+		return err
+	}
+
+	proposedAt := time.Unix(int64(meta.Timestamp), 0)
+	if !proposedAt.Before(horizon) {
+		return err
+	}
+
+	return fmt.Errorf(
+		"%w: slot %d proposed at %s is older than the retention horizon %s, "+
+			"a blob archive is required as the fallback source: %w",
+		errBlobLikelyPruned,
+		meta.L1Height+1,
+		proposedAt.UTC().Format(time.RFC3339),
+		horizon.UTC().Format(time.RFC3339),
+		err,
+	)
+}
+
+// recordBlobFetchError records the given blob fetching error of the given source in the metrics, a
+// malformed KZG commitment is recorded as a KZG mismatch, since the sidecar can't be matched with
+// any blob hash, and so is a blob which doesn't match its sidecar's commitment.
+func recordBlobFetchError(source string, err error) {
+	switch {
+	case errors.Is(err, errBlobLikelyPruned):
+		metrics.DriverBlobFetchFailedCounter(source, "pruned").Inc(1)
+	case errors.Is(err, errMalformedCommitment), errors.Is(err, errBlobCommitmentMismatch):
+		metrics.DriverBlobFetchFailedCounter(source, "kzgMismatch").Inc(1)
+	case errors.Is(err, errSidecarNotFound):
+		metrics.DriverBlobFetchFailedCounter(source, "sidecarNotFound").Inc(1)
+	case errors.Is(err, errBlobUnused):
+		metrics.DriverBlobFetchFailedCounter(source, "blobUnused").Inc(1)
+	default:
+		metrics.DriverBlobFetchFailedCounter(source, "other").Inc(1)
+	}
+}
+
+// blockBlobHashes returns the ordered blob hashes of the given block, which are the proposing transaction's
+// blob hashes starting at the block metadata's blob hash, at most maxBlobs of them.
+func blockBlobHashes(
+	tx *types.Transaction,
+	meta *bindings.TaikoDataBlockMetadata,
+	maxBlobs uint64,
+) ([]common.Hash, error) {
+	blobHash := common.BytesToHash(meta.BlobHash[:])
+	if maxBlobs <= 1 || tx == nil {
+		return []common.Hash{blobHash}, nil
+	}
+
+	txBlobHashes := tx.BlobHashes()
+	for i, h := range txBlobHashes {
+		if h != blobHash {
+			continue
 		}
+
+		end := i + int(utils.Min(maxBlobs, uint64(len(txBlobHashes)-i)))
+		return txBlobHashes[i:end], nil
+	}
+
+	return nil, fmt.Errorf(
+		"%w: blob hash %s not found in the proposing transaction %s",
+		errSidecarNotFound,
+		blobHash,
+		tx.Hash(),
+	)
+}
+
+// SidecarBlobHash returns the versioned blob hash of the given sidecar's KZG commitment.
+func SidecarBlobHash(sidecar *blob.Sidecar) (common.Hash, error) {
+	commitment, err := decodeCommitment(sidecar.KzgCommitment)
+	if err != nil {
+		return common.Hash{}, err
 	}
 
-	return nil, errSidecarNotFound
+	return kzg4844.CalcBlobHashV1(sha256.New(), &commitment), nil
+}
+
+// decodeCommitment decodes the given hex encoded KZG commitment, and makes sure it is exactly
+// 48 bytes long.
+func decodeCommitment(s string) (kzg4844.Commitment, error) {
+	var commitment kzg4844.Commitment
+
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X"))
+	if err != nil {
+		return commitment, fmt.Errorf("%w: %w", errMalformedCommitment, err)
+	}
+	if len(b) != len(commitment) {
+		return commitment, fmt.Errorf("%w: length %d, expected %d", errMalformedCommitment, len(b), len(commitment))
+	}
+	copy(commitment[:], b)
+
+	return commitment, nil
+}
+
+// isRecentlyProposed checks whether the given block was proposed within recentBlobSlots of the given time.
+func isRecentlyProposed(meta *bindings.TaikoDataBlockMetadata, now time.Time) bool {
+	return now.Sub(time.Unix(int64(meta.Timestamp), 0)) <= recentBlobSlots*slotDuration
 }
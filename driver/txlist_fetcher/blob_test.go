@@ -0,0 +1,379 @@
+package txlistdecoder
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	gethMetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/blob"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-client/internal/testutils"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+func TestBlobFetcherMalformedBlobHash(t *testing.T) {
+	meta := &bindings.TaikoDataBlockMetadata{BlobUsed: true, BlobHash: testutils.RandomHash()}
+	meta.BlobHash[0] = 0x02
+
+	_, err := NewBlobTxListFetcher(nil).Fetch(context.Background(), nil, meta)
+	require.ErrorIs(t, err, errMalformedBlobHash)
+}
+
+// newDelayedBeaconServer creates a beacon server which only serves the sidecar of the given data
+// after the given delay.
+func newDelayedBeaconServer(
+	t *testing.T,
+	data []byte,
+	delay time.Duration,
+) (*httptest.Server, *bindings.TaikoDataBlockMetadata) {
+	sidecar, err := rpc.MakeSidecar(data)
+	require.Nil(t, err)
+
+	var (
+		readyAt = time.Now().Add(delay)
+		meta    = &bindings.TaikoDataBlockMetadata{
+			BlobUsed: true,
+			BlobHash: kzg4844.CalcBlobHashV1(sha256.New(), &sidecar.Commitments[0]),
+		}
+		res = &blob.SidecarsResponse{Data: []*blob.Sidecar{{
+			Blob:          common.Bytes2Hex(sidecar.Blobs[0][:]),
+			KzgCommitment: common.Bytes2Hex(sidecar.Commitments[0][:]),
+		}}}
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if time.Now().Before(readyAt) {
+			require.Nil(t, json.NewEncoder(w).Encode(&blob.SidecarsResponse{}))
+			return
+		}
+		require.Nil(t, json.NewEncoder(w).Encode(res))
+	}))
+
+	return srv, meta
+}
+
+func TestBlobFetcherRetryRecentBlock(t *testing.T) {
+	data := []byte("recently proposed txList")
+	srv, meta := newDelayedBeaconServer(t, data, time.Second)
+	defer srv.Close()
+
+	beacon, err := rpc.NewBeaconClient(srv.URL, time.Second)
+	require.Nil(t, err)
+
+	meta.Timestamp = uint64(time.Now().Unix())
+	b, err := NewBlobTxListFetcher(&rpc.Client{L1Beacon: beacon}).Fetch(context.Background(), nil, meta)
+	require.Nil(t, err)
+	require.Equal(t, data, b)
+}
+
+func TestBlobFetcherOldBlockFailFast(t *testing.T) {
+	srv, meta := newDelayedBeaconServer(t, []byte("old txList"), time.Minute)
+	defer srv.Close()
+
+	beacon, err := rpc.NewBeaconClient(srv.URL, time.Second)
+	require.Nil(t, err)
+
+	meta.Timestamp = uint64(time.Now().Add(-time.Hour).Unix())
+	_, err = NewBlobTxListFetcher(&rpc.Client{L1Beacon: beacon}).Fetch(context.Background(), nil, meta)
+	require.ErrorIs(t, err, errSidecarNotFound)
+}
+
+func TestBlobFetcherLikelyPruned(t *testing.T) {
+	srv, meta := newDelayedBeaconServer(t, []byte("pruned txList"), time.Minute)
+	defer srv.Close()
+
+	// A beacon node retaining the sidecars for 10 epochs of 32 slots, i.e. 64 minutes.
+	retentionSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/eth/v1/config/spec" {
+			_, err := w.Write([]byte(`{"data":{"MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS":"10",` +
+				`"SLOTS_PER_EPOCH":"32","SECONDS_PER_SLOT":"12"}}`))
+			require.Nil(t, err)
+			return
+		}
+		srv.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer retentionSrv.Close()
+
+	beacon, err := rpc.NewBeaconClient(retentionSrv.URL, time.Second)
+	require.Nil(t, err)
+	fetcher := NewBlobTxListFetcher(&rpc.Client{L1Beacon: beacon})
+
+	// The block is within the retention window.
+	meta.Timestamp = uint64(time.Now().Add(-time.Hour).Unix())
+	_, err = fetcher.Fetch(context.Background(), nil, meta)
+	require.ErrorIs(t, err, errSidecarNotFound)
+	require.NotErrorIs(t, err, errBlobLikelyPruned)
+
+	// The block is beyond the retention window.
+	meta.Timestamp = uint64(time.Now().Add(-2 * time.Hour).Unix())
+	_, err = fetcher.Fetch(context.Background(), nil, meta)
+	require.ErrorIs(t, err, errBlobLikelyPruned)
+	require.ErrorIs(t, err, errSidecarNotFound)
+}
+
+func TestBlobFetcherMalformedCommitment(t *testing.T) {
+	data := []byte("txList after a malformed sidecar")
+	sidecar, err := rpc.MakeSidecar(data)
+	require.Nil(t, err)
+
+	var (
+		meta = &bindings.TaikoDataBlockMetadata{
+			BlobUsed:  true,
+			BlobHash:  kzg4844.CalcBlobHashV1(sha256.New(), &sidecar.Commitments[0]),
+			Timestamp: uint64(time.Now().Add(-time.Hour).Unix()),
+		}
+		malformed = &blob.Sidecar{
+			Blob:          common.Bytes2Hex(sidecar.Blobs[0][:]),
+			KzgCommitment: common.Bytes2Hex(sidecar.Commitments[0][:47]),
+		}
+		valid = &blob.Sidecar{
+			Blob:          common.Bytes2Hex(sidecar.Blobs[0][:]),
+			KzgCommitment: common.Bytes2Hex(sidecar.Commitments[0][:]),
+		}
+		res = &blob.SidecarsResponse{Data: []*blob.Sidecar{malformed, valid}}
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		require.Nil(t, json.NewEncoder(w).Encode(res))
+	}))
+	defer srv.Close()
+
+	beacon, err := rpc.NewBeaconClient(srv.URL, time.Second)
+	require.Nil(t, err)
+	fetcher := NewBlobTxListFetcher(&rpc.Client{L1Beacon: beacon})
+
+	// The malformed sidecar is skipped.
+	b, err := fetcher.Fetch(context.Background(), nil, meta)
+	require.Nil(t, err)
+	require.Equal(t, data, b)
+
+	// The malformed commitment is reported if no sidecar matches.
+	res.Data = []*blob.Sidecar{malformed}
+	_, err = fetcher.Fetch(context.Background(), nil, meta)
+	require.ErrorIs(t, err, errSidecarNotFound)
+	require.ErrorIs(t, err, errMalformedCommitment)
+
+	_, err = decodeCommitment("0x" + valid.KzgCommitment)
+	require.Nil(t, err)
+	_, err = decodeCommitment("zz" + valid.KzgCommitment[2:])
+	require.ErrorIs(t, err, errMalformedCommitment)
+}
+
+func TestBlobFetcherBlobCommitmentMismatch(t *testing.T) {
+	data := []byte("txList served by the fallback source")
+	fallbackSrv, meta := newDelayedBeaconServer(t, data, 0)
+	defer fallbackSrv.Close()
+	meta.Timestamp = uint64(time.Now().Add(-time.Hour).Unix())
+
+	// The primary beacon node serves a forged blob along with the proposed blob's commitment.
+	proposed, err := rpc.MakeSidecar(data)
+	require.Nil(t, err)
+	forged, err := rpc.MakeSidecar([]byte("forged txList"))
+	require.Nil(t, err)
+	primarySrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		require.Nil(t, json.NewEncoder(w).Encode(&blob.SidecarsResponse{Data: []*blob.Sidecar{{
+			Blob:          common.Bytes2Hex(forged.Blobs[0][:]),
+			KzgCommitment: common.Bytes2Hex(proposed.Commitments[0][:]),
+		}}}))
+	}))
+	defer primarySrv.Close()
+
+	primary, err := rpc.NewBeaconClient(primarySrv.URL, time.Second)
+	require.Nil(t, err)
+	fallback, err := rpc.NewBeaconClient(fallbackSrv.URL, time.Second)
+	require.Nil(t, err)
+
+	_, err = NewBlobTxListFetcher(&rpc.Client{L1Beacon: primary}).Fetch(context.Background(), nil, meta)
+	require.ErrorIs(t, err, errBlobCommitmentMismatch)
+
+	// The verified blob of the fallback source is used instead.
+	b, err := NewBlobTxListFetcher(
+		&rpc.Client{L1Beacon: primary, L1BeaconFallback: fallback},
+	).Fetch(context.Background(), nil, meta)
+	require.Nil(t, err)
+	require.Equal(t, data, b)
+}
+
+func TestBlobFetcherFallback(t *testing.T) {
+	data := []byte("txList served by the fallback source")
+	fallbackSrv, meta := newDelayedBeaconServer(t, data, 0)
+	defer fallbackSrv.Close()
+	meta.Timestamp = uint64(time.Now().Add(-time.Hour).Unix())
+
+	// The primary beacon node doesn't have the sidecar.
+	primarySrv, _ := newDelayedBeaconServer(t, []byte("another txList"), 0)
+	defer primarySrv.Close()
+
+	primary, err := rpc.NewBeaconClient(primarySrv.URL, time.Second)
+	require.Nil(t, err)
+	fallback, err := rpc.NewBeaconClient(fallbackSrv.URL, time.Second)
+	require.Nil(t, err)
+
+	// No fallback source configured.
+	_, err = NewBlobTxListFetcher(&rpc.Client{L1Beacon: primary}).Fetch(context.Background(), nil, meta)
+	require.ErrorIs(t, err, errSidecarNotFound)
+
+	// The fallback source serves the sidecar.
+	fetcher := NewBlobTxListFetcher(&rpc.Client{L1Beacon: primary, L1BeaconFallback: fallback})
+	b, err := fetcher.Fetch(context.Background(), nil, meta)
+	require.Nil(t, err)
+	require.Equal(t, data, b)
+
+	// The primary beacon node is unreachable.
+	primarySrv.Close()
+	b, err = fetcher.Fetch(context.Background(), nil, meta)
+	require.Nil(t, err)
+	require.Equal(t, data, b)
+
+	// The fallback source's sidecars are verified against the blob hash as well.
+	meta.BlobHash = testutils.RandomHash()
+	meta.BlobHash[0] = 0x01
+	_, err = fetcher.Fetch(context.Background(), nil, meta)
+	require.ErrorIs(t, err, errSidecarNotFound)
+}
+
+func TestBlobFetcherMultipleBlobs(t *testing.T) {
+	var (
+		chunks     = [][]byte{[]byte("first part of the txList, "), []byte("second part, "), []byte("third part")}
+		sidecars   []*blob.Sidecar
+		blobHashes []common.Hash
+	)
+	for _, chunk := range chunks {
+		sidecar, err := rpc.MakeSidecar(chunk)
+		require.Nil(t, err)
+
+		sidecars = append(sidecars, &blob.Sidecar{
+			Blob:          common.Bytes2Hex(sidecar.Blobs[0][:]),
+			KzgCommitment: common.Bytes2Hex(sidecar.Commitments[0][:]),
+		})
+		blobHashes = append(blobHashes, kzg4844.CalcBlobHashV1(sha256.New(), &sidecar.Commitments[0]))
+	}
+
+	// The sidecars are served out of order.
+	res := &blob.SidecarsResponse{Data: []*blob.Sidecar{sidecars[2], sidecars[1], sidecars[0]}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		require.Nil(t, json.NewEncoder(w).Encode(res))
+	}))
+	defer srv.Close()
+
+	beacon, err := rpc.NewBeaconClient(srv.URL, time.Second)
+	require.Nil(t, err)
+
+	var (
+		tx   = types.NewTx(&types.BlobTx{BlobHashes: blobHashes})
+		meta = &bindings.TaikoDataBlockMetadata{
+			BlobUsed:  true,
+			BlobHash:  blobHashes[0],
+			Timestamp: uint64(time.Now().Add(-time.Hour).Unix()),
+		}
+		fetcher = NewBlobTxListFetcher(&rpc.Client{L1Beacon: beacon})
+	)
+
+	// Only the block's blob is fetched by default.
+	b, err := fetcher.Fetch(context.Background(), tx, meta)
+	require.Nil(t, err)
+	require.Equal(t, chunks[0], b)
+
+	// A single blob proposing transaction.
+	fetcher.SetMultiBlobTxList(true)
+	b, err = fetcher.Fetch(context.Background(), types.NewTx(&types.BlobTx{BlobHashes: blobHashes[:1]}), meta)
+	require.Nil(t, err)
+	require.Equal(t, chunks[0], b)
+
+	// The blobs are concatenated in order.
+	b, err = fetcher.Fetch(context.Background(), tx, meta)
+	require.Nil(t, err)
+	require.Equal(t, append(append(append([]byte{}, chunks[0]...), chunks[1]...), chunks[2]...), b)
+
+	// The blob set starts at the block's blob hash.
+	meta.BlobHash = blobHashes[1]
+	b, err = fetcher.Fetch(context.Background(), tx, meta)
+	require.Nil(t, err)
+	require.Equal(t, append(append([]byte{}, chunks[1]...), chunks[2]...), b)
+
+	// A missing blob fails the whole set.
+	res.Data = []*blob.Sidecar{sidecars[1]}
+	_, err = fetcher.Fetch(context.Background(), tx, meta)
+	require.ErrorIs(t, err, errSidecarNotFound)
+	require.ErrorContains(t, err, "blob 2 of 2")
+
+	// The block's blob hash must be one of the proposing transaction's blob hashes.
+	meta.BlobHash = testutils.RandomHash()
+	meta.BlobHash[0] = 0x01
+	_, err = fetcher.Fetch(context.Background(), tx, meta)
+	require.ErrorIs(t, err, errSidecarNotFound)
+}
+
+func TestBlobFetcherMetrics(t *testing.T) {
+	enabled := gethMetrics.Enabled
+	gethMetrics.Enabled = true
+	defer func() { gethMetrics.Enabled = enabled }()
+	// Drop the no-op metrics registered by the other tests, when the metrics were disabled.
+	for _, source := range []string{blobSourceBeacon, blobSourceFallback} {
+		gethMetrics.DefaultRegistry.Unregister("driver/blob/fetch/" + source + "/latency")
+		for _, reason := range []string{"sidecarNotFound", "blobUnused", "kzgMismatch", "pruned", "other"} {
+			gethMetrics.DefaultRegistry.Unregister("driver/blob/fetch/" + source + "/failed/" + reason)
+		}
+	}
+
+	data := []byte("txList served by the fallback source")
+	fallbackSrv, meta := newDelayedBeaconServer(t, data, 0)
+	defer fallbackSrv.Close()
+	meta.Timestamp = uint64(time.Now().Add(-time.Hour).Unix())
+
+	primarySrv, _ := newDelayedBeaconServer(t, []byte("another txList"), 0)
+	defer primarySrv.Close()
+
+	primary, err := rpc.NewBeaconClient(primarySrv.URL, time.Second)
+	require.Nil(t, err)
+	fallback, err := rpc.NewBeaconClient(fallbackSrv.URL, time.Second)
+	require.Nil(t, err)
+
+	latency := func(source string) int64 {
+		return metrics.DriverBlobFetchLatencyHistogram(source).Snapshot().Count()
+	}
+	failed := func(source string, reason string) int64 {
+		return metrics.DriverBlobFetchFailedCounter(source, reason).Snapshot().Count()
+	}
+
+	var (
+		fetcher         = NewBlobTxListFetcher(&rpc.Client{L1Beacon: primary, L1BeaconFallback: fallback})
+		beaconLatency   = latency(blobSourceBeacon)
+		fallbackLatency = latency(blobSourceFallback)
+		notFound        = failed(blobSourceBeacon, "sidecarNotFound")
+		fallbackFailed  = failed(blobSourceFallback, "sidecarNotFound")
+		blobUnused      = failed(blobSourceBeacon, "blobUnused")
+	)
+
+	// The primary source doesn't have the sidecar, the fallback source serves it.
+	_, err = fetcher.Fetch(context.Background(), nil, meta)
+	require.Nil(t, err)
+	require.Equal(t, beaconLatency+1, latency(blobSourceBeacon))
+	require.Equal(t, fallbackLatency+1, latency(blobSourceFallback))
+	require.Equal(t, notFound+1, failed(blobSourceBeacon, "sidecarNotFound"))
+	require.Equal(t, fallbackFailed, failed(blobSourceFallback, "sidecarNotFound"))
+
+	// Neither source has the sidecar.
+	meta.BlobHash = testutils.RandomHash()
+	meta.BlobHash[0] = 0x01
+	_, err = fetcher.Fetch(context.Background(), nil, meta)
+	require.ErrorIs(t, err, errSidecarNotFound)
+	require.Equal(t, notFound+2, failed(blobSourceBeacon, "sidecarNotFound"))
+	require.Equal(t, fallbackFailed+1, failed(blobSourceFallback, "sidecarNotFound"))
+
+	meta.BlobUsed = false
+	_, err = fetcher.Fetch(context.Background(), nil, meta)
+	require.ErrorIs(t, err, errBlobUnused)
+	require.Equal(t, blobUnused+1, failed(blobSourceBeacon, "blobUnused"))
+}
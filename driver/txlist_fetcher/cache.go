@@ -0,0 +1,99 @@
+package txlistdecoder
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+// DefaultTxListCacheSize is the default number of the latest fetched transactions lists kept by a TxListCache.
+const DefaultTxListCacheSize = 128
+
+// txListCacheKey identifies a fetched transactions list, the blob hash is the versioned hash of the blob,
+// or the hash of the calldata transactions list.
+type txListCacheKey struct {
+	blobHash common.Hash
+	l1Height uint64
+}
+
+// TxListCache caches the fetched transactions lists, so the transactions lists of the re-processed
+// `BlockProposed` events won't be fetched and decoded again, it is safe for concurrent use.
+type TxListCache struct {
+	cache *lru.Cache[txListCacheKey, []byte]
+}
+
+// NewTxListCache creates a new TxListCache instance, which keeps the given number of the latest fetched
+// transactions lists, nil is returned if the size is 0, which disables the cache.
+func NewTxListCache(size uint64) *TxListCache {
+	if size == 0 {
+		return nil
+	}
+	return &TxListCache{cache: lru.NewCache[txListCacheKey, []byte](int(size))}
+}
+
+// Wrap wraps the given fetcher, so its fetched transactions lists are cached, the given fetcher is
+// returned as is if the cache is disabled.
+func (c *TxListCache) Wrap(fetcher TxListFetcher) TxListFetcher {
+	if c == nil {
+		return fetcher
+	}
+	return &cachedFetcher{cache: c, fetcher: fetcher}
+}
+
+// InvalidateFrom drops the cached transactions lists whose L1 height is not less than the given height,
+// it should be called when the L1 blocks since the given height have been reorged.
+func (c *TxListCache) InvalidateFrom(l1Height uint64) {
+	if c == nil {
+		return
+	}
+
+	var invalidated int
+	for _, key := range c.cache.Keys() {
+		if key.l1Height >= l1Height && c.cache.Remove(key) {
+			invalidated++
+		}
+	}
+
+	log.Debug("Invalidate cached transactions lists", "fromL1Height", l1Height, "invalidated", invalidated)
+}
+
+// Len returns the number of the cached transactions lists.
+func (c *TxListCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	return c.cache.Len()
+}
+
+// cachedFetcher is a TxListFetcher which short-circuits the fetches of the cached transactions lists.
+type cachedFetcher struct {
+	cache   *TxListCache
+	fetcher TxListFetcher
+}
+
+// Fetch implements the TxListFetcher interface.
+func (f *cachedFetcher) Fetch(
+	ctx context.Context,
+	tx *types.Transaction,
+	meta *bindings.TaikoDataBlockMetadata,
+) ([]byte, error) {
+	key := txListCacheKey{blobHash: meta.BlobHash, l1Height: meta.L1Height}
+	if b, ok := f.cache.cache.Get(key); ok {
+		log.Debug("Cached transactions list hit", "blobHash", key.blobHash, "l1Height", key.l1Height)
+		return b, nil
+	}
+
+	// The errors are never cached, so the failed fetches will be retried.
+	b, err := f.fetcher.Fetch(ctx, tx, meta)
+	if err != nil {
+		return nil, err
+	}
+	f.cache.cache.Add(key, b)
+
+	return b, nil
+}
@@ -0,0 +1,98 @@
+package txlistdecoder
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/internal/testutils"
+)
+
+// countingFetcher is a TxListFetcher which counts its fetches.
+type countingFetcher struct {
+	fetches int
+	err     error
+}
+
+func (f *countingFetcher) Fetch(
+	_ context.Context,
+	_ *types.Transaction,
+	meta *bindings.TaikoDataBlockMetadata,
+) ([]byte, error) {
+	f.fetches++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return meta.BlobHash[:], nil
+}
+
+func TestTxListCache(t *testing.T) {
+	var (
+		cache   = NewTxListCache(DefaultTxListCacheSize)
+		fetcher = new(countingFetcher)
+		metas   = []*bindings.TaikoDataBlockMetadata{
+			{BlobHash: testutils.RandomHash(), L1Height: 10},
+			{BlobHash: testutils.RandomHash(), L1Height: 11},
+			{BlobHash: testutils.RandomHash(), L1Height: 12},
+		}
+	)
+
+	// The repeated fetches are short-circuited.
+	for i := 0; i < 2; i++ {
+		for _, meta := range metas {
+			b, err := cache.Wrap(fetcher).Fetch(context.Background(), nil, meta)
+			require.Nil(t, err)
+			require.Equal(t, meta.BlobHash[:], b)
+		}
+	}
+	require.Equal(t, len(metas), fetcher.fetches)
+	require.Equal(t, len(metas), cache.Len())
+
+	// The same transactions list proposed at another L1 height is fetched again.
+	_, err := cache.Wrap(fetcher).Fetch(
+		context.Background(),
+		nil,
+		&bindings.TaikoDataBlockMetadata{BlobHash: metas[0].BlobHash, L1Height: 13},
+	)
+	require.Nil(t, err)
+	require.Equal(t, len(metas)+1, fetcher.fetches)
+
+	// The reorged transactions lists are invalidated.
+	cache.InvalidateFrom(11)
+	require.Equal(t, 1, cache.Len())
+	_, err = cache.Wrap(fetcher).Fetch(context.Background(), nil, metas[1])
+	require.Nil(t, err)
+	require.Equal(t, len(metas)+2, fetcher.fetches)
+
+	// The errors are not cached.
+	failing := &countingFetcher{err: errors.New("sidecar not found")}
+	meta := &bindings.TaikoDataBlockMetadata{BlobHash: testutils.RandomHash(), L1Height: 14}
+	for i := 0; i < 2; i++ {
+		_, err = cache.Wrap(failing).Fetch(context.Background(), nil, meta)
+		require.ErrorIs(t, err, failing.err)
+	}
+	require.Equal(t, 2, failing.fetches)
+}
+
+func TestTxListCacheDisabled(t *testing.T) {
+	var (
+		cache   = NewTxListCache(0)
+		fetcher = new(countingFetcher)
+		meta    = &bindings.TaikoDataBlockMetadata{BlobHash: testutils.RandomHash()}
+	)
+	require.Nil(t, cache)
+	require.Equal(t, fetcher, cache.Wrap(fetcher))
+
+	for i := 0; i < 2; i++ {
+		_, err := cache.Wrap(fetcher).Fetch(context.Background(), nil, meta)
+		require.Nil(t, err)
+	}
+	require.Equal(t, 2, fetcher.fetches)
+
+	cache.InvalidateFrom(0)
+	require.Zero(t, cache.Len())
+}
@@ -9,9 +9,18 @@ import (
 )
 
 var (
-	errBlobUsed        = errors.New("blob is used")
-	errBlobUnused      = errors.New("blob is not used")
-	errSidecarNotFound = errors.New("sidecar not found")
+	errBlobUsed          = errors.New("blob is used")
+	errBlobUnused        = errors.New("blob is not used")
+	errSidecarNotFound   = errors.New("sidecar not found")
+	errMalformedBlobHash = errors.New("malformed blob versioned hash")
+	// errMalformedCommitment is returned when a sidecar's KZG commitment is not a 48 bytes hex string,
+	// which usually indicates a beacon node bug.
+	errMalformedCommitment = errors.New("malformed sidecar KZG commitment")
+	// errBlobCommitmentMismatch is returned when a sidecar's blob doesn't match the sidecar's KZG commitment.
+	errBlobCommitmentMismatch = errors.New("blob doesn't match the sidecar KZG commitment")
+	// errBlobLikelyPruned is returned when the L1 beacon node fails to serve the blob of a block proposed
+	// before its blob retention horizon.
+	errBlobLikelyPruned = errors.New("blob likely pruned, slot older than retention")
 )
 
 // TxListFetcher is responsible for fetching the L2 txList bytes from L1
@@ -0,0 +1,180 @@
+package errlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// DefaultCapacity is the number of the latest errors kept for each component.
+const DefaultCapacity = 64
+
+// Components which record their errors.
+const (
+	ComponentDriver   = "driver"
+	ComponentProposer = "proposer"
+	ComponentProver   = "prover"
+	ComponentSender   = "sender"
+)
+
+const redacted = "[REDACTED]"
+
+var (
+	// Secrets which may be carried by the error messages, e.g. the RPC endpoints with credentials, the
+	// bearer tokens and the raw private keys.
+	secretPatterns = []struct {
+		pattern     *regexp.Regexp
+		replacement string
+	}{
+		{regexp.MustCompile(`([a-zA-Z][a-zA-Z0-9+.-]*://)[^/\s:@]+(:[^/\s@]*)?@`), "${1}" + redacted + "@"},
+		{regexp.MustCompile(`(?i)((?:api[_-]?key|token|secret|password|jwt|key)=)[^&\s"']+`), "${1}" + redacted},
+		{regexp.MustCompile(`(?i)(bearer\s+)[a-z0-9._~+/=-]+`), "${1}" + redacted},
+		{regexp.MustCompile(`\b[0-9a-fA-F]{64}\b`), redacted},
+	}
+	// Context keys whose values are always redacted.
+	secretKeyPattern = regexp.MustCompile(`(?i)(private|secret|token|password|jwt|apikey|api_key)`)
+)
+
+// Entry is a recorded error.
+type Entry struct {
+	Time      time.Time         `json:"time"`
+	Component string            `json:"component"`
+	Message   string            `json:"message"`
+	Error     string            `json:"error"`
+	Context   map[string]string `json:"context,omitempty"`
+}
+
+// Buffer is a bounded ring buffer keeping the latest errors of a component, it is safe for
+// concurrent use.
+type Buffer struct {
+	component string
+
+	mu      sync.Mutex
+	entries []*Entry
+	next    int
+	full    bool
+}
+
+// NewBuffer creates a new Buffer instance, which keeps the latest given number of errors.
+func NewBuffer(component string, capacity int) *Buffer {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	return &Buffer{component: component, entries: make([]*Entry, capacity)}
+}
+
+// Record records the given error with its message and key-value context, the secrets in them are
+// redacted.
+func (b *Buffer) Record(msg string, err error, ctx ...interface{}) {
+	if err == nil {
+		return
+	}
+
+	entry := &Entry{
+		Time:      time.Now(),
+		Component: b.component,
+		Message:   redact(msg),
+		Error:     redact(err.Error()),
+	}
+	for i := 0; i+1 < len(ctx); i += 2 {
+		if entry.Context == nil {
+			entry.Context = make(map[string]string)
+		}
+		key := fmt.Sprint(ctx[i])
+		if secretKeyPattern.MatchString(key) {
+			entry.Context[key] = redacted
+			continue
+		}
+		entry.Context[key] = redact(fmt.Sprint(ctx[i+1]))
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.entries[b.next] = entry
+	b.next = (b.next + 1) % len(b.entries)
+	if b.next == 0 {
+		b.full = true
+	}
+}
+
+// Entries returns the recorded errors, from the oldest to the latest.
+func (b *Buffer) Entries() []*Entry {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.full {
+		return append([]*Entry{}, b.entries[:b.next]...)
+	}
+	return append(append([]*Entry{}, b.entries[b.next:]...), b.entries[:b.next]...)
+}
+
+// redact replaces the secrets in the given string.
+func redact(s string) string {
+	for _, p := range secretPatterns {
+		s = p.pattern.ReplaceAllString(s, p.replacement)
+	}
+	return s
+}
+
+var (
+	buffersMu sync.Mutex
+	buffers   = make(map[string]*Buffer)
+)
+
+// For returns the error buffer of the given component, the same buffer will be returned if it has
+// already been created.
+func For(component string) *Buffer {
+	buffersMu.Lock()
+	defer buffersMu.Unlock()
+
+	if b, ok := buffers[component]; ok {
+		return b
+	}
+	b := NewBuffer(component, DefaultCapacity)
+	buffers[component] = b
+
+	return b
+}
+
+// Record records the given error into the error buffer of the given component.
+func Record(component string, msg string, err error, ctx ...interface{}) {
+	For(component).Record(msg, err, ctx...)
+}
+
+// Snapshot returns the recorded errors of all components.
+func Snapshot() map[string][]*Entry {
+	buffersMu.Lock()
+	defer buffersMu.Unlock()
+
+	snapshot := make(map[string][]*Entry, len(buffers))
+	for component, b := range buffers {
+		snapshot[component] = b.Entries()
+	}
+
+	return snapshot
+}
+
+// Handler returns the handler of the `/errors` endpoint, which serves the recorded errors of all
+// components, or only the component given by the `component` query parameter.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		res := Snapshot()
+		if component := r.URL.Query().Get("component"); component != "" {
+			res = map[string][]*Entry{component: res[component]}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -5,14 +5,17 @@ import (
 	"net"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/metrics/prometheus"
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-client/internal/errlog"
 )
 
 // Metrics
@@ -23,25 +26,54 @@ var (
 	DriverL1CurrentHeightGauge  = metrics.NewRegisteredGauge("driver/l1Current/height", nil)
 	DriverL2HeadIDGauge         = metrics.NewRegisteredGauge("driver/l2Head/id", nil)
 	DriverL2VerifiedHeightGauge = metrics.NewRegisteredGauge("driver/l2Verified/id", nil)
+	// Transactions lists carrying transactions with an unexpected chain ID
+	DriverTxListChainIDMismatchCounter = metrics.NewRegisteredCounter("driver/txList/chainIDMismatch", nil)
 
 	// Proposer
-	ProposerProposeEpochCounter    = metrics.NewRegisteredCounter("proposer/epoch", nil)
-	ProposerProposedTxListsCounter = metrics.NewRegisteredCounter("proposer/proposed/txLists", nil)
-	ProposerProposedTxsCounter     = metrics.NewRegisteredCounter("proposer/proposed/txs", nil)
+	ProposerProposeEpochCounter     = metrics.NewRegisteredCounter("proposer/epoch", nil)
+	ProposerProposedTxListsCounter  = metrics.NewRegisteredCounter("proposer/proposed/txLists", nil)
+	ProposerProposedTxsCounter      = metrics.NewRegisteredCounter("proposer/proposed/txs", nil)
+	ProposerEconomicBlobCounter     = metrics.NewRegisteredCounter("proposer/economic/blob", nil)
+	ProposerEconomicCalldataCounter = metrics.NewRegisteredCounter("proposer/economic/calldata", nil)
+	ProposerBlobFallbackCounter     = metrics.NewRegisteredCounter("proposer/blob/fallback", nil)
+	ProposerProtocolPausedGauge     = metrics.NewRegisteredGauge("proposer/protocol/paused", nil)
+	ProposerTierFeeMultiplierGauge  = metrics.NewRegisteredGauge("proposer/tierFee/multiplier", nil)
+	ProposerSoftPausedGauge         = metrics.NewRegisteredGauge("proposer/softPause/paused", nil)
+	// Effective proposing interval in milliseconds, longer than the configured one during a soft-pause
+	ProposerEffectiveProposeIntervalGauge = metrics.NewRegisteredGauge("proposer/proposeInterval/effective", nil)
 
 	// Prover
-	ProverLatestVerifiedIDGauge      = metrics.NewRegisteredGauge("prover/latestVerified/id", nil)
-	ProverLatestProvenBlockIDGauge   = metrics.NewRegisteredGauge("prover/latestProven/id", nil)
-	ProverQueuedProofCounter         = metrics.NewRegisteredCounter("prover/proof/all/queued", nil)
-	ProverReceivedProofCounter       = metrics.NewRegisteredCounter("prover/proof/all/received", nil)
-	ProverSentProofCounter           = metrics.NewRegisteredCounter("prover/proof/all/sent", nil)
-	ProverProofsAssigned             = metrics.NewRegisteredCounter("prover/proof/assigned", nil)
-	ProverReceivedProposedBlockGauge = metrics.NewRegisteredGauge("prover/proposed/received", nil)
-	ProverReceivedProvenBlockGauge   = metrics.NewRegisteredGauge("prover/proven/received", nil)
-	ProverSubmissionAcceptedCounter  = metrics.NewRegisteredCounter("prover/proof/submission/accepted", nil)
-	ProverSubmissionErrorCounter     = metrics.NewRegisteredCounter("prover/proof/submission/error", nil)
-	ProverSgxProofGeneratedCounter   = metrics.NewRegisteredCounter("prover/proof/sgx/generated", nil)
-	ProverPseProofGeneratedCounter   = metrics.NewRegisteredCounter("prover/proof/pse/generated", nil)
+	ProverLatestVerifiedIDGauge            = metrics.NewRegisteredGauge("prover/latestVerified/id", nil)
+	ProverLatestProvenBlockIDGauge         = metrics.NewRegisteredGauge("prover/latestProven/id", nil)
+	ProverQueuedProofCounter               = metrics.NewRegisteredCounter("prover/proof/all/queued", nil)
+	ProverReceivedProofCounter             = metrics.NewRegisteredCounter("prover/proof/all/received", nil)
+	ProverSentProofCounter                 = metrics.NewRegisteredCounter("prover/proof/all/sent", nil)
+	ProverProofsAssigned                   = metrics.NewRegisteredCounter("prover/proof/assigned", nil)
+	ProverReceivedProposedBlockGauge       = metrics.NewRegisteredGauge("prover/proposed/received", nil)
+	ProverReceivedProvenBlockGauge         = metrics.NewRegisteredGauge("prover/proven/received", nil)
+	ProverSubmissionAcceptedCounter        = metrics.NewRegisteredCounter("prover/proof/submission/accepted", nil)
+	ProverProofTooOldCounter               = metrics.NewRegisteredCounter("prover/proof/tooOld", nil)
+	ProverNotAssignedAbandonedCounter      = metrics.NewRegisteredCounter("prover/proof/notAssigned/abandoned", nil)
+	ProverFilteredBlocksCounter            = metrics.NewRegisteredCounter("prover/proof/filtered", nil)
+	ProverLateProofAcceptedCounter         = metrics.NewRegisteredCounter("prover/proof/late/accepted", nil)
+	ProverSubmissionErrorCounter           = metrics.NewRegisteredCounter("prover/proof/submission/error", nil)
+	ProverProofNotReflectedCounter         = metrics.NewRegisteredCounter("prover/proof/notReflected", nil)
+	ProverSyncLagGauge                     = metrics.NewRegisteredGauge("prover/sync/lag", nil)
+	ProverReorgTooDeepCounter              = metrics.NewRegisteredCounter("prover/reorg/tooDeep", nil)
+	ProverContestBondInsufficientCounter   = metrics.NewRegisteredCounter("prover/contest/bond/insufficient", nil)
+	ProverContestWonCounter                = metrics.NewRegisteredCounter("prover/contest/won", nil)
+	ProverContestLostCounter               = metrics.NewRegisteredCounter("prover/contest/lost", nil)
+	ProverSyncInterlockGauge               = metrics.NewRegisteredGauge("prover/sync/interlock", nil)
+	ProverLeaderGauge                      = metrics.NewRegisteredGauge("prover/leader", nil)
+	ProverPendingSubmissionsGauge          = metrics.NewRegisteredGauge("prover/proof/submission/pending", nil)
+	ProverProtocolPausedGauge              = metrics.NewRegisteredGauge("prover/protocol/paused", nil)
+	ProverTaikoTokenBalanceGauge           = metrics.NewRegisteredGaugeFloat64("prover/taikoToken/balance", nil)
+	ProverBondAllowanceGauge               = metrics.NewRegisteredGaugeFloat64("prover/bond/allowance", nil)
+	ProverBondAssignmentsGauge             = metrics.NewRegisteredGauge("prover/bond/assignments", nil)
+	ProverBalanceRunwayInsufficientCounter = metrics.NewRegisteredCounter("prover/balance/runway/insufficient", nil)
+	ProverBalanceAlertFailedCounter        = metrics.NewRegisteredCounter("prover/balance/alert/failed", nil)
+	ProverSgxProofGeneratedCounter         = metrics.NewRegisteredCounter("prover/proof/sgx/generated", nil)
+	ProverPseProofGeneratedCounter         = metrics.NewRegisteredCounter("prover/proof/pse/generated", nil)
 
 	// Transaction sender
 	TxSenderSentCounter                = metrics.NewRegisteredCounter("sender/sent/txs", nil)
@@ -51,11 +83,104 @@ var (
 	TxSenderGasPriceGauge              = metrics.NewRegisteredGauge("sender/gasPrice", nil)
 	TxSenderBlobGasPriceGauge          = metrics.NewRegisteredGauge("sender/blob/gasPrice", nil)
 	TxSenderTxIncludedTimeGauge        = metrics.NewRegisteredGauge("sender/tx/includedTime", nil)
+	TxSenderStuckReplacedCounter       = metrics.NewRegisteredCounter("sender/stuck/replaced/txs", nil)
+	TxSenderGasMarginConsumedCounter   = metrics.NewRegisteredCounter("sender/gasMargin/consumed/txs", nil)
+
+	// RPC
+	RPCEndpointFailoverCounter = metrics.NewRegisteredCounter("rpc/endpoint/failovers", nil)
 )
 
-// Serve starts the metrics server on the given address, will be closed when the given
-// context is cancelled.
-func Serve(ctx context.Context, c *cli.Context) error {
+// TxSenderNonceMetrics contains the nonce management metrics of a transaction sender account.
+type TxSenderNonceMetrics struct {
+	TrackedNonceGauge           metrics.Gauge
+	PendingNonceGauge           metrics.Gauge
+	NonceGapCounter             metrics.Counter
+	ReplacementCounter          metrics.Counter
+	OldestUnconfirmedTxAgeGauge metrics.Gauge
+	DuplicateNonceCounter       metrics.Counter
+	RateLimitQueueGauge         metrics.Gauge
+}
+
+// NewTxSenderNonceMetrics returns the nonce management metrics of the given sender account,
+// the same metrics will be returned if they have already been registered.
+func NewTxSenderNonceMetrics(account common.Address) *TxSenderNonceMetrics {
+	prefix := "sender/" + strings.ToLower(account.Hex())
+	return &TxSenderNonceMetrics{
+		TrackedNonceGauge:           metrics.GetOrRegisterGauge(prefix+"/nonce/tracked", nil),
+		PendingNonceGauge:           metrics.GetOrRegisterGauge(prefix+"/nonce/pending", nil),
+		NonceGapCounter:             metrics.GetOrRegisterCounter(prefix+"/nonce/gaps", nil),
+		ReplacementCounter:          metrics.GetOrRegisterCounter(prefix+"/replacements", nil),
+		OldestUnconfirmedTxAgeGauge: metrics.GetOrRegisterGauge(prefix+"/unconfirmed/oldestAge", nil),
+		DuplicateNonceCounter:       metrics.GetOrRegisterCounter(prefix+"/nonce/duplicates", nil),
+		RateLimitQueueGauge:         metrics.GetOrRegisterGauge(prefix+"/rateLimit/queued", nil),
+	}
+}
+
+// ProposerSkippedCounter returns the counter of the proposing epochs skipped for the given reason,
+// the same counter will be returned if it has already been registered.
+func ProposerSkippedCounter(reason string) metrics.Counter {
+	return metrics.GetOrRegisterCounter("proposer/skipped/"+reason, nil)
+}
+
+// DriverBlobFetchLatencyHistogram returns the histogram of the L1 beacon blob sidecars fetching round-trip
+// latency in milliseconds of the given source, the same histogram will be returned if it has already
+// been registered.
+func DriverBlobFetchLatencyHistogram(source string) metrics.Histogram {
+	return metrics.GetOrRegisterHistogram(
+		"driver/blob/fetch/"+source+"/latency",
+		nil,
+		metrics.NewExpDecaySample(1028, 0.015),
+	)
+}
+
+// DriverBlobFetchFailedCounter returns the counter of the blob fetching failures of the given source and
+// reason, the same counter will be returned if it has already been registered.
+func DriverBlobFetchFailedCounter(source string, reason string) metrics.Counter {
+	return metrics.GetOrRegisterCounter("driver/blob/fetch/"+source+"/failed/"+reason, nil)
+}
+
+// ProverSubmissionDeferredHistogram returns the histogram of how long the proof submissions are deferred in
+// milliseconds, due to the L1 base fee exceeding their tier's gas price ceiling, the same histogram will be
+// returned if it has already been registered.
+func ProverSubmissionDeferredHistogram() metrics.Histogram {
+	return metrics.GetOrRegisterHistogram("prover/proof/submission/deferred", nil, metrics.NewExpDecaySample(1028, 0.015))
+}
+
+// ProverMarketAssignmentsAcceptedCounter returns the counter of the proof assignments signed by the prover,
+// the same counter will be returned if it has already been registered.
+func ProverMarketAssignmentsAcceptedCounter() metrics.Counter {
+	return metrics.GetOrRegisterCounter("prover/market/assignments/accepted", nil)
+}
+
+// ProverMarketProofsFirstCounter returns the counter of the blocks proven by the prover before any other
+// prover, the same counter will be returned if it has already been registered.
+func ProverMarketProofsFirstCounter() metrics.Counter {
+	return metrics.GetOrRegisterCounter("prover/market/proofs/first", nil)
+}
+
+// ProverMarketProofsBeatenCounter returns the counter of the blocks assigned to the prover but proven by
+// another prover first, the same counter will be returned if it has already been registered.
+func ProverMarketProofsBeatenCounter() metrics.Counter {
+	return metrics.GetOrRegisterCounter("prover/market/proofs/beaten", nil)
+}
+
+// ProverRequestProofTimeoutCounter returns the counter of the proof requests which timed out, by the
+// given source of the timeout, i.e. the prover's own proof request timeout, or the caller's context, the
+// same counter will be returned if it has already been registered.
+func ProverRequestProofTimeoutCounter(source string) metrics.Counter {
+	return metrics.GetOrRegisterCounter("prover/proof/request/timeout/"+source, nil)
+}
+
+// RPCEndpointActiveGauge returns the gauge of whether the given redundant RPC endpoint is the active one,
+// the same gauge will be returned if it has already been registered.
+func RPCEndpointActiveGauge(endpoint string) metrics.Gauge {
+	return metrics.GetOrRegisterGauge("rpc/endpoint/"+endpoint+"/active", nil)
+}
+
+// Serve starts the metrics server on the given address, which also serves the latest errors of each
+// component at `/errors`, and the given health check handler at `/healthz` if it's not nil, will be closed
+// when the given context is cancelled.
+func Serve(ctx context.Context, c *cli.Context, healthHandler http.Handler) error {
 	if !c.Bool(flags.MetricsEnabled.Name) {
 		return nil
 	}
@@ -65,10 +190,17 @@ func Serve(ctx context.Context, c *cli.Context) error {
 		strconv.Itoa(c.Int(flags.MetricsPort.Name)),
 	)
 
+	mux := http.NewServeMux()
+	mux.Handle("/errors", errlog.Handler())
+	if healthHandler != nil {
+		mux.Handle("/healthz", healthHandler)
+	}
+	mux.Handle("/", prometheus.Handler(metrics.DefaultRegistry))
+
 	server := http.Server{
 		ReadHeaderTimeout: time.Minute,
 		Addr:              address,
-		Handler:           prometheus.Handler(metrics.DefaultRegistry),
+		Handler:           mux,
 	}
 
 	go func() {
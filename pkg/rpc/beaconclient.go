@@ -7,6 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -16,24 +20,162 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/blob"
 )
 
-var (
-	// Request urls.
-	sidecarsRequestURL = "eth/v1/beacon/blob_sidecars/%d"
+const (
+	// DefaultSidecarsPathTemplate is the default path template of the blob sidecars beacon API.
+	DefaultSidecarsPathTemplate = "eth/v1/beacon/blob_sidecars/{slot}"
+	// slotPlaceholder is replaced with the requested slot in a sidecars path template.
+	slotPlaceholder = "{slot}"
 )
 
+// ErrInvalidSidecarsPathTemplate is returned when a blob sidecars path template is invalid.
+var ErrInvalidSidecarsPathTemplate = errors.New("invalid blob sidecars path template")
+
 type BeaconClient struct {
 	*beacon.Client
 
 	timeout time.Duration
+	// Path template of the blob sidecars API, where {slot} is replaced with the requested slot
+	sidecarsPathTemplate string
+	// Slots of the concurrent blob fetches to this endpoint, nil means no limit
+	fetchSlots chan struct{}
+	// Cached blob retention window in nanoseconds, 0 means not fetched yet
+	retentionWindow atomic.Int64
 }
 
-// NewBeaconClient returns a new beacon client.
-func NewBeaconClient(endpoint string, timeout time.Duration) (*BeaconClient, error) {
-	cli, err := beacon.NewClient(endpoint, client.WithTimeout(timeout))
+// NewBeaconClient returns a new beacon client, the given options will be applied to the underlying
+// HTTP client.
+func NewBeaconClient(endpoint string, timeout time.Duration, opts ...client.ClientOpt) (*BeaconClient, error) {
+	cli, err := beacon.NewClient(endpoint, append([]client.ClientOpt{client.WithTimeout(timeout)}, opts...)...)
 	if err != nil {
 		return nil, err
 	}
-	return &BeaconClient{cli, timeout}, nil
+	return &BeaconClient{Client: cli, timeout: timeout, sidecarsPathTemplate: DefaultSidecarsPathTemplate}, nil
+}
+
+// ValidateSidecarsPathTemplate checks whether the given blob sidecars path template is a relative path
+// containing exactly one {slot} placeholder.
+func ValidateSidecarsPathTemplate(template string) error {
+	if strings.Count(template, slotPlaceholder) != 1 {
+		return fmt.Errorf("%w: %q must contain exactly one %s", ErrInvalidSidecarsPathTemplate, template, slotPlaceholder)
+	}
+
+	u, err := url.Parse(strings.Replace(template, slotPlaceholder, "0", 1))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSidecarsPathTemplate, err)
+	}
+	if u.IsAbs() || u.Host != "" {
+		return fmt.Errorf("%w: %q must be a path relative to the beacon endpoint", ErrInvalidSidecarsPathTemplate, template)
+	}
+
+	return nil
+}
+
+// SetSidecarsPathTemplate sets the path template of the blob sidecars API, for the beacon clients which
+// expose the sidecars under a non-default path.
+func (c *BeaconClient) SetSidecarsPathTemplate(template string) error {
+	if err := ValidateSidecarsPathTemplate(template); err != nil {
+		return err
+	}
+
+	c.sidecarsPathTemplate = template
+	return nil
+}
+
+// SetMaxConcurrentFetches sets the maximum number of the concurrent blob fetches to this beacon endpoint,
+// the exceeding fetches wait until a running one finishes, 0 means no limit.
+func (c *BeaconClient) SetMaxConcurrentFetches(max uint64) {
+	if max == 0 {
+		c.fetchSlots = nil
+		return
+	}
+
+	c.fetchSlots = make(chan struct{}, max)
+}
+
+// syncingPath is the path of the beacon node syncing status API.
+const syncingPath = "eth/v1/node/syncing"
+
+// SyncStatus returns the beacon node's head slot, and how many slots it lags behind the wall clock slot.
+func (c *BeaconClient) SyncStatus(ctx context.Context) (uint64, uint64, error) {
+	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
+	defer cancel()
+
+	resBytes, err := c.Get(ctxWithTimeout, syncingPath)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var syncing struct {
+		Data struct {
+			HeadSlot     string `json:"head_slot"`
+			SyncDistance string `json:"sync_distance"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(resBytes, &syncing); err != nil {
+		return 0, 0, err
+	}
+
+	headSlot, err := strconv.ParseUint(syncing.Data.HeadSlot, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid head slot %q: %w", syncing.Data.HeadSlot, err)
+	}
+	syncDistance, err := strconv.ParseUint(syncing.Data.SyncDistance, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid sync distance %q: %w", syncing.Data.SyncDistance, err)
+	}
+
+	return headSlot, syncDistance, nil
+}
+
+// specPath is the path of the beacon node config spec API.
+const specPath = "eth/v1/config/spec"
+
+// BlobRetentionWindow returns how long the beacon node serves the blob sidecars, implied by its
+// MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS, SLOTS_PER_EPOCH and SECONDS_PER_SLOT configs. A node may keep
+// the sidecars for longer, but blobs older than the window are likely pruned. The window is cached after
+// it is fetched.
+func (c *BeaconClient) BlobRetentionWindow(ctx context.Context) (time.Duration, error) {
+	if window := c.retentionWindow.Load(); window != 0 {
+		return time.Duration(window), nil
+	}
+
+	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
+	defer cancel()
+
+	resBytes, err := c.Get(ctxWithTimeout, specPath)
+	if err != nil {
+		return 0, err
+	}
+
+	var spec struct {
+		Data map[string]string `json:"data"`
+	}
+	if err := json.Unmarshal(resBytes, &spec); err != nil {
+		return 0, err
+	}
+
+	window := time.Second
+	for _, key := range []string{"MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS", "SLOTS_PER_EPOCH", "SECONDS_PER_SLOT"} {
+		value, err := strconv.ParseUint(spec.Data[key], 10, 64)
+		if err != nil || value == 0 {
+			return 0, fmt.Errorf("invalid %s %q in the beacon config spec", key, spec.Data[key])
+		}
+		window *= time.Duration(value)
+	}
+
+	c.retentionWindow.Store(int64(window))
+	return window, nil
+}
+
+// BlobRetentionHorizon returns the time before which the blob sidecars are likely pruned by the
+// beacon node.
+func (c *BeaconClient) BlobRetentionHorizon(ctx context.Context) (time.Time, error) {
+	window, err := c.BlobRetentionWindow(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Now().Add(-window), nil
 }
 
 // GetBlobs returns the sidecars for a given slot.
@@ -41,13 +183,26 @@ func (c *BeaconClient) GetBlobs(ctx context.Context, slot *big.Int) ([]*blob.Sid
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
+	if c.fetchSlots != nil {
+		select {
+		case c.fetchSlots <- struct{}{}:
+			defer func() { <-c.fetchSlots }()
+		case <-ctxWithTimeout.Done():
+			return nil, ctxWithTimeout.Err()
+		}
+	}
+
 	var sidecars *blob.SidecarsResponse
-	resBytes, err := c.Get(ctxWithTimeout, fmt.Sprintf(sidecarsRequestURL, slot))
+	resBytes, err := c.Get(ctxWithTimeout, strings.Replace(c.sidecarsPathTemplate, slotPlaceholder, slot.String(), 1))
 	if err != nil {
 		return nil, err
 	}
 
-	return sidecars.Data, json.Unmarshal(resBytes, &sidecars)
+	if err := json.Unmarshal(resBytes, &sidecars); err != nil {
+		return nil, err
+	}
+
+	return sidecars.Data, nil
 }
 
 // GetBlobByHash returns the sidecars for a given slot.
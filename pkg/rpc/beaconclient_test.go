@@ -0,0 +1,150 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/blob"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBlobsSidecarsPathTemplate(t *testing.T) {
+	var requestedPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		if r.URL.Path != "/custom/v2/sidecars/100/list" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.Nil(t, json.NewEncoder(w).Encode(&blob.SidecarsResponse{
+			Data: []*blob.Sidecar{{Index: "0", Blob: "0x01"}},
+		}))
+	}))
+	defer srv.Close()
+
+	client, err := NewBeaconClient(srv.URL, time.Second)
+	require.Nil(t, err)
+
+	// The default path is not served by the mock beacon node.
+	_, err = client.GetBlobs(context.Background(), big.NewInt(100))
+	require.NotNil(t, err)
+	require.Equal(t, "/eth/v1/beacon/blob_sidecars/100", requestedPath)
+
+	require.Nil(t, client.SetSidecarsPathTemplate("custom/v2/sidecars/{slot}/list"))
+	sidecars, err := client.GetBlobs(context.Background(), big.NewInt(100))
+	require.Nil(t, err)
+	require.Len(t, sidecars, 1)
+	require.Equal(t, "0x01", sidecars[0].Blob)
+}
+
+func TestValidateSidecarsPathTemplate(t *testing.T) {
+	require.Nil(t, ValidateSidecarsPathTemplate(DefaultSidecarsPathTemplate))
+	require.Nil(t, ValidateSidecarsPathTemplate("eth/v2/beacon/blobs/{slot}?indices=0"))
+
+	for _, template := range []string{
+		"",
+		"eth/v1/beacon/blob_sidecars",
+		"eth/v1/beacon/blob_sidecars/{slot}/{slot}",
+		"http://localhost/eth/v1/beacon/blob_sidecars/{slot}",
+		"//localhost/{slot}",
+	} {
+		require.ErrorIs(t, ValidateSidecarsPathTemplate(template), ErrInvalidSidecarsPathTemplate, template)
+	}
+
+	client, err := NewBeaconClient("http://localhost", time.Second)
+	require.Nil(t, err)
+	require.ErrorIs(t, client.SetSidecarsPathTemplate("blob_sidecars"), ErrInvalidSidecarsPathTemplate)
+	require.Equal(t, DefaultSidecarsPathTemplate, client.sidecarsPathTemplate)
+}
+
+func TestGetBlobsMaxConcurrentFetches(t *testing.T) {
+	var (
+		inFlight    atomic.Int32
+		maxInFlight atomic.Int32
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			if max := maxInFlight.Load(); n <= max || maxInFlight.CompareAndSwap(max, n) {
+				break
+			}
+		}
+
+		// Hold the request for a while, so the concurrent fetches overlap.
+		time.Sleep(50 * time.Millisecond)
+		require.Nil(t, json.NewEncoder(w).Encode(&blob.SidecarsResponse{Data: []*blob.Sidecar{}}))
+	}))
+	defer srv.Close()
+
+	client, err := NewBeaconClient(srv.URL, 5*time.Second)
+	require.Nil(t, err)
+	client.SetMaxConcurrentFetches(2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(slot int64) {
+			defer wg.Done()
+			_, err := client.GetBlobs(context.Background(), big.NewInt(slot))
+			require.Nil(t, err)
+		}(int64(i))
+	}
+	wg.Wait()
+	require.Equal(t, int32(2), maxInFlight.Load())
+
+	// The waiting fetches give up when the context is done.
+	client.fetchSlots <- struct{}{}
+	client.fetchSlots <- struct{}{}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = client.GetBlobs(ctx, common.Big1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestBlobRetentionWindow(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		require.Equal(t, "/eth/v1/config/spec", r.URL.Path)
+		_, err := w.Write([]byte(`{"data":{"MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS":"4096",` +
+			`"SLOTS_PER_EPOCH":"32","SECONDS_PER_SLOT":"12","CONFIG_NAME":"mainnet"}}`))
+		require.Nil(t, err)
+	}))
+	defer srv.Close()
+
+	client, err := NewBeaconClient(srv.URL, time.Second)
+	require.Nil(t, err)
+
+	window, err := client.BlobRetentionWindow(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 4096*32*12*time.Second, window)
+
+	// The window is cached.
+	horizon, err := client.BlobRetentionHorizon(context.Background())
+	require.Nil(t, err)
+	require.WithinDuration(t, time.Now().Add(-window), horizon, time.Second)
+	require.Equal(t, int32(1), requests.Load())
+}
+
+func TestBlobRetentionWindowMissingConfig(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte(`{"data":{"SLOTS_PER_EPOCH":"32","SECONDS_PER_SLOT":"12"}}`))
+		require.Nil(t, err)
+	}))
+	defer srv.Close()
+
+	client, err := NewBeaconClient(srv.URL, time.Second)
+	require.Nil(t, err)
+
+	_, err = client.BlobRetentionWindow(context.Background())
+	require.ErrorContains(t, err, "MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS")
+}
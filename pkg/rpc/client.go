@@ -3,10 +3,15 @@ package rpc
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/v4/api/client"
+
 	"github.com/taikoxyz/taiko-client/bindings"
 )
 
@@ -24,20 +29,27 @@ type Client struct {
 	L2Engine *EngineClient
 	// Beacon clients
 	L1Beacon *BeaconClient
+	// Secondary blob sidecars source, which is tried when the L1 beacon node fails to serve a blob
+	L1BeaconFallback *BeaconClient
 	// Protocol contracts clients
 	TaikoL1        *bindings.TaikoL1Client
 	TaikoL2        *bindings.TaikoL2Client
 	TaikoToken     *bindings.TaikoToken
 	GuardianProver *bindings.GuardianProver
+
+	// Lag thresholds of the connections health check
+	healthCheckCfg HealthCheckConfig
 }
 
 // ClientConfig contains all configs which will be used to initializing an
 // RPC client. If not providing L2EngineEndpoint or JwtSecret, then the L2Engine client
 // won't be initialized.
 type ClientConfig struct {
-	L1Endpoint            string
-	L2Endpoint            string
-	L1BeaconEndpoint      string
+	L1Endpoint       string
+	L2Endpoint       string
+	L1BeaconEndpoint string
+	// Path template of the L1 beacon blob sidecars API, defaults to DefaultSidecarsPathTemplate if empty
+	L1BeaconSidecarsPath  string
 	L2CheckPoint          string
 	TaikoL1Address        common.Address
 	TaikoL2Address        common.Address
@@ -46,6 +58,25 @@ type ClientConfig struct {
 	L2EngineEndpoint      string
 	JwtSecret             string
 	Timeout               time.Duration
+	// Custom HTTP client used by the HTTP based connections, defaults to the internal one if nil
+	HTTPClient *http.Client
+	// Maximum number of the concurrent blob fetches to each L1 beacon endpoint, 0 means no limit
+	L1BeaconMaxConcurrentFetches uint64
+	// Secondary blob sidecars source serving the beacon blob sidecars API, e.g. a blob archive or a
+	// second beacon node, optional
+	L1BeaconFallback string
+	// Whether to skip checking the L2 execution engine's genesis block against the genesis committed in
+	// the TaikoL1 contract, only for the local development
+	SkipGenesisCheck bool
+	// Redundant L1 endpoints, failed over to in order when the active L1 endpoint errors or goes stale
+	L1FailoverEndpoints []string
+	// Maximum number of blocks the active L1 endpoint's head can lag behind the other L1 endpoints,
+	// 0 means never failing over for the head lag
+	L1FailoverMaxHeadLag uint64
+	// Interval to check the health of the L1 endpoints, only used if there are L1FailoverEndpoints
+	L1FailoverCheckInterval time.Duration
+	// Lag thresholds of the connections health check, beyond which a reachable connection is unhealthy
+	HealthCheck HealthCheckConfig
 }
 
 // NewClient initializes all RPC clients used by Taiko client software.
@@ -53,12 +84,29 @@ func NewClient(ctx context.Context, cfg *ClientConfig) (*Client, error) {
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, defaultTimeout)
 	defer cancel()
 
-	l1Client, err := NewEthClient(ctxWithTimeout, cfg.L1Endpoint, cfg.Timeout)
+	var (
+		dialOpts   []rpc.ClientOption
+		beaconOpts []client.ClientOpt
+	)
+	if cfg.HTTPClient != nil {
+		dialOpts = append(dialOpts, rpc.WithHTTPClient(cfg.HTTPClient))
+		if cfg.HTTPClient.Transport != nil {
+			beaconOpts = append(beaconOpts, client.WithRoundTripper(cfg.HTTPClient.Transport))
+		}
+	}
+
+	l1Client, err := NewEthClientWithFailover(
+		ctxWithTimeout,
+		append([]string{cfg.L1Endpoint}, cfg.L1FailoverEndpoints...),
+		cfg.Timeout,
+		&FailoverConfig{CheckInterval: cfg.L1FailoverCheckInterval, MaxHeadLag: cfg.L1FailoverMaxHeadLag},
+		dialOpts...,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	l2Client, err := NewEthClient(ctxWithTimeout, cfg.L2Endpoint, cfg.Timeout)
+	l2Client, err := NewEthClient(ctxWithTimeout, cfg.L2Endpoint, cfg.Timeout, dialOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +152,7 @@ func NewClient(ctx context.Context, cfg *ClientConfig) (*Client, error) {
 	// won't be initialized.
 	var l2AuthClient *EngineClient
 	if len(cfg.L2EngineEndpoint) != 0 && len(cfg.JwtSecret) != 0 {
-		l2AuthClient, err = NewJWTEngineClient(cfg.L2EngineEndpoint, cfg.JwtSecret)
+		l2AuthClient, err = NewJWTEngineClient(cfg.L2EngineEndpoint, cfg.JwtSecret, dialOpts...)
 		if err != nil {
 			return nil, err
 		}
@@ -112,32 +160,50 @@ func NewClient(ctx context.Context, cfg *ClientConfig) (*Client, error) {
 
 	var l1BeaconClient *BeaconClient
 	if cfg.L1BeaconEndpoint != "" {
-		if l1BeaconClient, err = NewBeaconClient(cfg.L1BeaconEndpoint, defaultTimeout); err != nil {
+		if l1BeaconClient, err = NewBeaconClient(cfg.L1BeaconEndpoint, defaultTimeout, beaconOpts...); err != nil {
 			return nil, err
 		}
+		if cfg.L1BeaconSidecarsPath != "" {
+			if err := l1BeaconClient.SetSidecarsPathTemplate(cfg.L1BeaconSidecarsPath); err != nil {
+				return nil, err
+			}
+		}
+		l1BeaconClient.SetMaxConcurrentFetches(cfg.L1BeaconMaxConcurrentFetches)
+	}
+
+	var l1BeaconFallback *BeaconClient
+	if cfg.L1BeaconFallback != "" {
+		if l1BeaconFallback, err = NewBeaconClient(cfg.L1BeaconFallback, defaultTimeout, beaconOpts...); err != nil {
+			return nil, err
+		}
+		l1BeaconFallback.SetMaxConcurrentFetches(cfg.L1BeaconMaxConcurrentFetches)
 	}
 
 	var l2CheckPoint *EthClient
 	if cfg.L2CheckPoint != "" {
-		l2CheckPoint, err = NewEthClient(ctxWithTimeout, cfg.L2CheckPoint, cfg.Timeout)
+		l2CheckPoint, err = NewEthClient(ctxWithTimeout, cfg.L2CheckPoint, cfg.Timeout, dialOpts...)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	client := &Client{
-		L1:             l1Client,
-		L1Beacon:       l1BeaconClient,
-		L2:             l2Client,
-		L2CheckPoint:   l2CheckPoint,
-		L2Engine:       l2AuthClient,
-		TaikoL1:        taikoL1,
-		TaikoL2:        taikoL2,
-		TaikoToken:     taikoToken,
-		GuardianProver: guardianProver,
-	}
-
-	if err := client.ensureGenesisMatched(ctxWithTimeout); err != nil {
+		L1:               l1Client,
+		L1Beacon:         l1BeaconClient,
+		L1BeaconFallback: l1BeaconFallback,
+		L2:               l2Client,
+		L2CheckPoint:     l2CheckPoint,
+		L2Engine:         l2AuthClient,
+		TaikoL1:          taikoL1,
+		TaikoL2:          taikoL2,
+		TaikoToken:       taikoToken,
+		GuardianProver:   guardianProver,
+		healthCheckCfg:   cfg.HealthCheck,
+	}
+
+	if cfg.SkipGenesisCheck {
+		log.Warn("Skip checking the L2 genesis against the TaikoL1 contract")
+	} else if err := client.ensureGenesisMatched(ctxWithTimeout); err != nil {
 		return nil, err
 	}
 
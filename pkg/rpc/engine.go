@@ -3,9 +3,11 @@ package rpc
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/rpc"
 )
@@ -17,12 +19,18 @@ type EngineClient struct {
 	*rpc.Client
 }
 
-func NewJWTEngineClient(url, jwtSecret string) (*EngineClient, error) {
+// NewJWTEngineClient creates a new EngineClient instance authenticated by the given JWT secret, the given
+// options will be used when dialing the endpoint.
+func NewJWTEngineClient(url, jwtSecret string, opts ...rpc.ClientOption) (*EngineClient, error) {
 	var jwt = StringToBytes32(jwtSecret)
 	if jwt == (common.Hash{}) || url == "" {
 		return nil, fmt.Errorf("url is empty or jwt secret is illegal")
 	}
-	authClient, err := rpc.DialOptions(context.Background(), url, rpc.WithHTTPAuth(node.NewJWTAuth(jwt)))
+	authClient, err := rpc.DialOptions(
+		context.Background(),
+		url,
+		append(opts, rpc.WithHTTPAuth(node.NewJWTAuth(jwt)))...,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -96,3 +104,16 @@ func (c *EngineClient) ExchangeTransitionConfiguration(
 
 	return result, nil
 }
+
+// ChainID returns the chain ID of the L2 execution engine.
+func (c *EngineClient) ChainID(ctx context.Context) (*big.Int, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var result hexutil.Big
+	if err := c.Client.CallContext(timeoutCtx, &result, "eth_chainId"); err != nil {
+		return nil, err
+	}
+
+	return (*big.Int)(&result), nil
+}
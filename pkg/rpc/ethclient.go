@@ -2,62 +2,130 @@ package rpc
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/ethclient/gethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
-type gethClient struct {
-	*gethclient.Client
-}
-
-type ethClient struct {
-	*ethclient.Client
-}
-
-// EthClient is a wrapper for go-ethereum eth client with a timeout attached.
+// EthClient is a wrapper for go-ethereum eth client with a timeout attached. It can be backed by several
+// redundant endpoints of the same chain, and fails over to the next healthy endpoint when the active one
+// errors or goes stale, see failover.go.
 type EthClient struct {
 	ChainID *big.Int
 
-	*rpc.Client
-	*gethClient
-	*ethClient
+	endpoints []*ethEndpoint
+	active    atomic.Pointer[ethEndpoint]
+	// Guards the failovers and the endpoints' deactivation channels
+	failoverMu   sync.Mutex
+	failoverCfg  *FailoverConfig
+	closeMonitor context.CancelFunc
 
 	timeout time.Duration
 }
 
-func NewEthClient(ctx context.Context, url string, timeout time.Duration) (*EthClient, error) {
+// ethEndpoint contains the clients connected to a single RPC endpoint.
+type ethEndpoint struct {
+	label      string
+	rpcClient  *rpc.Client
+	gethClient *gethclient.Client
+	ethClient  *ethclient.Client
+	healthy    atomic.Bool
+	// Closed when the endpoint is no longer active, to drop the subscriptions made through it
+	deactivated chan struct{}
+}
+
+// NewEthClient creates a new EthClient instance, the given options will be used when dialing the endpoint.
+func NewEthClient(
+	ctx context.Context,
+	url string,
+	timeout time.Duration,
+	opts ...rpc.ClientOption,
+) (*EthClient, error) {
+	return NewEthClientWithFailover(ctx, []string{url}, timeout, nil, opts...)
+}
+
+// NewEthClientWithFailover creates a new EthClient instance backed by the given redundant endpoints of the
+// same chain, the first one is used until it fails. The given options will be used when dialing the
+// endpoints, and all of them must be reachable on startup.
+func NewEthClientWithFailover(
+	ctx context.Context,
+	urls []string,
+	timeout time.Duration,
+	failoverCfg *FailoverConfig,
+	opts ...rpc.ClientOption,
+) (*EthClient, error) {
+	if len(urls) == 0 {
+		return nil, errNoEndpoints
+	}
+
 	var timeoutVal = defaultTimeout
 	if timeout != 0 {
 		timeoutVal = timeout
 	}
 
-	client, err := rpc.DialContext(ctx, url)
-	if err != nil {
-		return nil, err
+	c := &EthClient{failoverCfg: failoverCfg, timeout: timeoutVal}
+	for _, url := range urls {
+		client, err := rpc.DialOptions(ctx, url, opts...)
+		if err != nil {
+			c.Close()
+			return nil, err
+		}
+
+		e := &ethEndpoint{
+			label:      endpointLabel(url),
+			rpcClient:  client,
+			gethClient: gethclient.New(client),
+			ethClient:  ethclient.NewClient(client),
+		}
+		e.healthy.Store(true)
+		c.endpoints = append(c.endpoints, e)
+
+		// Get chainID.
+		chainID, err := e.ethClient.ChainID(ctx)
+		if err != nil {
+			c.Close()
+			return nil, err
+		}
+		if c.ChainID == nil {
+			c.ChainID = chainID
+		} else if chainID.Cmp(c.ChainID) != 0 {
+			c.Close()
+			return nil, fmt.Errorf("%w: endpoint %s expected %s, got %s", ErrChainIDMismatch, e.label, c.ChainID, chainID)
+		}
 	}
 
-	ethClient := &ethClient{ethclient.NewClient(client)}
-	// Get chainID.
-	chainID, err := ethClient.ChainID(ctx)
-	if err != nil {
-		return nil, err
+	c.failoverMu.Lock()
+	c.activate(c.endpoints[0])
+	c.failoverMu.Unlock()
+
+	if len(c.endpoints) > 1 {
+		monitorCtx, cancel := context.WithCancel(context.Background())
+		c.closeMonitor = cancel
+		go c.monitorEndpoints(monitorCtx)
 	}
 
-	return &EthClient{
-		ChainID:    chainID,
-		Client:     client,
-		gethClient: &gethClient{gethclient.New(client)},
-		ethClient:  ethClient,
-		timeout:    timeoutVal,
-	}, nil
+	return c, nil
+}
+
+// Close stops monitoring the endpoints, and closes the connections to all of them.
+func (c *EthClient) Close() {
+	if c.closeMonitor != nil {
+		c.closeMonitor()
+	}
+	for _, e := range c.endpoints {
+		e.rpcClient.Close()
+	}
 }
 
 // BlockByHash returns the given full block.
@@ -68,7 +136,9 @@ func (c *EthClient) BlockByHash(ctx context.Context, hash common.Hash) (*types.B
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.BlockByHash(ctxWithTimeout, hash)
+	return call(ctx, c, func(e *ethEndpoint) (*types.Block, error) {
+		return e.ethClient.BlockByHash(ctxWithTimeout, hash)
+	})
 }
 
 // BlockByNumber returns a block from the current canonical chain. If number is nil, the
@@ -80,7 +150,9 @@ func (c *EthClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.BlockByNumber(ctxWithTimeout, number)
+	return call(ctx, c, func(e *ethEndpoint) (*types.Block, error) {
+		return e.ethClient.BlockByNumber(ctxWithTimeout, number)
+	})
 }
 
 // BlockNumber returns the most recent block number
@@ -88,7 +160,9 @@ func (c *EthClient) BlockNumber(ctx context.Context) (uint64, error) {
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.BlockNumber(ctxWithTimeout)
+	return call(ctx, c, func(e *ethEndpoint) (uint64, error) {
+		return e.ethClient.BlockNumber(ctxWithTimeout)
+	})
 }
 
 // PeerCount returns the number of p2p peers as reported by the net_peerCount method.
@@ -96,7 +170,9 @@ func (c *EthClient) PeerCount(ctx context.Context) (uint64, error) {
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.PeerCount(ctxWithTimeout)
+	return call(ctx, c, func(e *ethEndpoint) (uint64, error) {
+		return e.ethClient.PeerCount(ctxWithTimeout)
+	})
 }
 
 // HeaderByHash returns the block header with the given hash.
@@ -104,7 +180,9 @@ func (c *EthClient) HeaderByHash(ctx context.Context, hash common.Hash) (*types.
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.HeaderByHash(ctxWithTimeout, hash)
+	return call(ctx, c, func(e *ethEndpoint) (*types.Header, error) {
+		return e.ethClient.HeaderByHash(ctxWithTimeout, hash)
+	})
 }
 
 // HeaderByNumber returns a block header from the current canonical chain. If number is
@@ -113,7 +191,9 @@ func (c *EthClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.HeaderByNumber(ctxWithTimeout, number)
+	return call(ctx, c, func(e *ethEndpoint) (*types.Header, error) {
+		return e.ethClient.HeaderByNumber(ctxWithTimeout, number)
+	})
 }
 
 // TransactionByHash returns the transaction with the given hash.
@@ -124,7 +204,9 @@ func (c *EthClient) TransactionByHash(
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.TransactionByHash(ctxWithTimeout, hash)
+	e := c.endpoint()
+	tx, isPending, err = e.ethClient.TransactionByHash(ctxWithTimeout, hash)
+	return tx, isPending, c.observe(ctx, e, err)
 }
 
 // TransactionSender returns the sender address of the given transaction. The transaction
@@ -142,7 +224,9 @@ func (c *EthClient) TransactionSender(
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.TransactionSender(ctxWithTimeout, tx, block, index)
+	return call(ctx, c, func(e *ethEndpoint) (common.Address, error) {
+		return e.ethClient.TransactionSender(ctxWithTimeout, tx, block, index)
+	})
 }
 
 // TransactionCount returns the total number of transactions in the given block.
@@ -150,7 +234,9 @@ func (c *EthClient) TransactionCount(ctx context.Context, blockHash common.Hash)
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.TransactionCount(ctxWithTimeout, blockHash)
+	return call(ctx, c, func(e *ethEndpoint) (uint, error) {
+		return e.ethClient.TransactionCount(ctxWithTimeout, blockHash)
+	})
 }
 
 // TransactionInBlock returns a single transaction at index in the given block.
@@ -162,7 +248,9 @@ func (c *EthClient) TransactionInBlock(
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.TransactionInBlock(ctxWithTimeout, blockHash, index)
+	return call(ctx, c, func(e *ethEndpoint) (*types.Transaction, error) {
+		return e.ethClient.TransactionInBlock(ctxWithTimeout, blockHash, index)
+	})
 }
 
 // SyncProgress retrieves the current progress of the sync algorithm. If there's
@@ -171,7 +259,9 @@ func (c *EthClient) SyncProgress(ctx context.Context) (*ethereum.SyncProgress, e
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.SyncProgress(ctxWithTimeout)
+	return call(ctx, c, func(e *ethEndpoint) (*ethereum.SyncProgress, error) {
+		return e.ethClient.SyncProgress(ctxWithTimeout)
+	})
 }
 
 // NetworkID returns the network ID for this client.
@@ -179,7 +269,9 @@ func (c *EthClient) NetworkID(ctx context.Context) (*big.Int, error) {
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.NetworkID(ctxWithTimeout)
+	return call(ctx, c, func(e *ethEndpoint) (*big.Int, error) {
+		return e.ethClient.NetworkID(ctxWithTimeout)
+	})
 }
 
 // BalanceAt returns the wei balance of the given account.
@@ -192,7 +284,9 @@ func (c *EthClient) BalanceAt(
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.BalanceAt(ctxWithTimeout, account, blockNumber)
+	return call(ctx, c, func(e *ethEndpoint) (*big.Int, error) {
+		return e.ethClient.BalanceAt(ctxWithTimeout, account, blockNumber)
+	})
 }
 
 // StorageAt returns the value of key in the contract storage of the given account.
@@ -206,7 +300,9 @@ func (c *EthClient) StorageAt(
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.StorageAt(ctxWithTimeout, account, key, blockNumber)
+	return call(ctx, c, func(e *ethEndpoint) ([]byte, error) {
+		return e.ethClient.StorageAt(ctxWithTimeout, account, key, blockNumber)
+	})
 }
 
 // CodeAt returns the contract code of the given account.
@@ -219,7 +315,9 @@ func (c *EthClient) CodeAt(
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.CodeAt(ctxWithTimeout, account, blockNumber)
+	return call(ctx, c, func(e *ethEndpoint) ([]byte, error) {
+		return e.ethClient.CodeAt(ctxWithTimeout, account, blockNumber)
+	})
 }
 
 // NonceAt returns the account nonce of the given account.
@@ -232,7 +330,9 @@ func (c *EthClient) NonceAt(
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.NonceAt(ctxWithTimeout, account, blockNumber)
+	return call(ctx, c, func(e *ethEndpoint) (uint64, error) {
+		return e.ethClient.NonceAt(ctxWithTimeout, account, blockNumber)
+	})
 }
 
 // PendingBalanceAt returns the wei balance of the given account in the pending state.
@@ -240,7 +340,9 @@ func (c *EthClient) PendingBalanceAt(ctx context.Context, account common.Address
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.PendingBalanceAt(ctxWithTimeout, account)
+	return call(ctx, c, func(e *ethEndpoint) (*big.Int, error) {
+		return e.ethClient.PendingBalanceAt(ctxWithTimeout, account)
+	})
 }
 
 // PendingStorageAt returns the value of key in the contract storage of the given account in the pending state.
@@ -252,7 +354,9 @@ func (c *EthClient) PendingStorageAt(
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.PendingStorageAt(ctxWithTimeout, account, key)
+	return call(ctx, c, func(e *ethEndpoint) ([]byte, error) {
+		return e.ethClient.PendingStorageAt(ctxWithTimeout, account, key)
+	})
 }
 
 // PendingCodeAt returns the contract code of the given account in the pending state.
@@ -260,7 +364,9 @@ func (c *EthClient) PendingCodeAt(ctx context.Context, account common.Address) (
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.PendingCodeAt(ctxWithTimeout, account)
+	return call(ctx, c, func(e *ethEndpoint) ([]byte, error) {
+		return e.ethClient.PendingCodeAt(ctxWithTimeout, account)
+	})
 }
 
 // PendingNonceAt returns the account nonce of the given account in the pending state.
@@ -269,7 +375,9 @@ func (c *EthClient) PendingNonceAt(ctx context.Context, account common.Address)
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.PendingNonceAt(ctxWithTimeout, account)
+	return call(ctx, c, func(e *ethEndpoint) (uint64, error) {
+		return e.ethClient.PendingNonceAt(ctxWithTimeout, account)
+	})
 }
 
 // PendingTransactionCount returns the total number of transactions in the pending state.
@@ -277,7 +385,9 @@ func (c *EthClient) PendingTransactionCount(ctx context.Context) (uint, error) {
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.PendingTransactionCount(ctxWithTimeout)
+	return call(ctx, c, func(e *ethEndpoint) (uint, error) {
+		return e.ethClient.PendingTransactionCount(ctxWithTimeout)
+	})
 }
 
 // CallContract executes a message call transaction, which is directly executed in the VM
@@ -294,7 +404,9 @@ func (c *EthClient) CallContract(
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.CallContract(ctxWithTimeout, msg, blockNumber)
+	return call(ctx, c, func(e *ethEndpoint) ([]byte, error) {
+		return e.ethClient.CallContract(ctxWithTimeout, msg, blockNumber)
+	})
 }
 
 // CallContractAtHash is almost the same as CallContract except that it selects
@@ -307,7 +419,9 @@ func (c *EthClient) CallContractAtHash(
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.CallContractAtHash(ctxWithTimeout, msg, blockHash)
+	return call(ctx, c, func(e *ethEndpoint) ([]byte, error) {
+		return e.ethClient.CallContractAtHash(ctxWithTimeout, msg, blockHash)
+	})
 }
 
 // PendingCallContract executes a message call transaction using the EVM.
@@ -316,7 +430,9 @@ func (c *EthClient) PendingCallContract(ctx context.Context, msg ethereum.CallMs
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.PendingCallContract(ctxWithTimeout, msg)
+	return call(ctx, c, func(e *ethEndpoint) ([]byte, error) {
+		return e.ethClient.PendingCallContract(ctxWithTimeout, msg)
+	})
 }
 
 // SuggestGasPrice retrieves the currently suggested gas price to allow a timely
@@ -325,7 +441,9 @@ func (c *EthClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.SuggestGasPrice(ctxWithTimeout)
+	return call(ctx, c, func(e *ethEndpoint) (*big.Int, error) {
+		return e.ethClient.SuggestGasPrice(ctxWithTimeout)
+	})
 }
 
 // SuggestGasTipCap retrieves the currently suggested gas tip cap after 1559 to
@@ -334,7 +452,9 @@ func (c *EthClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.SuggestGasTipCap(ctxWithTimeout)
+	return call(ctx, c, func(e *ethEndpoint) (*big.Int, error) {
+		return e.ethClient.SuggestGasTipCap(ctxWithTimeout)
+	})
 }
 
 // FeeHistory retrieves the fee market history.
@@ -347,7 +467,9 @@ func (c *EthClient) FeeHistory(
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.FeeHistory(ctxWithTimeout, blockCount, lastBlock, rewardPercentiles)
+	return call(ctx, c, func(e *ethEndpoint) (*ethereum.FeeHistory, error) {
+		return e.ethClient.FeeHistory(ctxWithTimeout, blockCount, lastBlock, rewardPercentiles)
+	})
 }
 
 // EstimateGas tries to estimate the gas needed to execute a specific transaction based on
@@ -358,7 +480,9 @@ func (c *EthClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.EstimateGas(ctxWithTimeout, msg)
+	return call(ctx, c, func(e *ethEndpoint) (uint64, error) {
+		return e.ethClient.EstimateGas(ctxWithTimeout, msg)
+	})
 }
 
 // SendTransaction injects a signed transaction into the pending pool for execution.
@@ -369,7 +493,8 @@ func (c *EthClient) SendTransaction(ctx context.Context, tx *types.Transaction)
 	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, c.timeout)
 	defer cancel()
 
-	return c.ethClient.SendTransaction(ctxWithTimeout, tx)
+	e := c.endpoint()
+	return c.observe(ctx, e, e.ethClient.SendTransaction(ctxWithTimeout, tx))
 }
 
 // TransactionArgs represents the arguments to construct a new transaction
@@ -411,10 +536,85 @@ func (c *EthClient) FillTransaction(ctx context.Context, args *TransactionArgs)
 	defer cancel()
 
 	var result SignTransactionResult
-	err := c.CallContext(ctxWithTimeout, &result, "eth_fillTransaction", *args)
-	if err != nil {
+	if err := c.CallContext(ctxWithTimeout, &result, "eth_fillTransaction", *args); err != nil {
 		return nil, err
 	}
 
 	return result.Tx, nil
 }
+
+// TransactionReceipt returns the receipt of a transaction by transaction hash.
+// Note that the receipt is not available for pending transactions.
+func (c *EthClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return call(ctx, c, func(e *ethEndpoint) (*types.Receipt, error) {
+		return e.ethClient.TransactionReceipt(ctx, txHash)
+	})
+}
+
+// FilterLogs executes a filter query.
+func (c *EthClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return call(ctx, c, func(e *ethEndpoint) ([]types.Log, error) {
+		return e.ethClient.FilterLogs(ctx, q)
+	})
+}
+
+// SubscribeFilterLogs subscribes to the results of a streaming filter query, the subscription is dropped
+// when its endpoint is failed over.
+func (c *EthClient) SubscribeFilterLogs(
+	ctx context.Context,
+	q ethereum.FilterQuery,
+	ch chan<- types.Log,
+) (ethereum.Subscription, error) {
+	return call(ctx, c, func(e *ethEndpoint) (ethereum.Subscription, error) {
+		sub, err := e.ethClient.SubscribeFilterLogs(ctx, q, ch)
+		if err != nil {
+			return nil, err
+		}
+		return c.trackSubscription(e, sub), nil
+	})
+}
+
+// SubscribeNewHead subscribes to notifications about the current blockchain head, the subscription is
+// dropped when its endpoint is failed over.
+func (c *EthClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return call(ctx, c, func(e *ethEndpoint) (ethereum.Subscription, error) {
+		sub, err := e.ethClient.SubscribeNewHead(ctx, ch)
+		if err != nil {
+			return nil, err
+		}
+		return c.trackSubscription(e, sub), nil
+	})
+}
+
+// HeadL1Origin returns the latest L2 block's corresponding L1 origin.
+func (c *EthClient) HeadL1Origin(ctx context.Context) (*rawdb.L1Origin, error) {
+	return call(ctx, c, func(e *ethEndpoint) (*rawdb.L1Origin, error) {
+		return e.ethClient.HeadL1Origin(ctx)
+	})
+}
+
+// L1OriginByID returns the L2 block's corresponding L1 origin.
+func (c *EthClient) L1OriginByID(ctx context.Context, blockID *big.Int) (*rawdb.L1Origin, error) {
+	return call(ctx, c, func(e *ethEndpoint) (*rawdb.L1Origin, error) {
+		return e.ethClient.L1OriginByID(ctx, blockID)
+	})
+}
+
+// SetHead sets the current head of the local chain by block number.
+func (c *EthClient) SetHead(ctx context.Context, number *big.Int) error {
+	e := c.endpoint()
+	return c.observe(ctx, e, e.gethClient.SetHead(ctx, number))
+}
+
+// CallContext performs a JSON-RPC call with the given arguments.
+func (c *EthClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	e := c.endpoint()
+	return c.observe(ctx, e, e.rpcClient.CallContext(ctx, result, method, args...))
+}
+
+// BatchCallContext sends all given requests as a single batch and waits for the server
+// to return a response for all of them.
+func (c *EthClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	e := c.endpoint()
+	return c.observe(ctx, e, e.rpcClient.BatchCallContext(ctx, b))
+}
@@ -2,10 +2,16 @@ package rpc
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/require"
 )
 
@@ -177,3 +183,40 @@ func TestEstimateGas(t *testing.T) {
 	_, err := client.L1.EstimateGas(context.Background(), ethereum.CallMsg{})
 	require.Nil(t, err)
 }
+
+// recordingTransport is a http.RoundTripper which records the number of requests.
+type recordingTransport struct {
+	requests atomic.Int32
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests.Add(1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+type testChainIDService struct{}
+
+func (s *testChainIDService) ChainId() *hexutil.Big { return (*hexutil.Big)(common.Big32) }
+
+func TestNewEthClientWithHTTPClient(t *testing.T) {
+	server := rpc.NewServer()
+	require.Nil(t, server.RegisterName("eth", new(testChainIDService)))
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	transport := new(recordingTransport)
+	client, err := NewEthClient(
+		context.Background(),
+		httpServer.URL,
+		time.Second,
+		rpc.WithHTTPClient(&http.Client{Transport: transport}),
+	)
+	require.Nil(t, err)
+	require.Equal(t, common.Big32, client.ChainID)
+	require.Equal(t, int32(1), transport.requests.Load())
+
+	var chainID hexutil.Big
+	require.Nil(t, client.CallContext(context.Background(), &chainID, "eth_chainId"))
+	require.Equal(t, common.Big32, chainID.ToInt())
+	require.Equal(t, int32(2), transport.requests.Load())
+}
@@ -0,0 +1,226 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-client/internal/utils"
+)
+
+var (
+	errNoEndpoints         = errors.New("no RPC endpoint")
+	errEndpointDeactivated = errors.New("RPC endpoint failed over")
+	errEndpointHeadLagging = errors.New("RPC endpoint head lagging behind")
+	// Default interval to check the health of the redundant endpoints.
+	defaultFailoverCheckInterval = 12 * time.Second
+	invalidLabelChars            = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+)
+
+// FailoverConfig contains the configs of failing over between the redundant endpoints of an EthClient.
+type FailoverConfig struct {
+	// Interval to check the health of the endpoints, defaults to 12 seconds if zero
+	CheckInterval time.Duration
+	// Maximum number of blocks the active endpoint's head can lag behind the highest head of the other
+	// endpoints before it is considered stale, 0 means never failing over for the head lag
+	MaxHeadLag uint64
+}
+
+// call runs the given request against the active endpoint, and fails over to the next healthy endpoint
+// if the active one errors. The failed request itself is not retried, the following requests are sent
+// to the new active endpoint.
+func call[T any](ctx context.Context, c *EthClient, f func(e *ethEndpoint) (T, error)) (T, error) {
+	e := c.endpoint()
+	res, err := f(e)
+	return res, c.observe(ctx, e, err)
+}
+
+// endpoint returns the active endpoint.
+func (c *EthClient) endpoint() *ethEndpoint {
+	return c.active.Load()
+}
+
+// ActiveEndpoint returns the label of the active endpoint, i.e. its sanitized host.
+func (c *EthClient) ActiveEndpoint() string {
+	return c.endpoint().label
+}
+
+// observe fails over from the given endpoint if the given request error is caused by the endpoint
+// itself, the error is returned as is.
+func (c *EthClient) observe(ctx context.Context, e *ethEndpoint, err error) error {
+	if len(c.endpoints) > 1 && isEndpointError(ctx, err) {
+		c.failover(e, err)
+	}
+
+	return err
+}
+
+// isEndpointError checks whether the given request error is caused by the endpoint itself, e.g. a
+// connection failure, rather than an error response of the node, or the caller cancelling the request.
+func isEndpointError(ctx context.Context, err error) bool {
+	if err == nil || errors.Is(err, ethereum.NotFound) {
+		return false
+	}
+	if !utils.IsNil(ctx) && ctx.Err() != nil {
+		return false
+	}
+
+	var rpcErr rpc.Error
+	return !errors.As(err, &rpcErr)
+}
+
+// failover switches the active endpoint from the given endpoint to the next healthy one, if the given
+// endpoint is still the active one. If none of the other endpoints is known to be healthy, the next one
+// is tried anyway.
+func (c *EthClient) failover(from *ethEndpoint, reason error) {
+	c.failoverMu.Lock()
+	defer c.failoverMu.Unlock()
+
+	// Already failed over by another request.
+	if c.active.Load() != from {
+		return
+	}
+	from.healthy.Store(false)
+
+	var (
+		idx = slices.Index(c.endpoints, from)
+		to  = c.endpoints[(idx+1)%len(c.endpoints)]
+	)
+	for i := 1; i < len(c.endpoints); i++ {
+		if e := c.endpoints[(idx+i)%len(c.endpoints)]; e.healthy.Load() {
+			to = e
+			break
+		}
+	}
+
+	log.Warn(
+		"RPC endpoint failover",
+		"chainID", c.ChainID,
+		"from", from.label,
+		"to", to.label,
+		"toHealthy", to.healthy.Load(),
+		"reason", reason,
+	)
+	metrics.RPCEndpointFailoverCounter.Inc(1)
+	c.activate(to)
+}
+
+// activate makes the given endpoint the active one, and drops the subscriptions made through the previous
+// active endpoint, the caller must hold the failover lock.
+func (c *EthClient) activate(e *ethEndpoint) {
+	if prev := c.active.Swap(e); prev != nil {
+		close(prev.deactivated)
+	}
+	e.deactivated = make(chan struct{})
+
+	if len(c.endpoints) < 2 {
+		return
+	}
+	for _, endpoint := range c.endpoints {
+		if endpoint == e {
+			metrics.RPCEndpointActiveGauge(endpoint.label).Update(1)
+		} else {
+			metrics.RPCEndpointActiveGauge(endpoint.label).Update(0)
+		}
+	}
+}
+
+// trackSubscription wraps the given subscription made through the given endpoint, so a dropped
+// subscription fails over from the endpoint, and the subscription is dropped with errEndpointDeactivated
+// once the endpoint is failed over, for the subscriber to resubscribe through the new active endpoint.
+func (c *EthClient) trackSubscription(e *ethEndpoint, sub ethereum.Subscription) ethereum.Subscription {
+	c.failoverMu.Lock()
+	deactivated := e.deactivated
+	c.failoverMu.Unlock()
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+
+		select {
+		case err := <-sub.Err():
+			return c.observe(context.Background(), e, err)
+		case <-deactivated:
+			return errEndpointDeactivated
+		case <-quit:
+			return nil
+		}
+	})
+}
+
+// monitorEndpoints checks the health of all endpoints periodically, until the given context is done.
+func (c *EthClient) monitorEndpoints(ctx context.Context) {
+	interval := defaultFailoverCheckInterval
+	if c.failoverCfg != nil && c.failoverCfg.CheckInterval != 0 {
+		interval = c.failoverCfg.CheckInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.checkEndpoints(ctx)
+		}
+	}
+}
+
+// checkEndpoints fetches the heads of all endpoints, an endpoint is healthy if its head is fetched and
+// doesn't lag too far behind the highest head. The active endpoint is failed over if it's not healthy.
+func (c *EthClient) checkEndpoints(ctx context.Context) {
+	var (
+		heads   = make([]uint64, len(c.endpoints))
+		errs    = make([]error, len(c.endpoints))
+		highest uint64
+	)
+	for i, e := range c.endpoints {
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, c.timeout)
+		heads[i], errs[i] = e.ethClient.BlockNumber(ctxWithTimeout)
+		cancel()
+
+		if ctx.Err() != nil {
+			return
+		}
+		if errs[i] == nil {
+			highest = max(highest, heads[i])
+		}
+	}
+
+	active := c.endpoint()
+	for i, e := range c.endpoints {
+		if errs[i] == nil && c.failoverCfg != nil && c.failoverCfg.MaxHeadLag != 0 &&
+			heads[i]+c.failoverCfg.MaxHeadLag < highest {
+			errs[i] = errEndpointHeadLagging
+		}
+		if errs[i] != nil {
+			log.Debug("Unhealthy RPC endpoint", "endpoint", e.label, "head", heads[i], "highest", highest, "err", errs[i])
+		}
+		e.healthy.Store(errs[i] == nil)
+	}
+
+	if i := slices.Index(c.endpoints, active); errs[i] != nil {
+		c.failover(active, errs[i])
+	}
+}
+
+// endpointLabel returns the sanitized host of the given endpoint URL, which is used to identify the
+// endpoint in the logs and the metrics, without leaking the credentials which may be in the URL.
+func endpointLabel(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return "unknown"
+	}
+
+	return invalidLabelChars.ReplaceAllString(u.Host, "_")
+}
@@ -0,0 +1,198 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Names of the connections checked by the health check.
+const (
+	HealthL1       = "l1"
+	HealthL2       = "l2"
+	HealthL1Beacon = "l1Beacon"
+	HealthL2Engine = "l2Engine"
+)
+
+// HealthCheckConfig contains the lag thresholds of the health check, beyond which a reachable connection
+// is still considered unhealthy, a zero threshold means never checking that lag.
+type HealthCheckConfig struct {
+	// Maximum age of the L1 head block
+	MaxL1HeadAge time.Duration
+	// Maximum number of blocks the L2 head can lag behind the latest block proposed to TaikoL1
+	MaxL2BlockLag uint64
+	// Maximum sync distance in slots reported by the L1 beacon node
+	MaxBeaconSyncDistance uint64
+}
+
+// ConnectionHealth is the health of a single connection.
+type ConnectionHealth struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	// The active endpoint, only set for the connections with redundant endpoints
+	Endpoint string `json:"endpoint,omitempty"`
+	// Head block number, or head slot of the L1 beacon node
+	Head uint64 `json:"head,omitempty"`
+	// Lag of the head, in seconds for L1, in blocks for L2, in slots for the L1 beacon node
+	Lag uint64 `json:"lag,omitempty"`
+	// Round-trip latency of the check in milliseconds
+	LatencyMs int64  `json:"latencyMs"`
+	Error     string `json:"error,omitempty"`
+}
+
+// HealthCheckResult is the health of all connections of a client, which is healthy only if all the
+// connections are healthy.
+type HealthCheckResult struct {
+	Healthy     bool                `json:"healthy"`
+	Connections []*ConnectionHealth `json:"connections"`
+}
+
+// HealthCheck checks whether all connections of the client are reachable and not lagging too far behind,
+// the optional L1 beacon and L2 engine connections are only checked if they are initialized.
+func (c *Client) HealthCheck(ctx context.Context) *HealthCheckResult {
+	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, defaultTimeout)
+	defer cancel()
+
+	checks := map[string]func(ctx context.Context, h *ConnectionHealth) error{
+		HealthL1: c.checkL1Health,
+		HealthL2: c.checkL2Health,
+	}
+	if c.L1Beacon != nil {
+		checks[HealthL1Beacon] = c.checkL1BeaconHealth
+	}
+	if c.L2Engine != nil {
+		checks[HealthL2Engine] = c.checkL2EngineHealth
+	}
+
+	var (
+		result = &HealthCheckResult{Healthy: true}
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+	)
+	for _, name := range []string{HealthL1, HealthL2, HealthL1Beacon, HealthL2Engine} {
+		check, ok := checks[name]
+		if !ok {
+			continue
+		}
+
+		h := &ConnectionHealth{Name: name}
+		result.Connections = append(result.Connections, h)
+
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+
+			start := time.Now()
+			err := check(ctxWithTimeout, h)
+			h.LatencyMs = time.Since(start).Milliseconds()
+
+			if err != nil {
+				h.Error = err.Error()
+				log.Debug("Unhealthy connection", "name", name, "err", err)
+			}
+			h.Healthy = err == nil
+
+			mu.Lock()
+			result.Healthy = result.Healthy && h.Healthy
+			mu.Unlock()
+		}(name)
+	}
+	wg.Wait()
+
+	return result
+}
+
+// checkL1Health checks the L1 head block's age.
+func (c *Client) checkL1Health(ctx context.Context, h *ConnectionHealth) error {
+	if len(c.L1.endpoints) > 1 {
+		h.Endpoint = c.L1.ActiveEndpoint()
+	}
+
+	head, err := c.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	h.Head = head.Number.Uint64()
+	if now := uint64(time.Now().Unix()); now > head.Time {
+		h.Lag = now - head.Time
+	}
+	if c.healthCheckCfg.MaxL1HeadAge != 0 && time.Duration(h.Lag)*time.Second > c.healthCheckCfg.MaxL1HeadAge {
+		return fmt.Errorf("head block is %ds old, exceeds %s", h.Lag, c.healthCheckCfg.MaxL1HeadAge)
+	}
+
+	return nil
+}
+
+// checkL2Health checks how far the L2 head lags behind the latest block proposed to TaikoL1.
+func (c *Client) checkL2Health(ctx context.Context, h *ConnectionHealth) error {
+	head, err := c.L2.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	h.Head = head
+
+	if c.healthCheckCfg.MaxL2BlockLag == 0 {
+		return nil
+	}
+
+	stateVars, err := c.GetProtocolStateVariables(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get the latest proposed block: %w", err)
+	}
+	if latest := stateVars.B.NumBlocks - 1; latest > head {
+		h.Lag = latest - head
+	}
+	if h.Lag > c.healthCheckCfg.MaxL2BlockLag {
+		return fmt.Errorf(
+			"head lags %d blocks behind the latest proposed block, exceeds %d",
+			h.Lag,
+			c.healthCheckCfg.MaxL2BlockLag,
+		)
+	}
+
+	return nil
+}
+
+// checkL1BeaconHealth checks the L1 beacon node's sync distance.
+func (c *Client) checkL1BeaconHealth(ctx context.Context, h *ConnectionHealth) error {
+	headSlot, syncDistance, err := c.L1Beacon.SyncStatus(ctx)
+	if err != nil {
+		return err
+	}
+
+	h.Head, h.Lag = headSlot, syncDistance
+	if c.healthCheckCfg.MaxBeaconSyncDistance != 0 && syncDistance > c.healthCheckCfg.MaxBeaconSyncDistance {
+		return fmt.Errorf("sync distance is %d slots, exceeds %d", syncDistance, c.healthCheckCfg.MaxBeaconSyncDistance)
+	}
+
+	return nil
+}
+
+// checkL2EngineHealth checks whether the L2 engine API is reachable with the JWT secret.
+func (c *Client) checkL2EngineHealth(ctx context.Context, _ *ConnectionHealth) error {
+	_, err := c.L2Engine.ChainID(ctx)
+	return err
+}
+
+// HealthHandler returns a HTTP handler serving the given health check's result as JSON, with the status
+// 200 if all connections are healthy, and 503 otherwise.
+func HealthHandler(check func(ctx context.Context) *HealthCheckResult) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		result := check(r.Context())
+
+		w.Header().Set("Content-Type", "application/json")
+		if !result.Healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			log.Warn("Failed to write the health check result", "err", err)
+		}
+	})
+}
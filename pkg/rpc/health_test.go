@@ -0,0 +1,152 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type testHeaderService struct {
+	testHeadService
+	headTime atomic.Uint64
+}
+
+func (s *testHeaderService) GetBlockByNumber(_ string, _ bool) *types.Header {
+	return &types.Header{
+		Number:     new(big.Int).SetUint64(s.head.Load()),
+		Time:       s.headTime.Load(),
+		Difficulty: common.Big0,
+	}
+}
+
+func newTestHealthClient(
+	t *testing.T,
+	cfg HealthCheckConfig,
+	syncDistance *atomic.Uint64,
+) (*Client, *testHeaderService) {
+	l1Service := &testHeaderService{testHeadService: testHeadService{chainID: (*hexutil.Big)(big.NewInt(1))}}
+	l1Service.head.Store(100)
+	l1Service.headTime.Store(uint64(time.Now().Unix()))
+
+	server := rpc.NewServer()
+	require.Nil(t, server.RegisterName("eth", l1Service))
+	l1Server := httptest.NewServer(server)
+	t.Cleanup(l1Server.Close)
+
+	_, l2Server := newTestHeadServer(t, 2, 10)
+
+	beaconServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data":{"head_slot":"1000","sync_distance":"%d","is_syncing":false}}`, syncDistance.Load())
+	}))
+	t.Cleanup(beaconServer.Close)
+
+	l1, err := NewEthClient(context.Background(), l1Server.URL, time.Second)
+	require.Nil(t, err)
+	l2, err := NewEthClient(context.Background(), l2Server.URL, time.Second)
+	require.Nil(t, err)
+	l1Beacon, err := NewBeaconClient(beaconServer.URL, time.Second)
+	require.Nil(t, err)
+
+	return &Client{L1: l1, L2: l2, L1Beacon: l1Beacon, healthCheckCfg: cfg}, l1Service
+}
+
+func TestHealthCheck(t *testing.T) {
+	var syncDistance atomic.Uint64
+	syncDistance.Store(2)
+
+	client, l1Service := newTestHealthClient(
+		t,
+		HealthCheckConfig{MaxL1HeadAge: time.Minute, MaxBeaconSyncDistance: 8},
+		&syncDistance,
+	)
+
+	result := client.HealthCheck(context.Background())
+	require.True(t, result.Healthy)
+	require.Len(t, result.Connections, 3)
+	for i, name := range []string{HealthL1, HealthL2, HealthL1Beacon} {
+		require.Equal(t, name, result.Connections[i].Name)
+		require.True(t, result.Connections[i].Healthy)
+		require.Empty(t, result.Connections[i].Error)
+	}
+	require.Equal(t, uint64(100), result.Connections[0].Head)
+	require.Equal(t, uint64(10), result.Connections[1].Head)
+	require.Equal(t, uint64(1000), result.Connections[2].Head)
+	require.Equal(t, uint64(2), result.Connections[2].Lag)
+
+	// The beacon node falls behind.
+	syncDistance.Store(20)
+	result = client.HealthCheck(context.Background())
+	require.False(t, result.Healthy)
+	require.True(t, result.Connections[0].Healthy)
+	require.False(t, result.Connections[2].Healthy)
+	require.NotEmpty(t, result.Connections[2].Error)
+
+	// The L1 head goes stale.
+	syncDistance.Store(2)
+	l1Service.headTime.Store(uint64(time.Now().Add(-2 * time.Minute).Unix()))
+	result = client.HealthCheck(context.Background())
+	require.False(t, result.Healthy)
+	require.False(t, result.Connections[0].Healthy)
+	require.GreaterOrEqual(t, result.Connections[0].Lag, uint64(120))
+	require.True(t, result.Connections[2].Healthy)
+}
+
+func TestHealthCheckUnreachable(t *testing.T) {
+	var syncDistance atomic.Uint64
+	client, _ := newTestHealthClient(t, HealthCheckConfig{}, &syncDistance)
+
+	// The L2 node goes down.
+	_, l2Server := newTestHeadServer(t, 2, 10)
+	l2, err := NewEthClient(context.Background(), l2Server.URL, time.Second)
+	require.Nil(t, err)
+	l2Server.Close()
+	client.L2 = l2
+
+	result := client.HealthCheck(context.Background())
+	require.False(t, result.Healthy)
+	require.True(t, result.Connections[0].Healthy)
+	require.False(t, result.Connections[1].Healthy)
+	require.NotEmpty(t, result.Connections[1].Error)
+}
+
+func TestHealthHandler(t *testing.T) {
+	var healthy atomic.Bool
+	server := httptest.NewServer(HealthHandler(func(ctx context.Context) *HealthCheckResult {
+		return &HealthCheckResult{
+			Healthy:     healthy.Load(),
+			Connections: []*ConnectionHealth{{Name: HealthL1, Healthy: healthy.Load()}},
+		}
+	}))
+	defer server.Close()
+
+	for _, isHealthy := range []bool{true, false} {
+		healthy.Store(isHealthy)
+
+		res, err := http.Get(server.URL)
+		require.Nil(t, err)
+
+		var result HealthCheckResult
+		require.Nil(t, json.NewDecoder(res.Body).Decode(&result))
+		require.Nil(t, res.Body.Close())
+
+		if isHealthy {
+			require.Equal(t, http.StatusOK, res.StatusCode)
+		} else {
+			require.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+		}
+		require.Equal(t, isHealthy, result.Healthy)
+		require.Equal(t, HealthL1, result.Connections[0].Name)
+	}
+}
@@ -63,15 +63,7 @@ func (c *Client) ensureGenesisMatched(ctx context.Context) error {
 		log.Debug("Genesis hash", "node", nodeGenesis.Hash(), "TaikoL1", common.BytesToHash(l2GenesisHash[:]))
 
 		// Node's genesis header and TaikoL1 contract's genesis header must match.
-		if common.BytesToHash(l2GenesisHash[:]) != nodeGenesis.Hash() {
-			return fmt.Errorf(
-				"genesis header hash mismatch, node: %s, TaikoL1 contract: %s",
-				nodeGenesis.Hash(),
-				common.BytesToHash(l2GenesisHash[:]),
-			)
-		}
-
-		return nil
+		return CheckGenesisHash(common.BytesToHash(l2GenesisHash[:]), nodeGenesis.Hash())
 	}
 
 	log.Warn("Genesis block not found in TaikoL1")
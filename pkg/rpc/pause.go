@@ -0,0 +1,73 @@
+package rpc
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+// Custom errors reverted by TaikoL1 when it is paused.
+var invalidPauseStatusErrors = []string{"INVALID_PAUSE_STATUS", "L1_INVALID_PAUSE_STATUS"}
+
+// IsProtocolPaused checks whether the TaikoL1 contract is paused.
+func (c *Client) IsProtocolPaused(ctx context.Context) (bool, error) {
+	ctxWithTimeout, cancel := ctxWithTimeoutOrDefault(ctx, defaultTimeout)
+	defer cancel()
+
+	return c.TaikoL1.Paused(&bind.CallOpts{Context: ctxWithTimeout})
+}
+
+// IsProtocolPausedError checks whether the given transaction error is reverted because the TaikoL1
+// contract is paused.
+func IsProtocolPausedError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return slices.Contains(invalidPauseStatusErrors, encoding.TryParsingCustomError(err).Error())
+}
+
+// WaitTillProtocolUnpaused keeps polling the paused state with the given interval, until the protocol is
+// not paused. The given gauge is updated to 1 while the protocol is paused, and 0 otherwise.
+func WaitTillProtocolUnpaused(
+	ctx context.Context,
+	isPaused func(ctx context.Context) (bool, error),
+	interval time.Duration,
+	gauge metrics.Gauge,
+) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var waited bool
+	for {
+		paused, err := isPaused(ctx)
+		if err != nil {
+			return err
+		}
+		if !paused {
+			if waited {
+				log.Info("TaikoL1 unpaused, resume sending transactions")
+			}
+			gauge.Update(0)
+			return nil
+		}
+
+		if !waited {
+			log.Warn("TaikoL1 paused, wait until it is unpaused", "pollInterval", interval)
+			gauge.Update(1)
+			waited = true
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethMetrics "github.com/ethereum/go-ethereum/metrics"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+// pausedTaikoL1Service is a fake `eth` namespace RPC service, whose TaikoL1 contract reports paused
+// for the given number of `paused()` calls, and unpaused afterwards.
+type pausedTaikoL1Service struct {
+	pausedCalls int64
+	calls       atomic.Int64
+}
+
+func (s *pausedTaikoL1Service) Call(_ map[string]interface{}, _ string) (hexutil.Bytes, error) {
+	result := make([]byte, 32)
+	if s.calls.Add(1) <= s.pausedCalls {
+		result[31] = 1
+	}
+	return result, nil
+}
+
+func TestWaitTillProtocolUnpaused(t *testing.T) {
+	enabled := gethMetrics.Enabled
+	gethMetrics.Enabled = true
+	defer func() { gethMetrics.Enabled = enabled }()
+
+	service := &pausedTaikoL1Service{pausedCalls: 2}
+	server := gethRPC.NewServer()
+	require.Nil(t, server.RegisterName("eth", service))
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	rpcClient, err := gethRPC.Dial(httpServer.URL)
+	require.Nil(t, err)
+	taikoL1, err := bindings.NewTaikoL1Client(common.Address{}, ethclient.NewClient(rpcClient))
+	require.Nil(t, err)
+	client := &Client{TaikoL1: taikoL1}
+
+	paused, err := client.IsProtocolPaused(context.Background())
+	require.Nil(t, err)
+	require.True(t, paused)
+
+	// Keep polling until the contract is unpaused.
+	gauge := gethMetrics.NewGauge()
+	require.Nil(t, WaitTillProtocolUnpaused(context.Background(), client.IsProtocolPaused, time.Millisecond, gauge))
+	require.Equal(t, int64(3), service.calls.Load())
+	require.Zero(t, gauge.Snapshot().Value())
+
+	// Stop polling when the context is cancelled.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, WaitTillProtocolUnpaused(ctx, func(context.Context) (bool, error) {
+		return true, nil
+	}, time.Millisecond, gauge), context.DeadlineExceeded)
+	require.Equal(t, int64(1), gauge.Snapshot().Value())
+}
+
+// revertError is a fake geth JSON-RPC error carrying the revert data.
+type revertError struct{ data string }
+
+func (e *revertError) Error() string          { return "execution reverted" }
+func (e *revertError) ErrorData() interface{} { return e.data }
+
+func TestIsProtocolPausedError(t *testing.T) {
+	for _, name := range invalidPauseStatusErrors {
+		selector := hexutil.Encode(crypto.Keccak256([]byte(name + "()"))[:4])
+		require.True(t, IsProtocolPausedError(&revertError{selector}))
+		require.True(t, IsProtocolPausedError(errors.New(name)))
+	}
+	require.False(t, IsProtocolPausedError(&revertError{"0x12345678"}))
+	require.False(t, IsProtocolPausedError(errors.New("L1_TEST")))
+	require.False(t, IsProtocolPausedError(nil))
+}
@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"os"
@@ -22,6 +23,12 @@ import (
 )
 
 var (
+	// ErrChainIDMismatch is returned when a RPC endpoint is not on the expected chain.
+	ErrChainIDMismatch = errors.New("chain ID mismatch")
+	// ErrGenesisMismatch is returned when the L2 execution engine's genesis block doesn't match the
+	// genesis committed in the TaikoL1 contract.
+	ErrGenesisMismatch = errors.New("genesis header hash mismatch")
+
 	ZeroAddress                common.Address
 	waitReceiptPollingInterval        = 3 * time.Second
 	defaultWaitReceiptTimeout         = 1 * time.Minute
@@ -373,6 +380,30 @@ func SetHead(ctx context.Context, client *EthClient, headNum *big.Int) error {
 	return client.SetHead(ctxWithTimeout, headNum)
 }
 
+// CheckChainID checks whether the given chain ID is the expected one.
+func CheckChainID(expected *big.Int, actual *big.Int) error {
+	if expected.Cmp(actual) != 0 {
+		return fmt.Errorf("%w: expected %s, got %s", ErrChainIDMismatch, expected, actual)
+	}
+
+	return nil
+}
+
+// CheckGenesisHash checks whether the L2 execution engine's genesis block hash matches the genesis block
+// hash committed in the TaikoL1 contract.
+func CheckGenesisHash(protocolGenesis common.Hash, nodeGenesis common.Hash) error {
+	if protocolGenesis != nodeGenesis {
+		return fmt.Errorf(
+			"%w, the L2 execution engine may be on a wrong chain, node: %s, TaikoL1 contract: %s",
+			ErrGenesisMismatch,
+			nodeGenesis,
+			protocolGenesis,
+		)
+	}
+
+	return nil
+}
+
 // StringToBytes32 converts the given string to [32]byte.
 func StringToBytes32(str string) [32]byte {
 	var b [32]byte
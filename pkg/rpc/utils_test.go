@@ -35,6 +35,12 @@ func TestStringToBytes32(t *testing.T) {
 	require.Equal(t, [32]byte{0x61, 0x62, 0x63}, StringToBytes32("abc"))
 }
 
+func TestCheckGenesisHash(t *testing.T) {
+	genesis := common.HexToHash("0x01")
+	require.Nil(t, CheckGenesisHash(genesis, genesis))
+	require.ErrorIs(t, CheckGenesisHash(genesis, common.HexToHash("0x02")), ErrGenesisMismatch)
+}
+
 func TestL1ContentFrom(t *testing.T) {
 	client := newTestClient(t)
 	l2Head, err := client.L2.HeaderByNumber(context.Background(), nil)
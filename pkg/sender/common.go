@@ -60,27 +60,22 @@ func (s *Sender) AdjustBlobGasFee(txData types.TxData) {
 func (s *Sender) SetNonce(txData types.TxData, adjust bool) (err error) {
 	var nonce uint64
 	if adjust {
-		s.nonce, err = s.client.NonceAt(s.ctx, s.opts.From, nil)
-		if err != nil {
+		if nonce, err = s.client.NonceAt(s.ctx, s.opts.From, nil); err != nil {
 			log.Warn("Failed to get the nonce", "from", s.opts.From, "err", err)
 			return err
 		}
 	}
+
+	s.nonceMu.Lock()
+	if adjust {
+		s.setNonce(nonce)
+		s.releasedNonces = nil
+	}
 	nonce = s.nonce
+	s.nonceMu.Unlock()
 
 	if !utils.IsNil(txData) {
-		switch tx := txData.(type) {
-		case *types.DynamicFeeTx:
-			tx.Nonce = nonce
-		case *types.BlobTx:
-			tx.Nonce = nonce
-		case *types.LegacyTx:
-			tx.Nonce = nonce
-		case *types.AccessListTx:
-			tx.Nonce = nonce
-		default:
-			return fmt.Errorf("unsupported transaction type: %v", txData)
-		}
+		return setTxNonce(txData, nonce)
 	}
 	return
 }
@@ -111,8 +106,8 @@ func (s *Sender) updateGasTipGasFee(head *types.Header) error {
 	return nil
 }
 
-// buildTxData assembles the transaction data from the given transaction.
-func (s *Sender) buildTxData(tx *types.Transaction) (types.TxData, error) {
+// buildTxData assembles the transaction data from the given transaction, with the given gas limit.
+func (s *Sender) buildTxData(tx *types.Transaction, gasLimit uint64) (types.TxData, error) {
 	switch tx.Type() {
 	case types.DynamicFeeTxType:
 		return &types.DynamicFeeTx{
@@ -121,7 +116,7 @@ func (s *Sender) buildTxData(tx *types.Transaction) (types.TxData, error) {
 			Nonce:      tx.Nonce(),
 			GasFeeCap:  s.opts.GasFeeCap,
 			GasTipCap:  s.opts.GasTipCap,
-			Gas:        tx.Gas(),
+			Gas:        gasLimit,
 			Value:      tx.Value(),
 			Data:       tx.Data(),
 			AccessList: tx.AccessList(),
@@ -137,7 +132,7 @@ func (s *Sender) buildTxData(tx *types.Transaction) (types.TxData, error) {
 			Nonce:      tx.Nonce(),
 			GasFeeCap:  uint256.MustFromBig(s.opts.GasFeeCap),
 			GasTipCap:  uint256.MustFromBig(s.opts.GasTipCap),
-			Gas:        tx.Gas(),
+			Gas:        gasLimit,
 			Value:      uint256.MustFromBig(tx.Value()),
 			Data:       tx.Data(),
 			AccessList: tx.AccessList(),
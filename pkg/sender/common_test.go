@@ -1,11 +1,22 @@
 package sender
 
 import (
+	"context"
 	"math"
+	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	taikoRPC "github.com/taikoxyz/taiko-client/pkg/rpc"
 )
 
 func TestSetConfigWithDefaultValues(t *testing.T) {
@@ -13,9 +24,69 @@ func TestSetConfigWithDefaultValues(t *testing.T) {
 	assert.Equal(t, uint64(50), cfg.GasGrowthRate)
 	assert.Equal(t, uint64(1), cfg.MaxRetrys)
 	assert.Equal(t, 5*time.Minute, cfg.MaxWaitingTime)
+	assert.Equal(t, 3*time.Second, cfg.ReceiptPollInterval)
 	assert.Equal(t, uint64(math.MaxUint64), cfg.MaxGasFee)
 	assert.Equal(t, uint64(1024), cfg.MaxBlobFee)
 
 	cfg = setConfigWithDefaultValues(nil)
 	assert.Equal(t, cfg.GasGrowthRate, uint64(50))
 }
+
+// recordingEthService is a fake `eth` namespace RPC service, which records the received raw transactions.
+type recordingEthService struct {
+	mu  sync.Mutex
+	txs []common.Hash
+}
+
+func (s *recordingEthService) ChainId() *hexutil.Big { // nolint: revive,stylecheck
+	return (*hexutil.Big)(common.Big1)
+}
+
+func (s *recordingEthService) SendRawTransaction(input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.txs = append(s.txs, tx.Hash())
+
+	return tx.Hash(), nil
+}
+
+func TestBroadcastTransaction(t *testing.T) {
+	s := &Sender{ctx: context.Background()}
+
+	var services []*recordingEthService
+	for i := 0; i < 3; i++ {
+		service := new(recordingEthService)
+		server := rpc.NewServer()
+		require.Nil(t, server.RegisterName("eth", service))
+		httpServer := httptest.NewServer(server)
+		defer httpServer.Close()
+
+		client, err := taikoRPC.NewEthClient(context.Background(), httpServer.URL, time.Second)
+		require.Nil(t, err)
+
+		services = append(services, service)
+		s.broadcastClients = append(s.broadcastClients, client)
+	}
+
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(common.Big1), &types.DynamicFeeTx{
+		ChainID:   common.Big1,
+		Nonce:     1,
+		GasTipCap: common.Big1,
+		GasFeeCap: common.Big2,
+		Gas:       21_000,
+	})
+	require.Nil(t, err)
+
+	s.broadcastTransaction(tx)
+
+	for _, service := range services {
+		require.Equal(t, []common.Hash{tx.Hash()}, service.txs)
+	}
+}
@@ -0,0 +1,47 @@
+package sender
+
+import (
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+)
+
+// addGasMargin adds the configured safety margin to the given estimated gas limit, so the transaction
+// won't run out of gas after the state changes between the estimation and the inclusion. The gas limit
+// with the margin is capped by the configured cap, but never below the estimation.
+func (s *Sender) addGasMargin(estimated uint64) uint64 {
+	if s.GasLimitMargin == 0 {
+		return estimated
+	}
+
+	gasLimit := estimated + estimated*s.GasLimitMargin/100
+	if s.GasLimitMarginCap != 0 && gasLimit > s.GasLimitMarginCap {
+		gasLimit = max(estimated, s.GasLimitMarginCap)
+	}
+
+	return gasLimit
+}
+
+// recordGasMargin records if the given included transaction has consumed the safety margin added to its
+// estimated gas limit.
+func (s *Sender) recordGasMargin(tx *TxToConfirm, receipt *types.Receipt) {
+	if !gasMarginConsumed(tx, receipt) {
+		return
+	}
+
+	metrics.TxSenderGasMarginConsumedCounter.Inc(1)
+	log.Info(
+		"Transaction consumed the gas limit safety margin",
+		"txId", tx.ID,
+		"hash", receipt.TxHash,
+		"estimatedGas", tx.estimatedGas,
+		"gasUsed", receipt.GasUsed,
+		"gasLimit", tx.CurrentTx.Gas(),
+	)
+}
+
+// gasMarginConsumed checks whether the given included transaction has used more gas than its estimation.
+func gasMarginConsumed(tx *TxToConfirm, receipt *types.Receipt) bool {
+	return tx.estimatedGas != 0 && receipt.GasUsed > tx.estimatedGas
+}
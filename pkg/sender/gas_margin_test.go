@@ -0,0 +1,55 @@
+package sender
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddGasMargin(t *testing.T) {
+	s := &Sender{Config: &Config{}}
+	require.Equal(t, uint64(100_000), s.addGasMargin(100_000))
+
+	s.GasLimitMargin = 20
+	require.Equal(t, uint64(120_000), s.addGasMargin(100_000))
+
+	// Capped, but never below the estimation.
+	s.GasLimitMarginCap = 110_000
+	require.Equal(t, uint64(110_000), s.addGasMargin(100_000))
+	require.Equal(t, uint64(200_000), s.addGasMargin(200_000))
+}
+
+func TestSendTransactionGasMargin(t *testing.T) {
+	service := new(nonceEthService)
+	s := newTestSender(t, &Config{GasLimitMargin: 20}, service)
+	s.unconfirmedTxs = cmap.New[*TxToConfirm]()
+	s.txToConfirmCh = cmap.New[chan *TxToConfirm]()
+	s.opts.GasFeeCap, s.opts.GasTipCap = common.Big2, common.Big1
+
+	// The estimated gas limit is added with the margin.
+	id, err := s.SendTransaction(types.NewTx(&types.DynamicFeeTx{ChainID: common.Big1, Gas: 100_000}))
+	require.Nil(t, err)
+	require.Equal(t, []uint64{120_000}, service.gasLimits)
+	tx, ok := s.unconfirmedTxs.Get(id)
+	require.True(t, ok)
+	require.Equal(t, uint64(100_000), tx.estimatedGas)
+
+	// A fixed gas limit is never changed.
+	s.GasLimit = 100_000
+	_, err = s.SendTransaction(types.NewTx(&types.DynamicFeeTx{ChainID: common.Big1, Gas: 100_000}))
+	require.Nil(t, err)
+	require.Equal(t, []uint64{120_000, 100_000}, service.gasLimits)
+}
+
+func TestGasMarginConsumed(t *testing.T) {
+	tx := &TxToConfirm{estimatedGas: 100_000}
+	require.False(t, gasMarginConsumed(tx, &types.Receipt{GasUsed: 90_000}))
+	require.False(t, gasMarginConsumed(tx, &types.Receipt{GasUsed: 100_000}))
+	require.True(t, gasMarginConsumed(tx, &types.Receipt{GasUsed: 110_000}))
+
+	// The gas limit is not estimated.
+	require.False(t, gasMarginConsumed(&TxToConfirm{}, &types.Receipt{GasUsed: 110_000}))
+}
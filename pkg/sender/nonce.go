@@ -0,0 +1,237 @@
+package sender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/internal/utils"
+)
+
+// NonceStrategy describes how the sender allocates the nonces of its transactions.
+type NonceStrategy string
+
+// Nonce strategies.
+const (
+	// NonceStrategySequential sends the transactions one by one, with strictly sequential nonces.
+	NonceStrategySequential NonceStrategy = "sequential"
+	// NonceStrategyPooled pre-allocates a nonce for each transaction from a pool, so multiple
+	// transactions from the same account can be sent in parallel, the nonces of the failed
+	// transactions are returned to the pool and reused.
+	NonceStrategyPooled NonceStrategy = "pooled"
+	// NonceStrategyExternal fetches the nonce of each transaction from an external nonce manager.
+	NonceStrategyExternal NonceStrategy = "external"
+)
+
+// Errors returned by the L1 node when a new transaction's nonce has already been used by a pending
+// transaction, which may be sent by another sender sharing the account.
+var duplicateNonceErrors = []string{
+	"replacement transaction underpriced",
+}
+
+// alreadyKnownError is returned by the L1 node when the very same transaction is already in its pool.
+const alreadyKnownError = "already known"
+
+var (
+	errUnknownNonceStrategy = errors.New("unknown nonce strategy")
+	errNonceSourceNotSet    = errors.New("nonce source not set for the external nonce strategy")
+)
+
+// NonceSource returns the nonce for the next transaction, it is used by the external nonce strategy.
+type NonceSource func(ctx context.Context) (uint64, error)
+
+// validateNonceStrategy checks whether the nonce strategy in the given config is valid.
+func validateNonceStrategy(cfg *Config) error {
+	switch cfg.NonceStrategy {
+	case NonceStrategySequential, NonceStrategyPooled:
+		return nil
+	case NonceStrategyExternal:
+		if cfg.NonceSource == nil {
+			return errNonceSourceNotSet
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", errUnknownNonceStrategy, cfg.NonceStrategy)
+	}
+}
+
+// allocateNonce allocates the nonce for a new transaction.
+func (s *Sender) allocateNonce() (uint64, error) {
+	if s.NonceStrategy == NonceStrategyExternal {
+		return s.NonceSource(s.ctx)
+	}
+
+	s.nonceMu.Lock()
+	defer s.nonceMu.Unlock()
+
+	if s.NonceStrategy != NonceStrategyPooled {
+		return s.nonce, nil
+	}
+
+	// Reuse the lowest released nonce at first, to avoid leaving nonce gaps.
+	if len(s.releasedNonces) != 0 {
+		nonce := s.releasedNonces[0]
+		s.releasedNonces = s.releasedNonces[1:]
+		return nonce, nil
+	}
+
+	nonce := s.nonce
+	s.setNonce(s.nonce + 1)
+
+	return nonce, nil
+}
+
+// commitNonce marks the current nonce as used after a transaction has been sent, the pooled and
+// external strategies have already consumed the nonce when allocating it.
+func (s *Sender) commitNonce() {
+	if s.NonceStrategy != NonceStrategySequential {
+		return
+	}
+
+	s.nonceMu.Lock()
+	defer s.nonceMu.Unlock()
+
+	s.setNonce(s.nonce + 1)
+}
+
+// releaseNonce returns the given allocated nonce to the pool after its transaction failed to be sent,
+// so it will be reused by the next transaction.
+func (s *Sender) releaseNonce(nonce uint64) {
+	if s.NonceStrategy != NonceStrategyPooled {
+		return
+	}
+
+	s.nonceMu.Lock()
+	defer s.nonceMu.Unlock()
+
+	if nonce >= s.nonce {
+		return
+	}
+	i := sort.Search(len(s.releasedNonces), func(i int) bool { return s.releasedNonces[i] >= nonce })
+	if i < len(s.releasedNonces) && s.releasedNonces[i] == nonce {
+		return
+	}
+	s.releasedNonces = append(s.releasedNonces, 0)
+	copy(s.releasedNonces[i+1:], s.releasedNonces[i:])
+	s.releasedNonces[i] = nonce
+}
+
+// resyncNonce resyncs the tracked nonce with the L1 node after a "nonce too low" error, and
+// allocates a new nonce for the given transaction.
+func (s *Sender) resyncNonce(txData types.TxData) error {
+	if s.NonceStrategy == NonceStrategyExternal {
+		return s.assignNonce(txData)
+	}
+
+	nonce, err := s.client.NonceAt(s.ctx, s.opts.From, nil)
+	if err != nil {
+		log.Warn("Failed to get the nonce", "from", s.opts.From, "err", err)
+		return err
+	}
+
+	s.nonceMu.Lock()
+	if s.NonceStrategy == NonceStrategyPooled {
+		// Drop the released nonces which have already been used.
+		for len(s.releasedNonces) != 0 && s.releasedNonces[0] < nonce {
+			s.releasedNonces = s.releasedNonces[1:]
+		}
+		s.setNonce(utils.Max(s.nonce, nonce))
+	} else {
+		s.setNonce(nonce)
+	}
+	s.nonceMu.Unlock()
+
+	return s.assignNonce(txData)
+}
+
+// refreshNonce refetches the account's pending nonce after a duplicate nonce error, and allocates a
+// fresh nonce for the given transaction.
+func (s *Sender) refreshNonce(txData types.TxData) error {
+	if s.NonceStrategy == NonceStrategyExternal {
+		return s.assignNonce(txData)
+	}
+
+	nonce, err := s.client.PendingNonceAt(s.ctx, s.opts.From)
+	if err != nil {
+		log.Warn("Failed to get the pending nonce", "from", s.opts.From, "err", err)
+		return err
+	}
+
+	s.nonceMu.Lock()
+	// Drop the released nonces which have already been used.
+	for len(s.releasedNonces) != 0 && s.releasedNonces[0] < nonce {
+		s.releasedNonces = s.releasedNonces[1:]
+	}
+	if s.NonceStrategy == NonceStrategyPooled {
+		s.setNonce(utils.Max(s.nonce, nonce))
+	} else {
+		s.setNonce(nonce)
+	}
+	s.nonceMu.Unlock()
+
+	return s.assignNonce(txData)
+}
+
+// isDuplicateNonce checks whether the given sending error is caused by a nonce which has already been used.
+func isDuplicateNonce(err error) bool {
+	for _, duplicateErr := range duplicateNonceErrors {
+		if strings.Contains(err.Error(), duplicateErr) {
+			return true
+		}
+	}
+	return false
+}
+
+// isAlreadyKnown checks whether the given sending error is caused by the same transaction having already
+// been sent, e.g. by a previous attempt whose response was lost.
+func isAlreadyKnown(err error) bool {
+	return strings.Contains(err.Error(), alreadyKnownError)
+}
+
+// hasPendingNonce checks whether another unconfirmed transaction of this sender uses the given nonce.
+func (s *Sender) hasPendingNonce(nonce uint64, txID string) bool {
+	for id, unconfirmedTx := range s.unconfirmedTxs.Items() {
+		unconfirmedTx.mu.RLock()
+		currentTx := unconfirmedTx.CurrentTx
+		unconfirmedTx.mu.RUnlock()
+
+		if id != txID && currentTx != nil && currentTx.Nonce() == nonce {
+			return true
+		}
+	}
+
+	return false
+}
+
+// assignNonce allocates a nonce and sets it to the given transaction.
+func (s *Sender) assignNonce(txData types.TxData) error {
+	nonce, err := s.allocateNonce()
+	if err != nil {
+		return err
+	}
+
+	return setTxNonce(txData, nonce)
+}
+
+// setTxNonce sets the nonce of the given transaction.
+func setTxNonce(txData types.TxData, nonce uint64) error {
+	switch tx := txData.(type) {
+	case *types.DynamicFeeTx:
+		tx.Nonce = nonce
+	case *types.BlobTx:
+		tx.Nonce = nonce
+	case *types.LegacyTx:
+		tx.Nonce = nonce
+	case *types.AccessListTx:
+		tx.Nonce = nonce
+	default:
+		return fmt.Errorf("unsupported transaction type: %v", txData)
+	}
+
+	return nil
+}
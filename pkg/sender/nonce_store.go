@@ -0,0 +1,151 @@
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// NonceStore persists the tracked nonces of the senders, keyed by the account address, so a restarted
+// sender won't reuse the nonces of its transactions which are still pending in the mempool.
+type NonceStore interface {
+	// Load returns the persisted nonce of the given account, and whether there is one.
+	Load(address common.Address) (uint64, bool, error)
+	// Save persists the nonce of the given account.
+	Save(address common.Address, nonce uint64) error
+}
+
+// FileNonceStore is a NonceStore backed by a JSON file, which must not be shared between processes.
+type FileNonceStore struct {
+	mu     sync.Mutex
+	path   string
+	nonces map[common.Address]uint64
+}
+
+// NewFileNonceStore creates a new FileNonceStore, loading the nonces persisted in the given file, the file
+// is created on the first save if it doesn't exist.
+func NewFileNonceStore(path string) (*FileNonceStore, error) {
+	store := &FileNonceStore{path: path, nonces: map[common.Address]uint64{}}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return store, nil
+		}
+		return nil, fmt.Errorf("failed to read the nonce store: %w", err)
+	}
+	if err := json.Unmarshal(b, &store.nonces); err != nil {
+		return nil, fmt.Errorf("failed to decode the nonce store: %w", err)
+	}
+
+	return store, nil
+}
+
+// Load implements the NonceStore interface.
+func (s *FileNonceStore) Load(address common.Address) (uint64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	nonce, ok := s.nonces[address]
+	return nonce, ok, nil
+}
+
+// Save implements the NonceStore interface.
+func (s *FileNonceStore) Save(address common.Address, nonce uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if saved, ok := s.nonces[address]; ok && saved == nonce {
+		return nil
+	}
+	s.nonces[address] = nonce
+
+	b, err := json.Marshal(s.nonces)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create the nonce store: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write the nonce store: %w", err)
+	}
+	// Flush the nonces to the disk before replacing the old file, so a crash never leaves a partial file.
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write the nonce store: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write the nonce store: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// reconcileNonce reconciles the tracked nonce with the persisted nonce and the account's pending nonce on
+// startup, the higher one is adopted, so the new transactions never collide with the transactions sent
+// before the restart.
+func (s *Sender) reconcileNonce(ctx context.Context) error {
+	if s.NonceStore == nil {
+		return nil
+	}
+
+	pendingNonce, err := s.client.PendingNonceAt(ctx, s.opts.From)
+	if err != nil {
+		return fmt.Errorf("failed to get the pending nonce: %w", err)
+	}
+	persistedNonce, ok, err := s.NonceStore.Load(s.opts.From)
+	if err != nil {
+		return fmt.Errorf("failed to load the persisted nonce: %w", err)
+	}
+
+	s.nonceMu.Lock()
+	defer s.nonceMu.Unlock()
+
+	nonce := max(s.nonce, pendingNonce)
+	if ok && persistedNonce > nonce {
+		log.Warn(
+			"Persisted nonce is ahead of the pending nonce",
+			"from", s.opts.From,
+			"persistedNonce", persistedNonce,
+			"pendingNonce", pendingNonce,
+		)
+		nonce = persistedNonce
+	}
+	log.Info(
+		"Sender nonce reconciled",
+		"from", s.opts.From,
+		"nonce", nonce,
+		"persistedNonce", persistedNonce,
+		"pendingNonce", pendingNonce,
+	)
+	s.setNonce(nonce)
+
+	return nil
+}
+
+// setNonce sets the tracked nonce, and persists it if there is a nonce store, the caller must hold the
+// nonce lock.
+func (s *Sender) setNonce(nonce uint64) {
+	s.nonce = nonce
+	s.nonceMetrics.TrackedNonceGauge.Update(int64(nonce))
+
+	if s.NonceStore == nil {
+		return
+	}
+	if err := s.NonceStore.Save(s.opts.From, nonce); err != nil {
+		log.Warn("Failed to persist the nonce", "from", s.opts.From, "nonce", nonce, "err", err)
+	}
+}
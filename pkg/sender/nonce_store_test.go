@@ -0,0 +1,71 @@
+package sender
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileNonceStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nonces.json")
+
+	store, err := NewFileNonceStore(path)
+	require.Nil(t, err)
+	_, ok, err := store.Load(common.Address{1})
+	require.Nil(t, err)
+	require.False(t, ok)
+
+	require.Nil(t, store.Save(common.Address{1}, 10))
+	require.Nil(t, store.Save(common.Address{2}, 20))
+
+	// The nonces are recovered after a restart.
+	store, err = NewFileNonceStore(path)
+	require.Nil(t, err)
+	nonce, ok, err := store.Load(common.Address{1})
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(10), nonce)
+	nonce, ok, err = store.Load(common.Address{2})
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(20), nonce)
+
+	// A corrupted file is rejected.
+	require.Nil(t, os.WriteFile(path, []byte("{"), 0o600))
+	_, err = NewFileNonceStore(path)
+	require.ErrorContains(t, err, "failed to decode the nonce store")
+}
+
+func TestReconcileNonce(t *testing.T) {
+	store, err := NewFileNonceStore(filepath.Join(t.TempDir(), "nonces.json"))
+	require.Nil(t, err)
+
+	service := &nonceEthService{pendingNonce: 5}
+	s := newTestSender(t, &Config{NonceStore: store}, service)
+
+	// No persisted nonce, the pending nonce is adopted.
+	require.Nil(t, s.reconcileNonce(context.Background()))
+	require.Equal(t, uint64(5), s.nonce)
+
+	// The persisted nonce is ahead of the pending nonce, the persisted one is adopted.
+	require.Nil(t, store.Save(s.opts.From, 8))
+	require.Nil(t, s.reconcileNonce(context.Background()))
+	require.Equal(t, uint64(8), s.nonce)
+
+	// The pending nonce is ahead of the persisted nonce.
+	service.pendingNonce = 12
+	require.Nil(t, s.reconcileNonce(context.Background()))
+	require.Equal(t, uint64(12), s.nonce)
+
+	// The sent transactions' nonces are persisted.
+	require.Nil(t, s.send(newTestTx(), true))
+	require.Equal(t, []uint64{12}, service.nonces)
+	nonce, ok, err := store.Load(s.opts.From)
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(13), nonce)
+}
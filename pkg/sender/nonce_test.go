@@ -0,0 +1,262 @@
+package sender
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+	taikoRPC "github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// nonceEthService is a fake `eth` namespace RPC service, which records the nonces of the received
+// raw transactions, and rejects the transactions with the given nonce once.
+type nonceEthService struct {
+	mu          sync.Mutex
+	nonces      []uint64
+	gasLimits   []uint64
+	inFlight    int
+	maxInFlight int
+	rejectOnce  map[uint64]bool
+	// The nonces already used by another sender sharing the account, and the account's pending nonce
+	usedNonces   map[uint64]bool
+	pendingNonce uint64
+	// The nonces of the transactions already in the pool
+	knownNonces map[uint64]bool
+}
+
+func (s *nonceEthService) GetTransactionCount(_ common.Address, _ string) hexutil.Uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return hexutil.Uint64(s.pendingNonce)
+}
+
+func (s *nonceEthService) ChainId() *hexutil.Big { // nolint: revive,stylecheck
+	return (*hexutil.Big)(common.Big1)
+}
+
+func (s *nonceEthService) SendRawTransaction(input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+
+	s.mu.Lock()
+	s.inFlight++
+	s.maxInFlight = max(s.maxInFlight, s.inFlight)
+	s.mu.Unlock()
+
+	// Hold the request for a while, so the concurrent sendings overlap.
+	time.Sleep(100 * time.Millisecond)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inFlight--
+
+	if s.knownNonces[tx.Nonce()] {
+		return common.Hash{}, errors.New("already known")
+	}
+	if s.usedNonces[tx.Nonce()] {
+		return common.Hash{}, errors.New("replacement transaction underpriced")
+	}
+	if s.rejectOnce[tx.Nonce()] {
+		delete(s.rejectOnce, tx.Nonce())
+		return common.Hash{}, errors.New("insufficient funds for gas * price + value")
+	}
+	s.nonces = append(s.nonces, tx.Nonce())
+	s.gasLimits = append(s.gasLimits, tx.Gas())
+
+	return tx.Hash(), nil
+}
+
+func newTestSender(t *testing.T, cfg *Config, service *nonceEthService) *Sender {
+	server := rpc.NewServer()
+	require.Nil(t, server.RegisterName("eth", service))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	client, err := taikoRPC.NewEthClient(context.Background(), httpServer.URL, time.Second)
+	require.Nil(t, err)
+
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(key, common.Big1)
+	require.Nil(t, err)
+
+	return &Sender{
+		ctx:            context.Background(),
+		Config:         setConfigWithDefaultValues(cfg),
+		client:         client,
+		opts:           opts,
+		nonceMetrics:   metrics.NewTxSenderNonceMetrics(opts.From),
+		unconfirmedTxs: cmap.New[*TxToConfirm](),
+	}
+}
+
+func newTestTx() *TxToConfirm {
+	return &TxToConfirm{originalTx: &types.DynamicFeeTx{
+		ChainID:   common.Big1,
+		GasTipCap: common.Big1,
+		GasFeeCap: common.Big2,
+		Gas:       21_000,
+	}}
+}
+
+func TestValidateNonceStrategy(t *testing.T) {
+	require.Nil(t, validateNonceStrategy(setConfigWithDefaultValues(nil)))
+	require.Nil(t, validateNonceStrategy(&Config{NonceStrategy: NonceStrategyPooled}))
+	require.ErrorIs(t, validateNonceStrategy(&Config{NonceStrategy: NonceStrategyExternal}), errNonceSourceNotSet)
+	require.Nil(t, validateNonceStrategy(&Config{
+		NonceStrategy: NonceStrategyExternal,
+		NonceSource:   func(context.Context) (uint64, error) { return 0, nil },
+	}))
+	require.ErrorIs(t, validateNonceStrategy(&Config{NonceStrategy: "unknown"}), errUnknownNonceStrategy)
+}
+
+func TestPooledNonceStrategy(t *testing.T) {
+	service := &nonceEthService{rejectOnce: map[uint64]bool{2: true}}
+	s := newTestSender(t, &Config{NonceStrategy: NonceStrategyPooled}, service)
+
+	var (
+		wg   sync.WaitGroup
+		errs = make(chan error, 5)
+	)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- s.send(newTestTx(), true)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	var failed int
+	for err := range errs {
+		if err != nil {
+			failed++
+		}
+	}
+
+	// The transactions are sent in parallel, with distinct nonces.
+	require.Equal(t, 1, failed)
+	require.Greater(t, service.maxInFlight, 1)
+	sort.Slice(service.nonces, func(i, j int) bool { return service.nonces[i] < service.nonces[j] })
+	require.Equal(t, []uint64{0, 1, 3, 4}, service.nonces)
+
+	// The nonce of the failed transaction is reused by the next transaction.
+	require.Equal(t, []uint64{2}, s.releasedNonces)
+	require.Nil(t, s.send(newTestTx(), true))
+	require.Equal(t, uint64(2), service.nonces[len(service.nonces)-1])
+	require.Empty(t, s.releasedNonces)
+
+	// Then the pool continues with the next unused nonce.
+	require.Nil(t, s.send(newTestTx(), true))
+	require.Equal(t, uint64(5), service.nonces[len(service.nonces)-1])
+}
+
+func TestExternalNonceStrategy(t *testing.T) {
+	var next uint64 = 10
+	s := newTestSender(t, &Config{
+		NonceStrategy: NonceStrategyExternal,
+		NonceSource: func(context.Context) (uint64, error) {
+			next++
+			return next, nil
+		},
+	}, new(nonceEthService))
+
+	require.Nil(t, s.send(newTestTx(), true))
+	require.Nil(t, s.send(newTestTx(), true))
+	require.Zero(t, s.nonce)
+
+	nonce, err := s.allocateNonce()
+	require.Nil(t, err)
+	require.Equal(t, uint64(13), nonce)
+}
+
+func TestDuplicateNonceRecovery(t *testing.T) {
+	service := &nonceEthService{usedNonces: map[uint64]bool{0: true, 1: true}, pendingNonce: 2}
+	s := newTestSender(t, &Config{DuplicateNonceRetrys: 2}, service)
+
+	// The nonces 0 and 1 have been used by another sender, the transaction is sent with a fresh nonce.
+	require.Nil(t, s.send(newTestTx(), true))
+	require.Equal(t, []uint64{2}, service.nonces)
+	require.Equal(t, uint64(3), s.nonce)
+
+	// Give up after the retry limit.
+	service.usedNonces = map[uint64]bool{3: true, 4: true, 5: true}
+	service.pendingNonce = 4
+	err := s.send(newTestTx(), true)
+	require.ErrorContains(t, err, "replacement transaction underpriced")
+	require.Equal(t, []uint64{2}, service.nonces)
+}
+
+func TestAlreadyKnownTransaction(t *testing.T) {
+	service := &nonceEthService{knownNonces: map[uint64]bool{0: true}, pendingNonce: 1}
+	s := newTestSender(t, &Config{DuplicateNonceRetrys: 2}, service)
+
+	// The same transaction is already in the pool, it's treated as sent, without a fresh nonce.
+	tx := newTestTx()
+	require.Nil(t, s.send(tx, true))
+	require.Nil(t, tx.Err)
+	require.Equal(t, uint64(0), tx.CurrentTx.Nonce())
+	require.False(t, tx.sentAt.IsZero())
+	require.Empty(t, service.nonces)
+	require.Equal(t, uint64(1), s.nonce)
+}
+
+func TestDuplicateNonceOfOwnPendingTransaction(t *testing.T) {
+	service := &nonceEthService{usedNonces: map[uint64]bool{0: true}, pendingNonce: 1}
+	s := newTestSender(t, &Config{DuplicateNonceRetrys: 2, MaxGasFee: params.GWei}, service)
+
+	// The nonce is used by a pending transaction of this sender.
+	pending := newTestTx()
+	pending.ID = "pending"
+	pending.CurrentTx = types.NewTx(&types.DynamicFeeTx{Nonce: 0})
+	s.unconfirmedTxs.Set(pending.ID, pending)
+
+	// The transaction is not sent with a fresh nonce, but handled as an underpriced one.
+	tx := newTestTx()
+	require.Nil(t, s.send(tx, true))
+	require.ErrorContains(t, tx.Err, "replacement transaction underpriced")
+	require.Equal(t, uint64(0), tx.CurrentTx.Nonce())
+	require.NotZero(t, tx.bumps)
+	require.Empty(t, service.nonces)
+}
+
+func TestBeforeSend(t *testing.T) {
+	var (
+		errFenced = errors.New("fenced")
+		fenced    = true
+		service   = &nonceEthService{}
+	)
+	s := newTestSender(t, &Config{BeforeSend: func() error {
+		if fenced {
+			return errFenced
+		}
+		return nil
+	}}, service)
+
+	// Nothing is sent while fenced, and the nonce is released.
+	require.ErrorIs(t, s.send(newTestTx(), true), errFenced)
+	require.Empty(t, service.nonces)
+
+	fenced = false
+	require.Nil(t, s.send(newTestTx(), true))
+	require.Equal(t, []uint64{0}, service.nonces)
+}
@@ -0,0 +1,78 @@
+package sender
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PendingTx is a snapshot of a transaction which is waiting for its confirmation.
+type PendingTx struct {
+	ID    string      `json:"id"`
+	Nonce uint64      `json:"nonce"`
+	Hash  common.Hash `json:"hash"`
+	// The gas fee cap and gas tip cap of the latest sent transaction
+	GasFeeCap *big.Int `json:"gasFeeCap"`
+	GasTipCap *big.Int `json:"gasTipCap"`
+	// The time when the transaction was submitted at first, and last sent
+	SubmittedAt time.Time `json:"submittedAt"`
+	LastSentAt  time.Time `json:"lastSentAt"`
+	// The number of times the transaction's gas fee was bumped, and the transaction was resent
+	Bumps  uint64 `json:"bumps"`
+	Retrys uint64 `json:"retrys"`
+	// The error of the latest sending, empty if there is none
+	Error string `json:"error,omitempty"`
+}
+
+// PendingTxs returns a snapshot of the transactions which are waiting for their confirmations, sorted by
+// the nonce. The snapshot is a copy, it's safe to call while the sender is sending and replacing them.
+func (s *Sender) PendingTxs() []*PendingTx {
+	pendingTxs := make([]*PendingTx, 0, s.unconfirmedTxs.Count())
+	for _, tx := range s.unconfirmedTxs.Items() {
+		pendingTxs = append(pendingTxs, tx.snapshot())
+	}
+	sort.Slice(pendingTxs, func(i, j int) bool {
+		if pendingTxs[i].Nonce != pendingTxs[j].Nonce {
+			return pendingTxs[i].Nonce < pendingTxs[j].Nonce
+		}
+		return pendingTxs[i].SubmittedAt.Before(pendingTxs[j].SubmittedAt)
+	})
+
+	return pendingTxs
+}
+
+// snapshot returns a snapshot of the transaction.
+func (tx *TxToConfirm) snapshot() *PendingTx {
+	tx.mu.RLock()
+	defer tx.mu.RUnlock()
+
+	pendingTx := &PendingTx{
+		ID:          tx.ID,
+		SubmittedAt: tx.CreatedAt,
+		LastSentAt:  tx.sentAt,
+		Bumps:       tx.bumps,
+		Retrys:      tx.Retrys,
+	}
+	if tx.CurrentTx != nil {
+		pendingTx.Nonce = tx.CurrentTx.Nonce()
+		pendingTx.Hash = tx.CurrentTx.Hash()
+		pendingTx.GasFeeCap = tx.CurrentTx.GasFeeCap()
+		pendingTx.GasTipCap = tx.CurrentTx.GasTipCap()
+	}
+	if tx.Err != nil {
+		pendingTx.Error = tx.Err.Error()
+	}
+
+	return pendingTx
+}
+
+// update applies the given update to the transaction's state under its lock, so the snapshots taken
+// concurrently are always consistent.
+func (tx *TxToConfirm) update(f func()) {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	f()
+}
@@ -0,0 +1,62 @@
+package sender
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	cmap "github.com/orcaman/concurrent-map/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingTxs(t *testing.T) {
+	s := newTestSender(t, &Config{StuckTxTimeout: time.Nanosecond}, new(nonceEthService))
+	s.unconfirmedTxs = cmap.New[*TxToConfirm]()
+	require.Empty(t, s.PendingTxs())
+
+	var txs []*TxToConfirm
+	for i := 0; i < 2; i++ {
+		tx := newTestTx()
+		require.Nil(t, s.send(tx, true))
+		s.unconfirmedTxs.Set(tx.ID, tx)
+		txs = append(txs, tx)
+	}
+
+	pendingTxs := s.PendingTxs()
+	require.Len(t, pendingTxs, 2)
+	for i, pendingTx := range pendingTxs {
+		require.Equal(t, txs[i].ID, pendingTx.ID)
+		require.Equal(t, uint64(i), pendingTx.Nonce)
+		require.Equal(t, txs[i].CurrentTx.Hash(), pendingTx.Hash)
+		require.Equal(t, big.NewInt(2), pendingTx.GasFeeCap)
+		require.Equal(t, big.NewInt(1), pendingTx.GasTipCap)
+		require.Equal(t, txs[i].CreatedAt, pendingTx.SubmittedAt)
+		require.Zero(t, pendingTx.Bumps)
+		require.Empty(t, pendingTx.Error)
+	}
+
+	// The snapshots are taken safely while the transactions are being replaced.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 3; i++ {
+			s.replaceStuckTx(txs[0])
+		}
+	}()
+	for i := 0; i < 10; i++ {
+		require.Len(t, s.PendingTxs(), 2)
+	}
+	wg.Wait()
+
+	pendingTxs = s.PendingTxs()
+	require.Equal(t, uint64(3), pendingTxs[0].Bumps)
+	require.Equal(t, txs[0].CurrentTx.Hash(), pendingTxs[0].Hash)
+	require.Equal(t, uint64(0), pendingTxs[0].Nonce)
+	require.Zero(t, pendingTxs[1].Bumps)
+
+	// The snapshot is a copy.
+	pendingTxs[0].GasFeeCap.SetUint64(0)
+	require.NotZero(t, txs[0].CurrentTx.GasFeeCap().Uint64())
+}
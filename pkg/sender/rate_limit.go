@@ -0,0 +1,35 @@
+package sender
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/time/rate"
+)
+
+// newRateLimiter creates a token bucket rate limiter allowing the given number of transactions per second,
+// with a burst of the same size, nil is returned if there is no limit.
+func newRateLimiter(maxTxsPerSecond uint64) *rate.Limiter {
+	if maxTxsPerSecond == 0 {
+		return nil
+	}
+
+	return rate.NewLimiter(rate.Limit(maxTxsPerSecond), int(maxTxsPerSecond))
+}
+
+// waitRateLimit blocks until a new transaction can be sent under the configured rate limit, or the given
+// context is done. The transactions exceeding the rate are delayed, never dropped.
+func (s *Sender) waitRateLimit(ctx context.Context) error {
+	if s.limiter == nil {
+		return nil
+	}
+
+	s.nonceMetrics.RateLimitQueueGauge.Update(s.rateLimitQueued.Add(1))
+	defer func() { s.nonceMetrics.RateLimitQueueGauge.Update(s.rateLimitQueued.Add(-1)) }()
+
+	if err := s.limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("failed to wait for the transaction rate limit: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package sender
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitRateLimit(t *testing.T) {
+	s := newTestSender(t, nil, new(nonceEthService))
+	require.Nil(t, newRateLimiter(0))
+	require.Nil(t, s.waitRateLimit(context.Background()))
+
+	s.limiter = newRateLimiter(10)
+
+	// The burst is sent at once, the following transactions are delayed, but never dropped.
+	var (
+		start = time.Now()
+		wg    sync.WaitGroup
+		errs  = make(chan error, 15)
+	)
+	for i := 0; i < 15; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- s.waitRateLimit(context.Background())
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.Nil(t, err)
+	}
+	require.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+	require.Zero(t, s.rateLimitQueued.Load())
+}
+
+func TestWaitRateLimitCancelled(t *testing.T) {
+	s := newTestSender(t, nil, new(nonceEthService))
+	s.limiter = newRateLimiter(1)
+	require.Nil(t, s.waitRateLimit(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorContains(t, s.waitRateLimit(ctx), "failed to wait for the transaction rate limit")
+	require.Zero(t, s.rateLimitQueued.Load())
+
+	// The new transactions are cancelled along with the context.
+	_, err := s.SendTransactionWithMaxGasFee(ctx, types.NewTx(&types.DynamicFeeTx{ChainID: common.Big1}), nil)
+	require.ErrorContains(t, err, "failed to wait for the transaction rate limit")
+}
@@ -0,0 +1,135 @@
+package sender
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/holiman/uint256"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+)
+
+// blobTxMinBumpRate is the minimum gas fee bump rate in percent of a blob transaction replacement, which
+// is required by the L1 node's blob pool on the gas fee cap, the gas tip cap and the blob gas fee cap.
+const blobTxMinBumpRate = 100
+
+// replaceStuckTx replaces the given pending transaction with a higher gas fee version at the same nonce,
+// if it has been pending for longer than the configured stuck timeout since it was last sent. The gas fee
+// cap is never bumped above the sender's maximum gas fee, nor the transaction's own maximum gas fee. The
+// bumped gas fees are only kept once the replacement is accepted by the L1 node.
+func (s *Sender) replaceStuckTx(pendingTx *TxToConfirm) {
+	if s.StuckTxTimeout == 0 || time.Since(pendingTx.sentAt) < s.StuckTxTimeout {
+		return
+	}
+
+	maxGasFee := s.MaxGasFee
+	if pendingTx.maxGasFee != 0 {
+		maxGasFee = min(maxGasFee, pendingTx.maxGasFee)
+	}
+	rate := s.StuckTxGasBumpRate
+	if _, ok := pendingTx.originalTx.(*types.BlobTx); ok {
+		rate = max(rate, blobTxMinBumpRate)
+	}
+	replacement, ok := bumpGasFee(pendingTx.originalTx, rate, maxGasFee, s.MaxBlobFee)
+	if !ok {
+		log.Warn(
+			"Stuck transaction reaches the maximum gas fee, not replaced",
+			"txId", pendingTx.ID,
+			"nonce", pendingTx.CurrentTx.Nonce(),
+			"hash", pendingTx.CurrentTx.Hash(),
+			"maxGasFee", maxGasFee,
+		)
+		pendingTx.update(func() { pendingTx.sentAt = time.Now() })
+		return
+	}
+
+	rawTx, err := s.opts.Signer(s.opts.From, types.NewTx(replacement))
+	if err != nil {
+		log.Warn("Failed to sign the replacement transaction", "txId", pendingTx.ID, "err", err)
+		return
+	}
+	if err := s.client.SendTransaction(s.ctx, rawTx); err != nil {
+		log.Warn(
+			"Failed to replace the stuck transaction",
+			"txId", pendingTx.ID,
+			"nonce", rawTx.Nonce(),
+			"hash", pendingTx.CurrentTx.Hash(),
+			"err", err,
+		)
+		return
+	}
+
+	log.Info(
+		"Stuck transaction replaced",
+		"txId", pendingTx.ID,
+		"nonce", rawTx.Nonce(),
+		"oldHash", pendingTx.CurrentTx.Hash(),
+		"newHash", rawTx.Hash(),
+		"gasFeeCap", rawTx.GasFeeCap(),
+		"gasTipCap", rawTx.GasTipCap(),
+		"pending", time.Since(pendingTx.sentAt),
+	)
+	pendingTx.update(func() {
+		pendingTx.originalTx = replacement
+		pendingTx.CurrentTx = rawTx
+		pendingTx.sentAt = time.Now()
+		pendingTx.bumps++
+	})
+	metrics.TxSenderStuckReplacedCounter.Inc(1)
+	s.nonceMetrics.ReplacementCounter.Inc(1)
+	s.broadcastTransaction(rawTx)
+}
+
+// bumpGasFee returns a copy of the given transaction, with the gas fee cap and gas tip cap bumped by the
+// given rate in percent, capped by the given maximum gas fee, and the blob gas fee cap of a blob transaction
+// capped by the given maximum blob gas fee. It returns false if the gas fee cap can't be bumped any more.
+func bumpGasFee(txData types.TxData, rate uint64, maxGasFee uint64, maxBlobFee uint64) (types.TxData, bool) {
+	bump := func(fee *big.Int, maxFee uint64) *big.Int {
+		bumped := new(big.Int).Div(new(big.Int).Mul(fee, new(big.Int).SetUint64(100+rate)), big.NewInt(100))
+		// Always bump by at least 1 wei, for the very low fees.
+		if bumped.Cmp(fee) <= 0 {
+			bumped = new(big.Int).Add(fee, common.Big1)
+		}
+		if ceiling := new(big.Int).SetUint64(maxFee); bumped.Cmp(ceiling) > 0 {
+			bumped = ceiling
+		}
+		return bumped
+	}
+
+	// The gas fee fields are replaced rather than modified, so a shallow copy leaves the given transaction
+	// untouched.
+	switch tx := txData.(type) {
+	case *types.DynamicFeeTx:
+		gasFeeCap := bump(tx.GasFeeCap, maxGasFee)
+		if gasFeeCap.Cmp(tx.GasFeeCap) <= 0 {
+			return nil, false
+		}
+		gasTipCap := bump(tx.GasTipCap, maxGasFee)
+		if gasTipCap.Cmp(gasFeeCap) > 0 {
+			gasTipCap = gasFeeCap
+		}
+		bumped := *tx
+		bumped.GasFeeCap, bumped.GasTipCap = gasFeeCap, gasTipCap
+		return &bumped, true
+	case *types.BlobTx:
+		gasFeeCap := bump(tx.GasFeeCap.ToBig(), maxGasFee)
+		if gasFeeCap.Cmp(tx.GasFeeCap.ToBig()) <= 0 {
+			return nil, false
+		}
+		gasTipCap := bump(tx.GasTipCap.ToBig(), maxGasFee)
+		if gasTipCap.Cmp(gasFeeCap) > 0 {
+			gasTipCap = gasFeeCap
+		}
+		bumped := *tx
+		bumped.GasFeeCap, bumped.GasTipCap = uint256.MustFromBig(gasFeeCap), uint256.MustFromBig(gasTipCap)
+		if blobFeeCap := bump(tx.BlobFeeCap.ToBig(), maxBlobFee); blobFeeCap.Cmp(tx.BlobFeeCap.ToBig()) > 0 {
+			bumped.BlobFeeCap = uint256.MustFromBig(blobFeeCap)
+		}
+		return &bumped, true
+	default:
+		return nil, false
+	}
+}
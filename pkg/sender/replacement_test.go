@@ -0,0 +1,127 @@
+package sender
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBumpGasFee(t *testing.T) {
+	tx := &types.DynamicFeeTx{GasFeeCap: big.NewInt(100), GasTipCap: big.NewInt(10)}
+	bumped, ok := bumpGasFee(tx, 10, 1_000, 1_000)
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(110), bumped.(*types.DynamicFeeTx).GasFeeCap)
+	require.Equal(t, big.NewInt(11), bumped.(*types.DynamicFeeTx).GasTipCap)
+	// The given transaction is untouched.
+	require.Equal(t, big.NewInt(100), tx.GasFeeCap)
+	require.Equal(t, big.NewInt(10), tx.GasTipCap)
+
+	// Capped by the maximum gas fee.
+	bumped, ok = bumpGasFee(bumped, 10, 115, 1_000)
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(115), bumped.(*types.DynamicFeeTx).GasFeeCap)
+	_, ok = bumpGasFee(bumped, 10, 115, 1_000)
+	require.False(t, ok)
+
+	// Bumped by at least 1 wei.
+	bumped, ok = bumpGasFee(&types.DynamicFeeTx{GasFeeCap: big.NewInt(1), GasTipCap: big.NewInt(1)}, 10, 1_000, 1_000)
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(2), bumped.(*types.DynamicFeeTx).GasFeeCap)
+	require.Equal(t, big.NewInt(2), bumped.(*types.DynamicFeeTx).GasTipCap)
+
+	blobTx := &types.BlobTx{
+		GasFeeCap:  uint256.NewInt(100),
+		GasTipCap:  uint256.NewInt(100),
+		BlobFeeCap: uint256.NewInt(100),
+	}
+	bumped, ok = bumpGasFee(blobTx, 20, 110, 1_000)
+	require.True(t, ok)
+	require.Equal(t, uint256.NewInt(110), bumped.(*types.BlobTx).GasFeeCap)
+	require.Equal(t, uint256.NewInt(110), bumped.(*types.BlobTx).GasTipCap)
+	require.Equal(t, uint256.NewInt(120), bumped.(*types.BlobTx).BlobFeeCap)
+	require.Equal(t, uint256.NewInt(100), blobTx.BlobFeeCap)
+
+	_, ok = bumpGasFee(&types.LegacyTx{GasPrice: big.NewInt(1)}, 10, 1_000, 1_000)
+	require.False(t, ok)
+}
+
+func TestReplaceStuckTx(t *testing.T) {
+	service := new(nonceEthService)
+	s := newTestSender(t, &Config{StuckTxTimeout: time.Minute}, service)
+
+	tx := newTestTx()
+	require.Nil(t, s.send(tx, true))
+	require.Equal(t, []uint64{0}, service.nonces)
+	hash := tx.CurrentTx.Hash()
+
+	// Not stuck yet.
+	s.replaceStuckTx(tx)
+	require.Equal(t, hash, tx.CurrentTx.Hash())
+
+	// Replaced at the same nonce with a higher gas fee.
+	tx.sentAt = time.Now().Add(-2 * time.Minute)
+	s.replaceStuckTx(tx)
+	require.Equal(t, []uint64{0, 0}, service.nonces)
+	require.NotEqual(t, hash, tx.CurrentTx.Hash())
+	require.Equal(t, big.NewInt(3), tx.CurrentTx.GasFeeCap())
+	require.Equal(t, uint64(1), s.nonce)
+
+	// Never bumped above the transaction's maximum gas fee.
+	tx.maxGasFee = 3
+	tx.sentAt = time.Now().Add(-2 * time.Minute)
+	s.replaceStuckTx(tx)
+	require.Equal(t, []uint64{0, 0}, service.nonces)
+	require.Equal(t, big.NewInt(3), tx.CurrentTx.GasFeeCap())
+}
+
+func TestReplaceStuckTxFailed(t *testing.T) {
+	service := &nonceEthService{}
+	s := newTestSender(t, &Config{StuckTxTimeout: time.Minute, StuckTxGasBumpRate: 50}, service)
+
+	tx := newTestTx()
+	tx.originalTx.(*types.DynamicFeeTx).GasFeeCap = big.NewInt(100)
+	require.Nil(t, s.send(tx, true))
+	hash := tx.CurrentTx.Hash()
+
+	// The rejected replacement doesn't change the pending transaction.
+	service.rejectOnce = map[uint64]bool{0: true}
+	tx.sentAt = time.Now().Add(-2 * time.Minute)
+	s.replaceStuckTx(tx)
+	require.Equal(t, hash, tx.CurrentTx.Hash())
+	require.Equal(t, big.NewInt(100), tx.originalTx.(*types.DynamicFeeTx).GasFeeCap)
+	require.Zero(t, tx.bumps)
+
+	// So the next replacement isn't bumped twice.
+	s.replaceStuckTx(tx)
+	require.Equal(t, big.NewInt(150), tx.CurrentTx.GasFeeCap())
+	require.Equal(t, big.NewInt(150), tx.originalTx.(*types.DynamicFeeTx).GasFeeCap)
+	require.Equal(t, uint64(1), tx.bumps)
+}
+
+func TestReplaceStuckBlobTx(t *testing.T) {
+	service := new(nonceEthService)
+	s := newTestSender(t, &Config{StuckTxTimeout: time.Minute, StuckTxGasBumpRate: 10}, service)
+
+	tx := &TxToConfirm{originalTx: &types.BlobTx{
+		ChainID:    uint256.NewInt(1),
+		GasTipCap:  uint256.NewInt(10),
+		GasFeeCap:  uint256.NewInt(100),
+		BlobFeeCap: uint256.NewInt(100),
+		Gas:        21_000,
+		BlobHashes: []common.Hash{{0x01}},
+	}}
+	require.Nil(t, s.send(tx, true))
+
+	// A blob transaction replacement bumps all the gas fee caps by at least 100%.
+	tx.sentAt = time.Now().Add(-2 * time.Minute)
+	s.replaceStuckTx(tx)
+	require.Equal(t, []uint64{0, 0}, service.nonces)
+	require.Equal(t, big.NewInt(200), tx.CurrentTx.GasFeeCap())
+	require.Equal(t, big.NewInt(20), tx.CurrentTx.GasTipCap())
+	require.Equal(t, big.NewInt(200), tx.CurrentTx.BlobGasFeeCap())
+}
@@ -9,6 +9,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -18,7 +19,9 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	cmap "github.com/orcaman/concurrent-map/v2"
 	"github.com/pborman/uuid"
+	"golang.org/x/time/rate"
 
+	"github.com/taikoxyz/taiko-client/internal/errlog"
 	"github.com/taikoxyz/taiko-client/internal/metrics"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
 )
@@ -28,9 +31,9 @@ var (
 	unconfirmedTxsCap           = 100
 	nonceIncorrectRetrys        = 3
 	unconfirmedTxsCheckInternal = 2 * time.Second
-	chainHeadFetchInterval      = 3 * time.Second
 	errTimeoutInMempool         = errors.New("transaction in mempool for too long")
 	errToManyPendings           = errors.New("too many pending transactions")
+	errBroadcastChainIDMismatch = errors.New("broadcast endpoint chain ID mismatch")
 )
 
 // Config represents the configuration of the transaction sender.
@@ -41,6 +44,8 @@ type Config struct {
 	MaxRetrys uint64 `default:"0"`
 	// The maximum waiting time for the inclusion of transactions.
 	MaxWaitingTime time.Duration `default:"5m"`
+	// The interval to poll the chain head, and the receipts of the pending transactions on each new head.
+	ReceiptPollInterval time.Duration `default:"3s"`
 	// The gas limit for transactions.
 	GasLimit uint64 `default:"0"`
 	// The gas rate to increase the gas price, 20 means 20% gas growth rate.
@@ -49,12 +54,49 @@ type Config struct {
 	MaxGasFee uint64 `default:"0xffffffffffffffff"` // Use `math.MaxUint64` as default value
 	// The maximum blob gas fee can be used when sending transactions.
 	MaxBlobFee uint64 `default:"0xffffffffffffffff"` // Use `math.MaxUint64` as default value
+	// The extra L1 endpoints which the signed transactions will also be broadcasted to.
+	BroadcastEndpoints []string
+	// The strategy to allocate the nonces of the transactions.
+	NonceStrategy NonceStrategy `default:"sequential"`
+	// The external nonce manager, required by the external nonce strategy.
+	NonceSource NonceSource
+	// The safety margin rate added to the estimated gas limits, 20 means 1.2x the estimation, a fixed gas
+	// limit is never changed.
+	GasLimitMargin uint64 `default:"0"`
+	// The maximum gas limit after adding the safety margin, 0 means no cap.
+	GasLimitMarginCap uint64 `default:"0"`
+	// The maximum number of new transactions sent per second, the transactions exceeding the rate are
+	// delayed, 0 means no limit.
+	MaxTxsPerSecond uint64 `default:"0"`
+	// The maximum retry times with a fresh nonce, when a new transaction's nonce has already been used by
+	// another sender sharing the account, 0 means no retry.
+	DuplicateNonceRetrys uint64 `default:"0"`
+	// The store to persist the tracked nonce across restarts, nil means the nonce is only tracked in memory.
+	NonceStore NonceStore
+	// The time after which a pending transaction is considered stuck, and replaced with a higher gas fee
+	// version at the same nonce, 0 means never replacing the stuck transactions.
+	StuckTxTimeout time.Duration `default:"0"`
+	// The gas fee rate to bump a stuck transaction by, 10 means 10% bump.
+	StuckTxGasBumpRate uint64 `default:"10"`
+	// Checked right before each transaction, including the replacements, is sent to the L1 node, the
+	// transaction is not sent if an error is returned, nil means no check.
+	BeforeSend func() error
 }
 
 // TxToConfirm represents a transaction which is waiting for its confirmation.
 type TxToConfirm struct {
 	confirmations uint64
 	originalTx    types.TxData
+	// The time when the current transaction was sent
+	sentAt time.Time
+	// The maximum gas fee when replacing the transaction, 0 means no limit besides the sender's
+	maxGasFee uint64
+	// The number of times the gas fee was bumped
+	bumps uint64
+	// The estimated gas limit before adding the safety margin, 0 if the gas limit is not estimated
+	estimatedGas uint64
+	// Guards the state read by the snapshots
+	mu sync.RWMutex
 
 	ID        string
 	Retrys    uint64
@@ -70,32 +112,56 @@ type Sender struct {
 	ctx context.Context
 	*Config
 
-	head   *types.Header
-	client *rpc.EthClient
+	head             *types.Header
+	client           *rpc.EthClient
+	broadcastClients []*rpc.EthClient
 
-	nonce uint64
-	opts  *bind.TransactOpts
+	nonce   uint64
+	nonceMu sync.Mutex
+	// The nonces allocated by the pooled strategy but not used, since their transactions failed to be sent
+	releasedNonces []uint64
+	opts           *bind.TransactOpts
 
 	unconfirmedTxs cmap.ConcurrentMap[string, *TxToConfirm]
 	txToConfirmCh  cmap.ConcurrentMap[string, chan *TxToConfirm]
 
+	nonceMetrics *metrics.TxSenderNonceMetrics
+
+	// Token bucket rate limiter of the new transactions, nil means no limit
+	limiter *rate.Limiter
+	// Number of the new transactions waiting for the rate limiter
+	rateLimitQueued atomic.Int64
+
 	mu     sync.Mutex
 	wg     sync.WaitGroup
 	stopCh chan struct{}
 }
 
-// NewSender creates a new instance of Sender.
+// NewSender creates a new instance of Sender, signing the transactions with the given private key.
 func NewSender(ctx context.Context, cfg *Config, client *rpc.EthClient, priv *ecdsa.PrivateKey) (*Sender, error) {
-	cfg = setConfigWithDefaultValues(cfg)
+	return NewSenderWithSigner(ctx, cfg, client, NewLocalSigner(priv, client.ChainID))
+}
 
-	// Create a new transactor
-	opts, err := bind.NewKeyedTransactorWithChainID(priv, client.ChainID)
-	if err != nil {
+// NewSenderWithSigner creates a new instance of Sender, signing the transactions with the given signer.
+func NewSenderWithSigner(ctx context.Context, cfg *Config, client *rpc.EthClient, signer Signer) (*Sender, error) {
+	cfg = setConfigWithDefaultValues(cfg)
+	if err := validateNonceStrategy(cfg); err != nil {
 		return nil, err
 	}
-	// Do not automatically send transactions
-	opts.NoSend = true
-	opts.GasLimit = cfg.GasLimit
+
+	// Create a new transactor, do not automatically send transactions
+	opts := &bind.TransactOpts{
+		From: signer.Address(),
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != signer.Address() {
+				return nil, bind.ErrNotAuthorized
+			}
+			return signer.SignTx(tx)
+		},
+		Context:  context.Background(),
+		NoSend:   true,
+		GasLimit: cfg.GasLimit,
+	}
 
 	// Add the sender to the root sender.
 	if root := sendersMap[client.ChainID.Uint64()]; root == nil {
@@ -127,8 +193,26 @@ func NewSender(ctx context.Context, cfg *Config, client *rpc.EthClient, priv *ec
 		opts:           opts,
 		unconfirmedTxs: cmap.New[*TxToConfirm](),
 		txToConfirmCh:  cmap.New[chan *TxToConfirm](),
+		nonceMetrics:   metrics.NewTxSenderNonceMetrics(opts.From),
+		limiter:        newRateLimiter(cfg.MaxTxsPerSecond),
 		stopCh:         make(chan struct{}),
 	}
+	sender.nonceMetrics.TrackedNonceGauge.Update(int64(nonce))
+	if err := sender.reconcileNonce(ctx); err != nil {
+		return nil, err
+	}
+
+	// Connect to the broadcast endpoints, which should all be on the same chain.
+	for _, endpoint := range cfg.BroadcastEndpoints {
+		broadcastClient, err := rpc.NewEthClient(ctx, endpoint, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to broadcast endpoint %s: %w", endpoint, err)
+		}
+		if err := rpc.CheckChainID(client.ChainID, broadcastClient.ChainID); err != nil {
+			return nil, fmt.Errorf("%w: broadcast endpoint %s: %w", errBroadcastChainIDMismatch, endpoint, err)
+		}
+		sender.broadcastClients = append(sender.broadcastClients, broadcastClient)
+	}
 
 	// Initialize the gas fee related fields
 	if err = sender.updateGasTipGasFee(head); err != nil {
@@ -207,19 +291,22 @@ func (s *Sender) SendRawTransaction(
 	data []byte,
 	sidecar *types.BlobTxSidecar,
 ) (string, error) {
+	if err := s.waitRateLimit(ctx); err != nil {
+		return "", err
+	}
 	// If there are too many pending transactions to be confirmed, return an error here.
 	if s.unconfirmedTxs.Count() >= unconfirmedTxsCap {
 		return "", errToManyPendings
 	}
-	if nonce == 0 {
-		nonce = s.nonce
-	}
+	// Allocate a nonce when sending the transaction if not specified.
+	resetNonce := nonce == 0
 
 	var (
-		originalTx types.TxData
-		opts       = s.GetOpts(ctx)
-		gasLimit   = s.GasLimit
-		err        error
+		originalTx   types.TxData
+		opts         = s.GetOpts(ctx)
+		gasLimit     = s.GasLimit
+		estimatedGas uint64
+		err          error
 	)
 	if sidecar != nil {
 		opts.Value = value
@@ -231,6 +318,9 @@ func (s *Sender) SendRawTransaction(
 			return "", err
 		}
 		blobTx.Nonce = nonce
+		if gasLimit == 0 {
+			estimatedGas, blobTx.Gas = blobTx.Gas, s.addGasMargin(blobTx.Gas)
+		}
 		originalTx = blobTx
 	} else {
 		if gasLimit == 0 {
@@ -244,6 +334,7 @@ func (s *Sender) SendRawTransaction(
 			}); err != nil {
 				return "", err
 			}
+			estimatedGas, gasLimit = gasLimit, s.addGasMargin(gasLimit)
 		}
 
 		originalTx = &types.DynamicFeeTx{
@@ -258,15 +349,16 @@ func (s *Sender) SendRawTransaction(
 		}
 	}
 
-	txToConfirm := &TxToConfirm{originalTx: originalTx}
+	txToConfirm := &TxToConfirm{originalTx: originalTx, estimatedGas: estimatedGas}
 
-	if err := s.send(txToConfirm, false); err != nil && !strings.Contains(err.Error(), "replacement transaction") {
+	if err := s.send(txToConfirm, resetNonce); err != nil && !strings.Contains(err.Error(), "replacement transaction") {
 		log.Error(
 			"Failed to send transaction",
 			"txId", txToConfirm.ID,
-			"nonce", nonce,
+			"nonce", types.NewTx(originalTx).Nonce(),
 			"err", err,
 		)
+		errlog.Record(errlog.ComponentSender, "Failed to send transaction", err, "txId", txToConfirm.ID)
 		return "", err
 	}
 
@@ -280,16 +372,52 @@ func (s *Sender) SendRawTransaction(
 
 // SendTransaction sends a transaction to the given Ethereum node.
 func (s *Sender) SendTransaction(tx *types.Transaction) (string, error) {
+	return s.sendTransaction(s.ctx, tx, 0)
+}
+
+// SendTransactionWithMaxGasFee sends a transaction to the given Ethereum node, the transaction's gas fee
+// cap is never bumped above the given maximum gas fee when replacing it after being stuck.
+func (s *Sender) SendTransactionWithMaxGasFee(
+	ctx context.Context,
+	tx *types.Transaction,
+	maxGasFee *big.Int,
+) (string, error) {
+	if maxGasFee == nil || !maxGasFee.IsUint64() {
+		return s.sendTransaction(ctx, tx, 0)
+	}
+	return s.sendTransaction(ctx, tx, maxGasFee.Uint64())
+}
+
+// sendTransaction sends a transaction with the given maximum gas fee for its replacements, waiting for the
+// rate limit until the given context is done.
+func (s *Sender) sendTransaction(ctx context.Context, tx *types.Transaction, maxGasFee uint64) (string, error) {
+	if err := s.waitRateLimit(ctx); err != nil {
+		return "", err
+	}
 	if s.unconfirmedTxs.Count() >= unconfirmedTxsCap {
 		return "", errToManyPendings
 	}
 
-	txData, err := s.buildTxData(tx)
+	// The transaction's gas limit is estimated when building it, unless the sender has a fixed gas limit.
+	var (
+		estimatedGas uint64
+		gasLimit     = tx.Gas()
+	)
+	if s.GasLimit == 0 {
+		estimatedGas, gasLimit = gasLimit, s.addGasMargin(gasLimit)
+	}
+
+	txData, err := s.buildTxData(tx, gasLimit)
 	if err != nil {
 		return "", err
 	}
 
-	txToConfirm := &TxToConfirm{originalTx: txData, CurrentTx: tx}
+	txToConfirm := &TxToConfirm{
+		originalTx:   txData,
+		CurrentTx:    tx,
+		maxGasFee:    maxGasFee,
+		estimatedGas: estimatedGas,
+	}
 
 	if err = s.send(txToConfirm, true); err != nil && !strings.Contains(err.Error(), "replacement transaction") {
 		log.Error(
@@ -298,6 +426,7 @@ func (s *Sender) SendTransaction(tx *types.Transaction) (string, error) {
 			"hash", tx.Hash(),
 			"err", err,
 		)
+		errlog.Record(errlog.ComponentSender, "Failed to send transaction", err, "txId", txToConfirm.ID)
 		return "", err
 	}
 
@@ -310,9 +439,13 @@ func (s *Sender) SendTransaction(tx *types.Transaction) (string, error) {
 }
 
 // send is the internal method to send the real transaction.
-func (s *Sender) send(tx *TxToConfirm, resetNonce bool) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *Sender) send(tx *TxToConfirm, resetNonce bool) (err error) {
+	// The pooled and external strategies allocate a dedicated nonce for each transaction, so
+	// the transactions can be sent in parallel.
+	if s.NonceStrategy == NonceStrategySequential {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+	}
 
 	// Set the transaction ID and its creation time
 	if tx.ID == "" {
@@ -324,24 +457,42 @@ func (s *Sender) send(tx *TxToConfirm, resetNonce bool) error {
 
 	if resetNonce {
 		// Set the nonce of the transaction.
-		if err := s.SetNonce(originalTx, false); err != nil {
+		if err := s.assignNonce(originalTx); err != nil {
 			return err
 		}
+		defer func() {
+			if err != nil && !strings.Contains(err.Error(), "replacement transaction") {
+				s.releaseNonce(types.NewTx(originalTx).Nonce())
+			}
+		}()
 	}
 
-	for i := 0; i < nonceIncorrectRetrys; i++ {
+	var duplicateNonceRetrys uint64
+	for i := 0; i < nonceIncorrectRetrys+int(s.DuplicateNonceRetrys); i++ {
+		if s.BeforeSend != nil {
+			if err := s.BeforeSend(); err != nil {
+				return err
+			}
+		}
 		// Retry when nonce is incorrect
 		rawTx, err := s.opts.Signer(s.opts.From, types.NewTx(originalTx))
 		if err != nil {
 			return err
 		}
-		tx.CurrentTx = rawTx
+		tx.update(func() { tx.CurrentTx = rawTx })
 		err = s.client.SendTransaction(s.ctx, rawTx)
-		tx.Err = err
+		// The very same transaction is already in the pool, so it has been sent, never resend it with a
+		// fresh nonce, which would execute it twice.
+		if err != nil && isAlreadyKnown(err) {
+			log.Info("Transaction already known by the L1 node", "txId", tx.ID, "nonce", rawTx.Nonce(), "hash", rawTx.Hash())
+			err = nil
+		}
+		tx.update(func() { tx.Err = err })
 		// Check if the error is nonce too low
 		if err != nil {
 			if strings.Contains(err.Error(), "nonce too low") {
-				if err := s.SetNonce(originalTx, true); err != nil {
+				s.nonceMetrics.NonceGapCounter.Inc(1)
+				if err := s.resyncNonce(originalTx); err != nil {
 					log.Error(
 						"Failed to set nonce when appear nonce too low",
 						"txId", tx.ID,
@@ -360,6 +511,37 @@ func (s *Sender) send(tx *TxToConfirm, resetNonce bool) error {
 				}
 				continue
 			}
+			// The nonce of a new transaction has already been used by another sender sharing the account,
+			// never bump the gas fee in this case, which would replace the other sender's transaction. If
+			// the nonce is used by a pending transaction of this sender, it's handled as an underpriced one.
+			if resetNonce && s.DuplicateNonceRetrys != 0 && isDuplicateNonce(err) &&
+				!s.hasPendingNonce(rawTx.Nonce(), tx.ID) {
+				if duplicateNonceRetrys >= s.DuplicateNonceRetrys {
+					log.Error(
+						"Failed to send transaction, nonce has already been used",
+						"txId", tx.ID,
+						"nonce", tx.CurrentTx.Nonce(),
+						"hash", rawTx.Hash(),
+						"retrys", duplicateNonceRetrys,
+						"err", err,
+					)
+					return err
+				}
+				duplicateNonceRetrys++
+				s.nonceMetrics.DuplicateNonceCounter.Inc(1)
+				log.Warn(
+					"Nonce has already been used, retry sending the transaction with a fresh nonce",
+					"txId", tx.ID,
+					"nonce", tx.CurrentTx.Nonce(),
+					"hash", rawTx.Hash(),
+					"retry", duplicateNonceRetrys,
+					"err", err,
+				)
+				if err := s.refreshNonce(originalTx); err != nil {
+					return err
+				}
+				continue
+			}
 			// handle the list:
 			// ErrUnderpriced: "transaction underpriced"
 			// ErrReplaceUnderpriced: "replacement transaction underpriced"
@@ -371,6 +553,8 @@ func (s *Sender) send(tx *TxToConfirm, resetNonce bool) error {
 				} else {
 					s.AdjustGasFee(originalTx)
 				}
+				tx.update(func() { tx.bumps++ })
+				s.nonceMetrics.ReplacementCounter.Inc(1)
 				log.Warn(
 					"Replacement transaction underpriced",
 					"txId", tx.ID,
@@ -390,10 +574,12 @@ func (s *Sender) send(tx *TxToConfirm, resetNonce bool) error {
 			return err
 		}
 
+		tx.update(func() { tx.sentAt = time.Now() })
 		metrics.TxSenderSentCounter.Inc(1)
+		s.broadcastTransaction(rawTx)
 		break
 	}
-	s.nonce++
+	s.commitNonce()
 	return nil
 }
 
@@ -401,7 +587,7 @@ func (s *Sender) send(tx *TxToConfirm, resetNonce bool) error {
 func (s *Sender) loop() {
 	defer s.wg.Done()
 
-	chainHeadFetchTicker := time.NewTicker(chainHeadFetchInterval)
+	chainHeadFetchTicker := time.NewTicker(s.ReceiptPollInterval)
 	defer chainHeadFetchTicker.Stop()
 
 	unconfirmedTxsCheckTicker := time.NewTicker(unconfirmedTxsCheckInternal)
@@ -415,6 +601,7 @@ func (s *Sender) loop() {
 			return
 		case <-unconfirmedTxsCheckTicker.C:
 			s.resendUnconfirmedTxs()
+			s.updateOldestUnconfirmedTxAge()
 		case <-chainHeadFetchTicker.C:
 			newHead, err := s.client.HeaderByNumber(s.ctx, nil)
 			if err != nil {
@@ -431,6 +618,7 @@ func (s *Sender) loop() {
 			}
 			// Check the unconfirmed transactions
 			s.checkPendingTransactionsConfirmation()
+			s.updatePendingNonce()
 		}
 	}
 }
@@ -441,11 +629,12 @@ func (s *Sender) resendUnconfirmedTxs() {
 		if unconfirmedTx.Err == nil {
 			continue
 		}
-		unconfirmedTx.Retrys++
+		unconfirmedTx.update(func() { unconfirmedTx.Retrys++ })
 		if s.MaxRetrys != 0 && unconfirmedTx.Retrys >= s.MaxRetrys {
 			s.releaseUnconfirmedTx(id)
 			continue
 		}
+		s.nonceMetrics.ReplacementCounter.Inc(1)
 		if err := s.send(unconfirmedTx, true); err != nil {
 			metrics.TxSenderUnconfirmedCounter.Inc(1)
 			log.Warn(
@@ -468,7 +657,7 @@ func (s *Sender) checkPendingTransactionsConfirmation() {
 		}
 		if pendingTx.Receipt == nil {
 			// Ignore the transaction if it is pending.
-			tx, isPending, err := s.client.TransactionByHash(s.ctx, pendingTx.CurrentTx.Hash())
+			tx, isPending, err := s.transactionByHash(pendingTx.CurrentTx.Hash())
 			if err != nil {
 				log.Warn(
 					"Failed to fetch transaction",
@@ -482,15 +671,18 @@ func (s *Sender) checkPendingTransactionsConfirmation() {
 			if isPending {
 				// If the transaction is in mempool for too long, replace it.
 				if time.Since(tx.Time()) > s.MaxWaitingTime {
-					pendingTx.Err = errTimeoutInMempool
+					pendingTx.update(func() { pendingTx.Err = errTimeoutInMempool })
+					continue
 				}
+				// If the transaction is stuck, replace it with a higher gas fee at the same nonce.
+				s.replaceStuckTx(pendingTx)
 				continue
 			}
 			// Get the transaction receipt.
-			receipt, err := s.client.TransactionReceipt(s.ctx, pendingTx.CurrentTx.Hash())
+			receipt, err := s.transactionReceipt(pendingTx.CurrentTx.Hash())
 			if err != nil {
 				if err.Error() == "not found" {
-					pendingTx.Err = err
+					pendingTx.update(func() { pendingTx.Err = err })
 					s.releaseUnconfirmedTx(id)
 				}
 				log.Warn("Failed to get the transaction receipt", "hash", pendingTx.CurrentTx.Hash(), "err", err)
@@ -507,8 +699,11 @@ func (s *Sender) checkPendingTransactionsConfirmation() {
 			metrics.TxSenderTxIncludedTimeGauge.Update(int64(time.Since(pendingTx.CreatedAt).Seconds()))
 
 			pendingTx.Receipt = receipt
+			s.recordGasMargin(pendingTx, receipt)
 			if receipt.Status != types.ReceiptStatusSuccessful {
-				pendingTx.Err = fmt.Errorf("transaction status is failed, hash: %s", receipt.TxHash)
+				pendingTx.update(func() {
+					pendingTx.Err = fmt.Errorf("transaction status is failed, hash: %s", receipt.TxHash)
+				})
 				metrics.TxSenderConfirmedFailedCounter.Inc(1)
 				s.releaseUnconfirmedTx(id)
 				continue
@@ -522,6 +717,79 @@ func (s *Sender) checkPendingTransactionsConfirmation() {
 	}
 }
 
+// broadcastTransaction sends the given signed transaction to all the broadcast endpoints, the
+// duplicate broadcasts of a same signed transaction are harmless, so errors are only logged.
+func (s *Sender) broadcastTransaction(tx *types.Transaction) {
+	var wg sync.WaitGroup
+	for _, client := range s.broadcastClients {
+		wg.Add(1)
+		go func(client *rpc.EthClient) {
+			defer wg.Done()
+			if err := client.SendTransaction(s.ctx, tx); err != nil {
+				log.Debug("Failed to broadcast the transaction", "hash", tx.Hash(), "err", err)
+			}
+		}(client)
+	}
+	wg.Wait()
+}
+
+// transactionByHash fetches the given transaction from the main L1 endpoint, and then
+// the broadcast endpoints, until the transaction is found.
+func (s *Sender) transactionByHash(hash common.Hash) (tx *types.Transaction, isPending bool, err error) {
+	if tx, isPending, err = s.client.TransactionByHash(s.ctx, hash); err == nil {
+		return tx, isPending, nil
+	}
+	for _, client := range s.broadcastClients {
+		if tx, isPending, err := client.TransactionByHash(s.ctx, hash); err == nil {
+			return tx, isPending, nil
+		}
+	}
+	return nil, false, err
+}
+
+// transactionReceipt fetches the given transaction's receipt from the main L1 endpoint, and then
+// the broadcast endpoints, so the inclusion can be tracked from whichever endpoint confirms it first.
+func (s *Sender) transactionReceipt(hash common.Hash) (receipt *types.Receipt, err error) {
+	if receipt, err = s.client.TransactionReceipt(s.ctx, hash); err == nil {
+		return receipt, nil
+	}
+	for _, client := range s.broadcastClients {
+		if receipt, err := client.TransactionReceipt(s.ctx, hash); err == nil {
+			return receipt, nil
+		}
+	}
+	return nil, err
+}
+
+// updatePendingNonce fetches the pending nonce of the sender account from the node, and records
+// a nonce gap if the account has been used by others.
+func (s *Sender) updatePendingNonce() {
+	pendingNonce, err := s.client.PendingNonceAt(s.ctx, s.opts.From)
+	if err != nil {
+		log.Warn("Failed to get the pending nonce", "from", s.opts.From, "err", err)
+		return
+	}
+	s.nonceMetrics.PendingNonceGauge.Update(int64(pendingNonce))
+
+	s.nonceMu.Lock()
+	defer s.nonceMu.Unlock()
+	if pendingNonce > s.nonce {
+		log.Warn("Nonce gap detected", "from", s.opts.From, "trackedNonce", s.nonce, "pendingNonce", pendingNonce)
+		s.nonceMetrics.NonceGapCounter.Inc(1)
+	}
+}
+
+// updateOldestUnconfirmedTxAge records the age of the oldest unconfirmed transaction.
+func (s *Sender) updateOldestUnconfirmedTxAge() {
+	var oldest time.Duration
+	for _, unconfirmedTx := range s.unconfirmedTxs.Items() {
+		if age := time.Since(unconfirmedTx.CreatedAt); age > oldest {
+			oldest = age
+		}
+	}
+	s.nonceMetrics.OldestUnconfirmedTxAgeGauge.Update(int64(oldest.Seconds()))
+}
+
 // releaseUnconfirmedTx releases the unconfirmed transaction by the transaction ID.
 func (s *Sender) releaseUnconfirmedTx(txID string) {
 	txConfirm, _ := s.unconfirmedTxs.Get(txID)
@@ -11,10 +11,12 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	gethMetrics "github.com/ethereum/go-ethereum/metrics"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/suite"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/taikoxyz/taiko-client/internal/metrics"
 	"github.com/taikoxyz/taiko-client/internal/testutils"
 	"github.com/taikoxyz/taiko-client/internal/utils"
 	"github.com/taikoxyz/taiko-client/pkg/sender"
@@ -174,6 +176,54 @@ func (s *SenderTestSuite) TestNonceTooLow() {
 	s.Equal(nonce, confirm.CurrentTx.Nonce())
 }
 
+func (s *SenderTestSuite) TestNonceMetrics() {
+	gethMetrics.Enabled = true
+	defer func() { gethMetrics.Enabled = false }()
+
+	priv, err := crypto.ToECDSA(common.FromHex(os.Getenv("L1_PROVER_PRIVATE_KEY")))
+	s.Nil(err)
+	send, err := sender.NewSender(context.Background(), &sender.Config{
+		MaxGasFee:      20000000000,
+		GasGrowthRate:  50,
+		GasLimit:       2000000,
+		MaxWaitingTime: time.Second * 10,
+	}, s.RPCClient.L1, priv)
+	s.Nil(err)
+	defer send.Close()
+
+	client := s.RPCClient.L1
+	nonce, err := client.NonceAt(context.Background(), send.Address(), nil)
+	s.Nil(err)
+	pendingNonce, err := client.PendingNonceAt(context.Background(), send.Address())
+	s.Nil(err)
+	// Run test only if mempool has no pending transactions.
+	if pendingNonce > nonce || nonce < 3 {
+		return
+	}
+
+	var (
+		nonceMetrics = metrics.NewTxSenderNonceMetrics(send.Address())
+		gapsBefore   = nonceMetrics.NonceGapCounter.Snapshot().Count()
+	)
+	s.Equal(int64(nonce), nonceMetrics.TrackedNonceGauge.Snapshot().Value())
+
+	// Resubmit the transaction with a too low nonce.
+	txID, err := send.SendRawTransaction(
+		context.Background(),
+		nonce-3,
+		&common.Address{},
+		big.NewInt(1),
+		nil,
+		nil,
+	)
+	s.Nil(err)
+	confirm := <-send.TxToConfirmChannel(txID)
+	s.Nil(confirm.Err)
+
+	s.Greater(nonceMetrics.NonceGapCounter.Snapshot().Count(), gapsBefore)
+	s.Equal(int64(confirm.CurrentTx.Nonce()+1), nonceMetrics.TrackedNonceGauge.Snapshot().Value())
+}
+
 func (s *SenderTestSuite) TestAdjustGas() {
 	send := s.sender
 	dynamicTx := &types.DynamicFeeTx{}
@@ -0,0 +1,189 @@
+package sender
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	// The timeout of a single signing request to the remote signer.
+	remoteSignerTimeout   = 30 * time.Second
+	errRemoteSignerTxDiff = errors.New("remote signer signed a different transaction")
+)
+
+// Signer signs the transactions of a sender, it can either hold the private key in process, or delegate
+// the signing to an external signer, so the key never leaves it.
+type Signer interface {
+	// Address returns the address of the signing account.
+	Address() common.Address
+	// SignTx signs the given transaction.
+	SignTx(tx *types.Transaction) (*types.Transaction, error)
+}
+
+// LocalSigner signs the transactions with a private key held in process.
+type LocalSigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+	signer  types.Signer
+}
+
+// NewLocalSigner creates a new LocalSigner with the given private key, for the given chain.
+func NewLocalSigner(key *ecdsa.PrivateKey, chainID *big.Int) *LocalSigner {
+	return &LocalSigner{
+		key:     key,
+		address: crypto.PubkeyToAddress(key.PublicKey),
+		signer:  types.LatestSignerForChainID(chainID),
+	}
+}
+
+// Address implements the Signer interface.
+func (s *LocalSigner) Address() common.Address {
+	return s.address
+}
+
+// SignTx implements the Signer interface.
+func (s *LocalSigner) SignTx(tx *types.Transaction) (*types.Transaction, error) {
+	return types.SignTx(tx, s.signer, s.key)
+}
+
+// RemoteSigner delegates the signing to an external signer, e.g. a remote signing service or a KMS proxy,
+// through the `eth_signTransaction` JSON-RPC method.
+type RemoteSigner struct {
+	client  *rpc.Client
+	address common.Address
+	chainID *big.Int
+	signer  types.Signer
+}
+
+// NewRemoteSigner creates a new RemoteSigner connected to the given endpoint, signing for the given account
+// on the given chain.
+func NewRemoteSigner(
+	ctx context.Context,
+	endpoint string,
+	address common.Address,
+	chainID *big.Int,
+) (*RemoteSigner, error) {
+	client, err := rpc.DialContext(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to remote signer %s: %w", endpoint, err)
+	}
+
+	return &RemoteSigner{
+		client:  client,
+		address: address,
+		chainID: chainID,
+		signer:  types.LatestSignerForChainID(chainID),
+	}, nil
+}
+
+// Address implements the Signer interface.
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+// signTxArgs represents the arguments of the `eth_signTransaction` JSON-RPC method.
+type signTxArgs struct {
+	From                 common.Address   `json:"from"`
+	To                   *common.Address  `json:"to"`
+	Gas                  hexutil.Uint64   `json:"gas"`
+	MaxFeePerGas         *hexutil.Big     `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big     `json:"maxPriorityFeePerGas"`
+	Value                *hexutil.Big     `json:"value"`
+	Nonce                hexutil.Uint64   `json:"nonce"`
+	Input                hexutil.Bytes    `json:"input"`
+	ChainID              *hexutil.Big     `json:"chainId"`
+	AccessList           types.AccessList `json:"accessList"`
+	MaxFeePerBlobGas     *hexutil.Big     `json:"maxFeePerBlobGas,omitempty"`
+	BlobVersionedHashes  []common.Hash    `json:"blobVersionedHashes,omitempty"`
+}
+
+// SignTx implements the Signer interface, the returned transaction is checked to be the given transaction
+// signed by the expected account, and the blob sidecar is reattached to it, since it's never signed.
+func (s *RemoteSigner) SignTx(tx *types.Transaction) (*types.Transaction, error) {
+	args := &signTxArgs{
+		From:                 s.address,
+		To:                   tx.To(),
+		Gas:                  hexutil.Uint64(tx.Gas()),
+		MaxFeePerGas:         (*hexutil.Big)(tx.GasFeeCap()),
+		MaxPriorityFeePerGas: (*hexutil.Big)(tx.GasTipCap()),
+		Value:                (*hexutil.Big)(tx.Value()),
+		Nonce:                hexutil.Uint64(tx.Nonce()),
+		Input:                tx.Data(),
+		ChainID:              (*hexutil.Big)(s.chainID),
+		AccessList:           tx.AccessList(),
+	}
+	if tx.Type() == types.BlobTxType {
+		args.MaxFeePerBlobGas = (*hexutil.Big)(tx.BlobGasFeeCap())
+		args.BlobVersionedHashes = tx.BlobHashes()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	var result json.RawMessage
+	if err := s.client.CallContext(ctx, &result, "eth_signTransaction", args); err != nil {
+		return nil, fmt.Errorf("failed to sign transaction with remote signer: %w", err)
+	}
+	raw, err := decodeSignTxResult(result)
+	if err != nil {
+		return nil, err
+	}
+
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode the remotely signed transaction: %w", err)
+	}
+	if s.signer.Hash(signed) != s.signer.Hash(tx) {
+		return nil, fmt.Errorf("%w: %s", errRemoteSignerTxDiff, signed.Hash())
+	}
+	from, err := types.Sender(s.signer, signed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover the remotely signed transaction's sender: %w", err)
+	}
+	if from != s.address {
+		return nil, fmt.Errorf("remote signer signed with %s, expected %s", from, s.address)
+	}
+	if tx.BlobTxSidecar() == nil {
+		return signed, nil
+	}
+
+	// Reattach the signature to the given blob transaction, which carries the sidecar.
+	var (
+		v, r, sv = signed.RawSignatureValues()
+		sig      = make([]byte, crypto.SignatureLength)
+	)
+	r.FillBytes(sig[:32])
+	sv.FillBytes(sig[32:64])
+	sig[crypto.RecoveryIDOffset] = byte(v.Uint64())
+
+	return tx.WithSignature(s.signer, sig)
+}
+
+// decodeSignTxResult decodes the raw signed transaction from the result of `eth_signTransaction`, which is
+// either the raw transaction itself, or an object containing it as geth returns.
+func decodeSignTxResult(result json.RawMessage) (hexutil.Bytes, error) {
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err == nil {
+		return raw, nil
+	}
+
+	var signed struct {
+		Raw hexutil.Bytes `json:"raw"`
+	}
+	if err := json.Unmarshal(result, &signed); err != nil || len(signed.Raw) == 0 {
+		return nil, fmt.Errorf("invalid remote signer result: %s", result)
+	}
+
+	return signed.Raw, nil
+}
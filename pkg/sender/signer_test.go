@@ -0,0 +1,188 @@
+package sender
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+// signerService is a fake external signer serving `eth_signTransaction`.
+type signerService struct {
+	key *ecdsa.PrivateKey
+	// Whether to return the result as an object, as geth does
+	object bool
+	// Whether to sign a different transaction
+	tamper bool
+}
+
+func (s *signerService) SignTransaction(args signTxArgs) (interface{}, error) {
+	nonce := uint64(args.Nonce)
+	if s.tamper {
+		nonce++
+	}
+
+	var txData types.TxData = &types.DynamicFeeTx{
+		ChainID:    args.ChainID.ToInt(),
+		Nonce:      nonce,
+		GasTipCap:  args.MaxPriorityFeePerGas.ToInt(),
+		GasFeeCap:  args.MaxFeePerGas.ToInt(),
+		Gas:        uint64(args.Gas),
+		To:         args.To,
+		Value:      args.Value.ToInt(),
+		Data:       args.Input,
+		AccessList: args.AccessList,
+	}
+	if len(args.BlobVersionedHashes) != 0 {
+		txData = &types.BlobTx{
+			ChainID:    uint256.MustFromBig(args.ChainID.ToInt()),
+			Nonce:      nonce,
+			GasTipCap:  uint256.MustFromBig(args.MaxPriorityFeePerGas.ToInt()),
+			GasFeeCap:  uint256.MustFromBig(args.MaxFeePerGas.ToInt()),
+			Gas:        uint64(args.Gas),
+			To:         *args.To,
+			Value:      uint256.MustFromBig(args.Value.ToInt()),
+			Data:       args.Input,
+			AccessList: args.AccessList,
+			BlobFeeCap: uint256.MustFromBig(args.MaxFeePerBlobGas.ToInt()),
+			BlobHashes: args.BlobVersionedHashes,
+		}
+	}
+
+	signed, err := types.SignNewTx(s.key, types.LatestSignerForChainID(args.ChainID.ToInt()), txData)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signed.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if s.object {
+		return map[string]interface{}{"raw": hexutil.Bytes(raw), "tx": signed}, nil
+	}
+	return hexutil.Bytes(raw), nil
+}
+
+func newTestRemoteSigner(t *testing.T, service *signerService, address common.Address) *RemoteSigner {
+	server := rpc.NewServer()
+	require.Nil(t, server.RegisterName("eth", service))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	signer, err := NewRemoteSigner(context.Background(), httpServer.URL, address, common.Big1)
+	require.Nil(t, err)
+	return signer
+}
+
+func newTestDynamicFeeTx() *types.Transaction {
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   common.Big1,
+		Nonce:     7,
+		GasTipCap: common.Big1,
+		GasFeeCap: common.Big2,
+		Gas:       21_000,
+		To:        &common.Address{1},
+		Value:     common.Big1,
+		Data:      []byte{1, 2, 3},
+	})
+}
+
+func TestLocalSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	signer := NewLocalSigner(key, common.Big1)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), signer.Address())
+
+	signed, err := signer.SignTx(newTestDynamicFeeTx())
+	require.Nil(t, err)
+	from, err := types.Sender(types.LatestSignerForChainID(common.Big1), signed)
+	require.Nil(t, err)
+	require.Equal(t, signer.Address(), from)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	for _, object := range []bool{false, true} {
+		signer := newTestRemoteSigner(t, &signerService{key: key, object: object}, address)
+		require.Equal(t, address, signer.Address())
+
+		tx := newTestDynamicFeeTx()
+		signed, err := signer.SignTx(tx)
+		require.Nil(t, err)
+		require.Equal(t, tx.Nonce(), signed.Nonce())
+		require.Equal(t, tx.Data(), signed.Data())
+		from, err := types.Sender(types.LatestSignerForChainID(common.Big1), signed)
+		require.Nil(t, err)
+		require.Equal(t, address, from)
+	}
+}
+
+func TestRemoteSignerBlobTx(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	signer := newTestRemoteSigner(t, &signerService{key: key}, address)
+
+	var blob kzg4844.Blob
+	commitment, err := kzg4844.BlobToCommitment(blob)
+	require.Nil(t, err)
+	proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+	require.Nil(t, err)
+	sidecar := &types.BlobTxSidecar{
+		Blobs:       []kzg4844.Blob{blob},
+		Commitments: []kzg4844.Commitment{commitment},
+		Proofs:      []kzg4844.Proof{proof},
+	}
+
+	tx := types.NewTx(&types.BlobTx{
+		ChainID:    uint256.NewInt(1),
+		Nonce:      3,
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(2),
+		Gas:        21_000,
+		To:         common.Address{1},
+		Value:      uint256.NewInt(0),
+		BlobFeeCap: uint256.NewInt(1),
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	})
+
+	// The sidecar is reattached to the remotely signed transaction.
+	signed, err := signer.SignTx(tx)
+	require.Nil(t, err)
+	require.Equal(t, sidecar, signed.BlobTxSidecar())
+	from, err := types.Sender(types.LatestSignerForChainID(common.Big1), signed)
+	require.Nil(t, err)
+	require.Equal(t, address, from)
+}
+
+func TestRemoteSignerErrors(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	// A different transaction is signed.
+	_, err = newTestRemoteSigner(t, &signerService{key: key, tamper: true}, address).SignTx(newTestDynamicFeeTx())
+	require.ErrorIs(t, err, errRemoteSignerTxDiff)
+
+	// Signed by another account.
+	_, err = newTestRemoteSigner(t, &signerService{key: key}, common.Address{1}).SignTx(newTestDynamicFeeTx())
+	require.ErrorContains(t, err, "remote signer signed with")
+
+	_, err = decodeSignTxResult(json.RawMessage(`{"tx":{}}`))
+	require.ErrorContains(t, err, "invalid remote signer result")
+}
@@ -1,11 +1,14 @@
 package txlistvalidator
 
 import (
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
 )
 
 // TxListValidator is responsible for validating the transactions list in a TaikoL1.proposeBlock transaction.
@@ -13,6 +16,11 @@ type TxListValidator struct {
 	blockMaxGasLimit  uint64
 	maxBytesPerTxList uint64
 	chainID           *big.Int
+	// Limits of a transactions list decoded from a blob, 0 means no limit
+	maxBlobTxListBytes uint64
+	maxBlobTxs         uint64
+	// Number of the decoded transactions sampled to check their chain ID, 0 means no check
+	chainIDCheckSamples uint64
 }
 
 // NewTxListValidator creates a new TxListValidator instance based on giving configurations.
@@ -28,6 +36,19 @@ func NewTxListValidator(
 	}
 }
 
+// SetBlobDecodeLimits sets the limits of the decoded transactions list size and transactions count of
+// a blob, blobs exceeding the limits will be treated as invalid, 0 means no limit.
+func (v *TxListValidator) SetBlobDecodeLimits(maxTxListBytes uint64, maxTxs uint64) {
+	v.maxBlobTxListBytes = maxTxListBytes
+	v.maxBlobTxs = maxTxs
+}
+
+// SetChainIDCheckSamples sets the number of the decoded transactions, evenly sampled from each transactions
+// list, whose chain ID will be checked against the L2 chain ID, 0 means no check.
+func (v *TxListValidator) SetChainIDCheckSamples(samples uint64) {
+	v.chainIDCheckSamples = samples
+}
+
 // ValidateTxList checks whether the transactions list in the TaikoL1.proposeBlock transaction's
 // input data is valid.
 func (v *TxListValidator) ValidateTxList(
@@ -45,12 +66,133 @@ func (v *TxListValidator) ValidateTxList(
 		return false
 	}
 
+	if !blobUsed && isTruncated(blockID, txListBytes) {
+		return false
+	}
+
+	if blobUsed && !v.withinBlobDecodeLimits(blockID, txListBytes) {
+		return false
+	}
+
 	var txs types.Transactions
 	if err := rlp.DecodeBytes(txListBytes, &txs); err != nil {
 		log.Info("Failed to decode transactions list bytes", "blockID", blockID, "error", err)
 		return false
 	}
 
+	// A chain ID mismatch is only reported, since the protocol doesn't invalidate such a transactions list.
+	if !v.chainIDsMatch(blockID, txs) {
+		metrics.DriverTxListChainIDMismatchCounter.Inc(1)
+	}
+
 	log.Info("Transaction list is valid", "blockID", blockID)
 	return true
 }
+
+// isTruncated checks whether the given calldata transactions list is shorter than the length declared in
+// its RLP list header, which could happen when the proposing transaction ran low on gas.
+func isTruncated(blockID *big.Int, txListBytes []byte) bool {
+	declared, ok := declaredListLength(txListBytes)
+	if ok && declared <= uint64(len(txListBytes)) {
+		return false
+	}
+
+	log.Info(
+		"Transactions list binary truncated",
+		"declared", declared,
+		"available", len(txListBytes),
+		"blockID", blockID,
+	)
+	return true
+}
+
+// declaredListLength returns the total length, including the header, declared by the RLP list header of
+// the given bytes, ok is false if the header itself is incomplete. Non-list values are treated as not
+// truncated, they will be rejected when decoding.
+func declaredListLength(b []byte) (length uint64, ok bool) {
+	if len(b) == 0 || b[0] < 0xC0 {
+		return uint64(len(b)), true
+	}
+	if b[0] < 0xF8 {
+		return 1 + uint64(b[0]-0xC0), true
+	}
+
+	sizeLen := int(b[0] - 0xF7)
+	if len(b) < 1+sizeLen {
+		return 1 + uint64(sizeLen), false
+	}
+
+	var size uint64
+	for _, c := range b[1 : 1+sizeLen] {
+		size = size<<8 | uint64(c)
+	}
+	// No transactions list can be that large, treat it as truncated.
+	if size > math.MaxUint64-uint64(1+sizeLen) {
+		return math.MaxUint64, true
+	}
+
+	return 1 + uint64(sizeLen) + size, true
+}
+
+// withinBlobDecodeLimits checks whether the given transactions list decoded from a blob is within the
+// configured limits, the transactions are counted before being fully decoded, so a malicious blob
+// can not exhaust the memory.
+func (v *TxListValidator) withinBlobDecodeLimits(blockID *big.Int, txListBytes []byte) bool {
+	if v.maxBlobTxListBytes != 0 && uint64(len(txListBytes)) > v.maxBlobTxListBytes {
+		log.Info("Blob transactions list binary too large", "length", len(txListBytes), "blockID", blockID)
+		return false
+	}
+
+	if v.maxBlobTxs == 0 {
+		return true
+	}
+
+	content, _, err := rlp.SplitList(txListBytes)
+	if err != nil {
+		log.Info("Failed to split blob transactions list bytes", "blockID", blockID, "error", err)
+		return false
+	}
+	count, err := rlp.CountValues(content)
+	if err != nil {
+		log.Info("Failed to count blob transactions", "blockID", blockID, "error", err)
+		return false
+	}
+	if uint64(count) > v.maxBlobTxs {
+		log.Info("Too many transactions in blob transactions list", "count", count, "blockID", blockID)
+		return false
+	}
+
+	return true
+}
+
+// chainIDsMatch checks whether the sampled transactions in the given list carry the L2 chain ID, a mismatch
+// indicates a likely decoding error or transactions from another chain. Transactions without replay protection
+// are not checked.
+func (v *TxListValidator) chainIDsMatch(blockID *big.Int, txs types.Transactions) bool {
+	if v.chainIDCheckSamples == 0 || len(txs) == 0 {
+		return true
+	}
+
+	stride := 1
+	if uint64(len(txs)) > v.chainIDCheckSamples {
+		stride = len(txs) / int(v.chainIDCheckSamples)
+	}
+
+	for i := 0; i < len(txs); i += stride {
+		if !txs[i].Protected() || txs[i].ChainId().Cmp(v.chainID) == 0 {
+			continue
+		}
+
+		log.Warn(
+			"Transaction with unexpected chain ID in transactions list, likely a decoding error or wrong chain data",
+			"blockID", blockID,
+			"index", i,
+			"hash", txs[i].Hash(),
+			"chainID", txs[i].ChainId(),
+			"expected", v.chainID,
+		)
+		return false
+	}
+
+	return true
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
 )
 
 var (
@@ -76,6 +78,69 @@ func TestIsTxListValid(t *testing.T) {
 	}
 }
 
+func TestBlobDecodeLimits(t *testing.T) {
+	v := NewTxListValidator(maxBlocksGasLimit, maxTxlistBytes, chainID)
+
+	// Decode the transactions list from a blob.
+	var blob rpc.Blob
+	require.Nil(t, blob.FromData(rlpEncodedTransactionBytes(10, true)))
+	txListBytes, err := blob.ToData()
+	require.Nil(t, err)
+
+	// No limits by default.
+	require.True(t, v.ValidateTxList(chainID, txListBytes, true))
+
+	// Within the limits.
+	v.SetBlobDecodeLimits(uint64(len(txListBytes)), 10)
+	require.True(t, v.ValidateTxList(chainID, txListBytes, true))
+
+	// Too many transactions.
+	v.SetBlobDecodeLimits(0, 9)
+	require.False(t, v.ValidateTxList(chainID, txListBytes, true))
+
+	// Decoded transactions list too large.
+	v.SetBlobDecodeLimits(uint64(len(txListBytes))-1, 0)
+	require.False(t, v.ValidateTxList(chainID, txListBytes, true))
+
+	// The limits only apply to blobs.
+	require.True(t, v.ValidateTxList(chainID, txListBytes, false))
+}
+
+func TestChainIDCheck(t *testing.T) {
+	v := NewTxListValidator(maxBlocksGasLimit, maxTxlistBytes, chainID)
+
+	// Transactions signed for another chain.
+	var txs types.Transactions
+	for i := 0; i < 10; i++ {
+		tx := types.MustSignNewTx(testKey, types.LatestSignerForChainID(common.Big1), &types.DynamicFeeTx{
+			ChainID:   common.Big1,
+			Nonce:     uint64(i),
+			To:        &testAddr,
+			GasTipCap: common.Big1,
+			GasFeeCap: common.Big256,
+			Gas:       21000,
+		})
+		txs = append(txs, tx)
+	}
+	wrongChainTxListBytes, err := rlp.EncodeToBytes(txs)
+	require.Nil(t, err)
+
+	// No check by default.
+	require.True(t, v.chainIDsMatch(chainID, txs))
+
+	v.SetChainIDCheckSamples(3)
+	require.False(t, v.chainIDsMatch(chainID, txs))
+
+	// The mismatch is only reported, the transactions list is still valid.
+	require.True(t, v.ValidateTxList(chainID, wrongChainTxListBytes, false))
+	require.True(t, v.ValidateTxList(chainID, wrongChainTxListBytes, true))
+
+	// Transactions signed for the L2 chain.
+	var l2Txs types.Transactions
+	require.Nil(t, rlp.DecodeBytes(rlpEncodedTransactionBytes(10, true), &l2Txs))
+	require.True(t, v.chainIDsMatch(chainID, l2Txs))
+}
+
 func rlpEncodedTransactionBytes(l int, signed bool) []byte {
 	txs := make(types.Transactions, 0)
 	for i := 0; i < l; i++ {
@@ -109,3 +174,28 @@ func randBytes(l uint64) []byte {
 	}
 	return b
 }
+
+func TestTruncatedTxList(t *testing.T) {
+	v := NewTxListValidator(maxBlocksGasLimit, maxTxlistBytes, chainID)
+
+	for _, l := range []int{1, 10} {
+		txListBytes := rlpEncodedTransactionBytes(l, true)
+		require.True(t, v.ValidateTxList(chainID, txListBytes, false))
+		require.False(t, isTruncated(chainID, txListBytes))
+
+		// Truncated in the middle of the list body.
+		truncated := txListBytes[:len(txListBytes)-1]
+		require.True(t, isTruncated(chainID, truncated))
+		require.False(t, v.ValidateTxList(chainID, truncated, false))
+
+		// Truncated in the middle of the list header.
+		require.True(t, isTruncated(chainID, txListBytes[:1]))
+		require.False(t, v.ValidateTxList(chainID, txListBytes[:1], false))
+	}
+
+	// Lists declaring an impossible length.
+	require.True(t, isTruncated(chainID, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}))
+
+	// Non-list values are rejected when decoding instead.
+	require.False(t, isTruncated(chainID, []byte{0x01}))
+}
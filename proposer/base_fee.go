@@ -0,0 +1,38 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var errL1BaseFeeTooHigh = errors.New("L1 base fee too high")
+
+// checkL1BaseFee fetches the current L1 base fee, updates the soft-pause state by it, and returns
+// errL1BaseFeeTooHigh if it exceeds the configured threshold, so the proposer defers proposing until
+// the base fee subsides.
+func (p *Proposer) checkL1BaseFee(ctx context.Context) error {
+	head, err := p.rpc.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get L1 head: %w", err)
+	}
+
+	p.updateSoftPause(head.BaseFee)
+
+	return checkBaseFee(head.BaseFee, p.MaxL1BaseFee)
+}
+
+// checkBaseFee checks whether the given base fee exceeds the given threshold, a nil threshold
+// means no limit.
+func checkBaseFee(baseFee *big.Int, threshold *big.Int) error {
+	if threshold == nil || baseFee == nil || baseFee.Cmp(threshold) <= 0 {
+		return nil
+	}
+
+	log.Warn("L1 base fee too high, deferring proposing", "baseFee", baseFee, "threshold", threshold)
+
+	return fmt.Errorf("%w: base fee %s, threshold %s", errL1BaseFeeTooHigh, baseFee, threshold)
+}
@@ -0,0 +1,24 @@
+package proposer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckBaseFee(t *testing.T) {
+	threshold := big.NewInt(100_000_000_000)
+
+	require.Nil(t, checkBaseFee(big.NewInt(30_000_000_000), threshold))
+	require.Nil(t, checkBaseFee(threshold, threshold))
+	require.Nil(t, checkBaseFee(big.NewInt(500_000_000_000), nil))
+
+	// The proposer should defer proposing during a base fee spike.
+	err := checkBaseFee(big.NewInt(500_000_000_000), threshold)
+	require.ErrorIs(t, err, errL1BaseFeeTooHigh)
+
+	reason, skipped := skipReasonOf(err)
+	require.True(t, skipped)
+	require.Equal(t, SkipReasonHighL1BaseFee, reason)
+}
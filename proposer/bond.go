@@ -0,0 +1,58 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+var errInsufficientProposerBond = errors.New("insufficient proposer bond")
+
+// checkProposerBond reads the given proposer's current Taiko token balance and its allowance to the
+// assignment hook, which pulls the liveness bond when a block is proposed, and returns
+// errInsufficientProposerBond if either of them is below the protocol's liveness bond, so the
+// proposer won't send a transaction which will be reverted.
+func (p *Proposer) checkProposerBond(ctx context.Context, proposer common.Address) error {
+	opts := &bind.CallOpts{Context: ctx}
+
+	balance, err := p.rpc.TaikoToken.BalanceOf(opts, proposer)
+	if err != nil {
+		return fmt.Errorf("failed to get proposer's Taiko token balance: %w", encoding.TryParsingCustomError(err))
+	}
+
+	allowance, err := p.rpc.TaikoToken.Allowance(opts, proposer, p.AssignmentHookAddress)
+	if err != nil {
+		return fmt.Errorf("failed to get proposer's Taiko token allowance: %w", encoding.TryParsingCustomError(err))
+	}
+
+	return checkBond(balance, allowance, p.protocolConfigs.LivenessBond)
+}
+
+// checkBond checks whether the given balance and allowance both cover the required bond.
+func checkBond(balance *big.Int, allowance *big.Int, required *big.Int) error {
+	if balance.Cmp(required) >= 0 && allowance.Cmp(required) >= 0 {
+		return nil
+	}
+
+	log.Warn(
+		"Proposer does not have enough bond, refusing to propose",
+		"balance", balance,
+		"allowance", allowance,
+		"required", required,
+	)
+
+	return fmt.Errorf(
+		"%w: balance %s, allowance %s, required %s",
+		errInsufficientProposerBond,
+		balance,
+		allowance,
+		required,
+	)
+}
@@ -0,0 +1,30 @@
+package proposer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckBond(t *testing.T) {
+	required := big.NewInt(100)
+
+	require.Nil(t, checkBond(big.NewInt(100), big.NewInt(100), required))
+	require.Nil(t, checkBond(big.NewInt(200), big.NewInt(150), required))
+
+	// An under-bonded proposer should skip the proposing epoch.
+	for _, c := range []struct{ balance, allowance *big.Int }{
+		{big.NewInt(99), big.NewInt(100)},
+		{big.NewInt(100), big.NewInt(99)},
+		{common.Big0, common.Big0},
+	} {
+		err := checkBond(c.balance, c.allowance, required)
+		require.ErrorIs(t, err, errInsufficientProposerBond)
+
+		reason, skipped := skipReasonOf(err)
+		require.True(t, skipped)
+		require.Equal(t, SkipReasonInsufficientBond, reason)
+	}
+}
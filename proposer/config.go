@@ -2,6 +2,7 @@ package proposer
 
 import (
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
 	"net/url"
@@ -12,8 +13,10 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/urfave/cli/v2"
 
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/cmd/flags"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	builder "github.com/taikoxyz/taiko-client/proposer/transaction_builder"
 )
 
 // Config contains all configurations to initialize a Taiko proposer.
@@ -21,6 +24,9 @@ type Config struct {
 	*rpc.ClientConfig
 	AssignmentHookAddress               common.Address
 	L1ProposerPrivKey                   *ecdsa.PrivateKey
+	L1ProposerSigner                    string
+	L1ProposerSignerAddress             common.Address
+	L1ProposerPrivKeys                  []*ecdsa.PrivateKey
 	L2SuggestedFeeRecipient             common.Address
 	ExtraData                           string
 	ProposeInterval                     time.Duration
@@ -28,28 +34,87 @@ type Config struct {
 	LocalAddressesOnly                  bool
 	ProposeEmptyBlocksInterval          time.Duration
 	MaxProposedTxListsPerEpoch          uint64
+	MinTxListBytes                      uint64
+	MinTxListMaxWait                    time.Duration
+	AnchorGasLimit                      uint64
 	ProposeBlockTxGasLimit              uint64
 	ProposeBlockTxReplacementMultiplier uint64
 	WaitReceiptTimeout                  time.Duration
+	ReceiptPollInterval                 time.Duration
 	ProposeBlockTxGasTipCap             *big.Int
 	ProverEndpoints                     []*url.URL
+	ProverEndpointFailureThreshold      uint64
+	ProverEndpointCooldown              time.Duration
 	OptimisticTierFee                   *big.Int
 	SgxTierFee                          *big.Int
 	TierFeePriceBump                    *big.Int
 	MaxTierFeePriceBumps                uint64
+	AdaptiveTierFee                     bool
+	TierFeeFloors                       map[uint16]*big.Int
 	IncludeParentMetaHash               bool
 	BlobAllowed                         bool
+	ProposeMode                         builder.ProposeMode
+	CheckBlobAvailability               bool
 	L1BlockBuilderTip                   *big.Int
+	CheckProposerBond                   bool
+	TxListsAssemblyDeadline             time.Duration
+	MaxL1BaseFee                        *big.Int
+	SoftPauseBaseFee                    *big.Int
+	SoftPauseMaxInterval                time.Duration
+	ForcePropose                        bool
+	MinProposalGap                      time.Duration
+	ProtocolPausedPollInterval          time.Duration
+	NonceStoreFile                      string
+	StuckTxTimeout                      time.Duration
+	StuckTxGasBumpRate                  uint64
+	GasLimitMargin                      uint64
+	GasLimitMarginCap                   uint64
+	MaxTxsPerSecond                     uint64
 }
 
 // NewConfigFromCliContext initializes a Config instance from
 // command line flags.
 func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
-	l1ProposerPrivKey, err := crypto.ToECDSA(
-		common.Hex2Bytes(c.String(flags.L1ProposerPrivKey.Name)),
+	var (
+		l1ProposerPrivKey       *ecdsa.PrivateKey
+		l1ProposerSignerAddress common.Address
+		proposerAddress         common.Address
+		err                     error
 	)
-	if err != nil {
-		return nil, fmt.Errorf("invalid L1 proposer private key: %w", err)
+	if c.IsSet(flags.L1ProposerSigner.Name) {
+		if c.IsSet(flags.L1ProposerPrivKey.Name) {
+			return nil, errors.New("L1 proposer private key and signer can't be both set")
+		}
+		address := c.String(flags.L1ProposerSignerAddress.Name)
+		if !common.IsHexAddress(address) {
+			return nil, fmt.Errorf("invalid L1 proposer signer address: %s", address)
+		}
+		l1ProposerSignerAddress = common.HexToAddress(address)
+		proposerAddress = l1ProposerSignerAddress
+	} else {
+		if l1ProposerPrivKey, err = crypto.ToECDSA(
+			common.Hex2Bytes(c.String(flags.L1ProposerPrivKey.Name)),
+		); err != nil {
+			return nil, fmt.Errorf("invalid L1 proposer private key: %w", err)
+		}
+		proposerAddress = crypto.PubkeyToAddress(l1ProposerPrivKey.PublicKey)
+	}
+
+	var l1ProposerPrivKeys []*ecdsa.PrivateKey
+	if c.IsSet(flags.L1ProposerPrivKeys.Name) {
+		addresses := map[common.Address]bool{proposerAddress: true}
+		for i, k := range strings.Split(c.String(flags.L1ProposerPrivKeys.Name), ",") {
+			key, err := crypto.ToECDSA(common.FromHex(strings.TrimSpace(k)))
+			if err != nil {
+				return nil, fmt.Errorf("invalid L1 proposer private key #%d: %w", i, err)
+			}
+			address := crypto.PubkeyToAddress(key.PublicKey)
+			if addresses[address] {
+				return nil, fmt.Errorf("duplicated L1 proposer private key #%d: %s", i, address)
+			}
+			addresses[address] = true
+			l1ProposerPrivKeys = append(l1ProposerPrivKeys, key)
+		}
 	}
 
 	l2SuggestedFeeRecipient := c.String(flags.L2SuggestedFeeRecipient.Name)
@@ -75,6 +140,15 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		)
 	}
 
+	// A zero max wait would propose the small transactions lists right away, disabling the minimum size.
+	if c.Uint64(flags.MinTxListBytes.Name) != 0 && c.Duration(flags.MinTxListMaxWait.Name) == 0 {
+		return nil, fmt.Errorf(
+			"invalid --%s value: 0, must be positive when --%s is set",
+			flags.MinTxListMaxWait.Name,
+			flags.MinTxListBytes.Name,
+		)
+	}
+
 	var proposeBlockTxGasTipCap *big.Int
 	if c.IsSet(flags.ProposeBlockTxGasTipCap.Name) {
 		proposeBlockTxGasTipCap = new(big.Int).SetUint64(c.Uint64(flags.ProposeBlockTxGasTipCap.Name))
@@ -89,17 +163,60 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		proverEndpoints = append(proverEndpoints, endpoint)
 	}
 
+	proposeMode := builder.ProposeMode(c.String(flags.ProposeMode.Name))
+	switch proposeMode {
+	case "":
+		proposeMode = builder.ProposeModeCalldata
+		if c.Bool(flags.BlobAllowed.Name) {
+			proposeMode = builder.ProposeModeBlob
+		}
+	case builder.ProposeModeCalldata, builder.ProposeModeBlob, builder.ProposeModeEconomic:
+	default:
+		return nil, fmt.Errorf("invalid --%s value: %s", flags.ProposeMode.Name, proposeMode)
+	}
+
+	var maxL1BaseFee *big.Int
+	if c.IsSet(flags.MaxL1BaseFee.Name) {
+		maxL1BaseFee = new(big.Int).SetUint64(c.Uint64(flags.MaxL1BaseFee.Name))
+	}
+
+	tierFeeFloors := make(map[uint16]*big.Int)
+	for tier, flag := range map[uint16]*cli.Uint64Flag{
+		encoding.TierOptimisticID: flags.OptimisticTierFeeFloor,
+		encoding.TierSgxID:        flags.SgxTierFeeFloor,
+	} {
+		if c.Uint64(flag.Name) != 0 {
+			tierFeeFloors[tier] = new(big.Int).SetUint64(c.Uint64(flag.Name))
+		}
+	}
+
+	var softPauseBaseFee *big.Int
+	if c.IsSet(flags.SoftPauseBaseFee.Name) {
+		softPauseBaseFee = new(big.Int).SetUint64(c.Uint64(flags.SoftPauseBaseFee.Name))
+	}
+
 	return &Config{
 		ClientConfig: &rpc.ClientConfig{
-			L1Endpoint:        c.String(flags.L1WSEndpoint.Name),
-			L2Endpoint:        c.String(flags.L2HTTPEndpoint.Name),
-			TaikoL1Address:    common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
-			TaikoL2Address:    common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
-			TaikoTokenAddress: common.HexToAddress(c.String(flags.TaikoTokenAddress.Name)),
-			Timeout:           c.Duration(flags.RPCTimeout.Name),
+			L1Endpoint:              c.String(flags.L1WSEndpoint.Name),
+			L1FailoverEndpoints:     c.StringSlice(flags.L1FailoverWSEndpoints.Name),
+			L1FailoverMaxHeadLag:    c.Uint64(flags.L1FailoverMaxHeadLag.Name),
+			L1FailoverCheckInterval: c.Duration(flags.L1FailoverCheckInterval.Name),
+			L2Endpoint:              c.String(flags.L2HTTPEndpoint.Name),
+			TaikoL1Address:          common.HexToAddress(c.String(flags.TaikoL1Address.Name)),
+			TaikoL2Address:          common.HexToAddress(c.String(flags.TaikoL2Address.Name)),
+			TaikoTokenAddress:       common.HexToAddress(c.String(flags.TaikoTokenAddress.Name)),
+			Timeout:                 c.Duration(flags.RPCTimeout.Name),
+			HealthCheck: rpc.HealthCheckConfig{
+				MaxL1HeadAge:          c.Duration(flags.HealthMaxL1HeadAge.Name),
+				MaxL2BlockLag:         c.Uint64(flags.HealthMaxL2BlockLag.Name),
+				MaxBeaconSyncDistance: c.Uint64(flags.HealthMaxBeaconSyncDistance.Name),
+			},
 		},
 		AssignmentHookAddress:               common.HexToAddress(c.String(flags.ProposerAssignmentHookAddress.Name)),
 		L1ProposerPrivKey:                   l1ProposerPrivKey,
+		L1ProposerSigner:                    c.String(flags.L1ProposerSigner.Name),
+		L1ProposerSignerAddress:             l1ProposerSignerAddress,
+		L1ProposerPrivKeys:                  l1ProposerPrivKeys,
 		L2SuggestedFeeRecipient:             common.HexToAddress(l2SuggestedFeeRecipient),
 		ExtraData:                           c.String(flags.ExtraData.Name),
 		ProposeInterval:                     c.Duration(flags.ProposeInterval.Name),
@@ -107,17 +224,41 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		LocalAddressesOnly:                  c.Bool(flags.TxPoolLocalsOnly.Name),
 		ProposeEmptyBlocksInterval:          c.Duration(flags.ProposeEmptyBlocksInterval.Name),
 		MaxProposedTxListsPerEpoch:          c.Uint64(flags.MaxProposedTxListsPerEpoch.Name),
+		MinTxListBytes:                      c.Uint64(flags.MinTxListBytes.Name),
+		MinTxListMaxWait:                    c.Duration(flags.MinTxListMaxWait.Name),
+		AnchorGasLimit:                      c.Uint64(flags.AnchorGasLimit.Name),
 		ProposeBlockTxGasLimit:              c.Uint64(flags.ProposeBlockTxGasLimit.Name),
 		ProposeBlockTxReplacementMultiplier: proposeBlockTxReplacementMultiplier,
 		WaitReceiptTimeout:                  c.Duration(flags.WaitReceiptTimeout.Name),
+		ReceiptPollInterval:                 c.Duration(flags.ReceiptPollInterval.Name),
 		ProposeBlockTxGasTipCap:             proposeBlockTxGasTipCap,
 		ProverEndpoints:                     proverEndpoints,
+		ProverEndpointFailureThreshold:      c.Uint64(flags.ProverEndpointFailureThreshold.Name),
+		ProverEndpointCooldown:              c.Duration(flags.ProverEndpointCooldown.Name),
 		OptimisticTierFee:                   new(big.Int).SetUint64(c.Uint64(flags.OptimisticTierFee.Name)),
 		SgxTierFee:                          new(big.Int).SetUint64(c.Uint64(flags.SgxTierFee.Name)),
 		TierFeePriceBump:                    new(big.Int).SetUint64(c.Uint64(flags.TierFeePriceBump.Name)),
 		MaxTierFeePriceBumps:                c.Uint64(flags.MaxTierFeePriceBumps.Name),
+		AdaptiveTierFee:                     c.Bool(flags.AdaptiveTierFee.Name),
+		TierFeeFloors:                       tierFeeFloors,
 		IncludeParentMetaHash:               c.Bool(flags.ProposeBlockIncludeParentMetaHash.Name),
 		BlobAllowed:                         c.Bool(flags.BlobAllowed.Name),
+		ProposeMode:                         proposeMode,
+		CheckBlobAvailability:               c.Bool(flags.CheckBlobAvailability.Name),
 		L1BlockBuilderTip:                   new(big.Int).SetUint64(c.Uint64(flags.L1BlockBuilderTip.Name)),
+		CheckProposerBond:                   c.Bool(flags.CheckProposerBond.Name),
+		TxListsAssemblyDeadline:             c.Duration(flags.TxListsAssemblyDeadline.Name),
+		MaxL1BaseFee:                        maxL1BaseFee,
+		SoftPauseBaseFee:                    softPauseBaseFee,
+		SoftPauseMaxInterval:                c.Duration(flags.SoftPauseMaxInterval.Name),
+		ForcePropose:                        c.Bool(flags.ForcePropose.Name),
+		MinProposalGap:                      c.Duration(flags.MinProposalGap.Name),
+		ProtocolPausedPollInterval:          c.Duration(flags.ProtocolPausedPollInterval.Name),
+		NonceStoreFile:                      c.String(flags.NonceStoreFile.Name),
+		StuckTxTimeout:                      c.Duration(flags.StuckTxTimeout.Name),
+		StuckTxGasBumpRate:                  c.Uint64(flags.StuckTxGasBumpRate.Name),
+		GasLimitMargin:                      c.Uint64(flags.GasLimitMargin.Name),
+		GasLimitMarginCap:                   c.Uint64(flags.GasLimitMarginCap.Name),
+		MaxTxsPerSecond:                     c.Uint64(flags.MaxTxsPerSecond.Name),
 	}, nil
 }
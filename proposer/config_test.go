@@ -3,6 +3,7 @@ package proposer
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"os"
 	"strings"
 	"time"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/cmd/flags"
+	builder "github.com/taikoxyz/taiko-client/proposer/transaction_builder"
 )
 
 var (
@@ -49,11 +51,38 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContext() {
 		s.Equal(uint64(5), c.ProposeBlockTxReplacementMultiplier)
 		s.Equal(5*time.Second, c.Timeout)
 		s.Equal(10*time.Second, c.WaitReceiptTimeout)
+		s.Equal(500*time.Millisecond, c.ReceiptPollInterval)
 		s.Equal(uint64(tierFee), c.OptimisticTierFee.Uint64())
 		s.Equal(uint64(tierFee), c.SgxTierFee.Uint64())
 		s.Equal(uint64(15), c.TierFeePriceBump.Uint64())
 		s.Equal(uint64(5), c.MaxTierFeePriceBumps)
+		s.Equal(uint64(1024), c.MinTxListBytes)
+		s.Equal(2*time.Minute, c.MinTxListMaxWait)
+		s.Equal(uint64(3), c.ProverEndpointFailureThreshold)
+		s.Equal(30*time.Second, c.ProverEndpointCooldown)
+		s.True(c.AdaptiveTierFee)
+		s.Equal(map[uint16]*big.Int{encoding.TierSgxID: big.NewInt(int64(tierFee))}, c.TierFeeFloors)
 		s.Equal(true, c.IncludeParentMetaHash)
+		s.Equal(builder.ProposeModeEconomic, c.ProposeMode)
+		s.True(c.CheckBlobAvailability)
+		s.True(c.CheckProposerBond)
+		s.Equal(3*time.Second, c.TxListsAssemblyDeadline)
+		s.Equal(uint64(100_000_000_000), c.MaxL1BaseFee.Uint64())
+		s.Equal(uint64(50_000_000_000), c.SoftPauseBaseFee.Uint64())
+		s.Equal(5*time.Minute, c.SoftPauseMaxInterval)
+		s.True(c.ForcePropose)
+		s.Equal(30*time.Second, c.MinProposalGap)
+		s.Equal(12*time.Second, c.ProtocolPausedPollInterval)
+		s.Equal("/tmp/nonces.json", c.NonceStoreFile)
+		s.Equal(2*time.Minute, c.StuckTxTimeout)
+		s.Equal(uint64(15), c.StuckTxGasBumpRate)
+		s.Equal(uint64(20), c.GasLimitMargin)
+		s.Equal(uint64(3_000_000), c.GasLimitMarginCap)
+		s.Equal(uint64(5), c.MaxTxsPerSecond)
+		s.Equal(uint64(2), c.L1FailoverMaxHeadLag)
+		s.Equal(6*time.Second, c.L1FailoverCheckInterval)
+		s.Equal(time.Minute, c.HealthCheck.MaxL1HeadAge)
+		s.Equal(uint64(16), c.HealthCheck.MaxL2BlockLag)
 
 		for i, e := range strings.Split(proverEndpoints, ",") {
 			s.Equal(c.ProverEndpoints[i].String(), e)
@@ -77,14 +106,41 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContext() {
 		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "5",
 		"--" + flags.RPCTimeout.Name, rpcTimeout,
 		"--" + flags.WaitReceiptTimeout.Name, "10s",
+		"--" + flags.ReceiptPollInterval.Name, "500ms",
 		"--" + flags.ProposeBlockTxGasTipCap.Name, "100000",
 		"--" + flags.ProposeBlockTxGasLimit.Name, "100000",
 		"--" + flags.ProverEndpoints.Name, proverEndpoints,
+		"--" + flags.ProverEndpointFailureThreshold.Name, "3",
+		"--" + flags.ProverEndpointCooldown.Name, "30s",
 		"--" + flags.OptimisticTierFee.Name, fmt.Sprint(tierFee),
 		"--" + flags.SgxTierFee.Name, fmt.Sprint(tierFee),
 		"--" + flags.TierFeePriceBump.Name, "15",
 		"--" + flags.MaxTierFeePriceBumps.Name, "5",
+		"--" + flags.MinTxListBytes.Name, "1024",
+		"--" + flags.MinTxListMaxWait.Name, "2m",
+		"--" + flags.AdaptiveTierFee.Name,
+		"--" + flags.SgxTierFeeFloor.Name, fmt.Sprint(tierFee),
 		"--" + flags.ProposeBlockIncludeParentMetaHash.Name, "true",
+		"--" + flags.ProposeMode.Name, string(builder.ProposeModeEconomic),
+		"--" + flags.CheckBlobAvailability.Name,
+		"--" + flags.CheckProposerBond.Name,
+		"--" + flags.TxListsAssemblyDeadline.Name, "3s",
+		"--" + flags.MaxL1BaseFee.Name, "100000000000",
+		"--" + flags.SoftPauseBaseFee.Name, "50000000000",
+		"--" + flags.SoftPauseMaxInterval.Name, "5m",
+		"--" + flags.ForcePropose.Name,
+		"--" + flags.MinProposalGap.Name, "30s",
+		"--" + flags.ProtocolPausedPollInterval.Name, "12s",
+		"--" + flags.NonceStoreFile.Name, "/tmp/nonces.json",
+		"--" + flags.StuckTxTimeout.Name, "2m",
+		"--" + flags.StuckTxGasBumpRate.Name, "15",
+		"--" + flags.GasLimitMargin.Name, "20",
+		"--" + flags.GasLimitMarginCap.Name, "3000000",
+		"--" + flags.MaxTxsPerSecond.Name, "5",
+		"--" + flags.L1FailoverMaxHeadLag.Name, "2",
+		"--" + flags.L1FailoverCheckInterval.Name, "6s",
+		"--" + flags.HealthMaxL1HeadAge.Name, "1m",
+		"--" + flags.HealthMaxL2BlockLag.Name, "16",
 	}))
 }
 
@@ -97,6 +153,38 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContextPrivKeyErr() {
 	}), "invalid L1 proposer private key")
 }
 
+func (s *ProposerTestSuite) TestNewConfigFromCliContextPrivKeysErr() {
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextPrivKeysErr",
+		"--" + flags.L1ProposerPrivKey.Name, encoding.GoldenTouchPrivKey,
+		"--" + flags.L1ProposerPrivKeys.Name, "0x",
+	}), "invalid L1 proposer private key #0")
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextPrivKeysErr",
+		"--" + flags.L1ProposerPrivKey.Name, encoding.GoldenTouchPrivKey,
+		"--" + flags.L1ProposerPrivKeys.Name, encoding.GoldenTouchPrivKey,
+	}), "duplicated L1 proposer private key #0")
+}
+
+func (s *ProposerTestSuite) TestNewConfigFromCliContextSignerErr() {
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextSignerErr",
+		"--" + flags.L1ProposerPrivKey.Name, encoding.GoldenTouchPrivKey,
+		"--" + flags.L1ProposerSigner.Name, "http://localhost:9000",
+	}), "L1 proposer private key and signer can't be both set")
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextSignerErr",
+		"--" + flags.L1ProposerSigner.Name, "http://localhost:9000",
+		"--" + flags.L1ProposerSignerAddress.Name, "0x",
+	}), "invalid L1 proposer signer address")
+}
+
 func (s *ProposerTestSuite) TestNewConfigFromCliContextL2RecipErr() {
 	app := s.SetupApp()
 
@@ -142,6 +230,24 @@ func (s *ProposerTestSuite) TestNewConfigFromCliContextReplMultErr() {
 	}), "invalid --proposeBlockTxReplacementMultiplier value")
 }
 
+func (s *ProposerTestSuite) TestNewConfigFromCliContextMinTxListMaxWaitErr() {
+	goldenTouchAddress, err := s.RPCClient.TaikoL2.GOLDENTOUCHADDRESS(nil)
+	s.Nil(err)
+
+	app := s.SetupApp()
+
+	s.ErrorContains(app.Run([]string{
+		"TestNewConfigFromCliContextMinTxListMaxWaitErr",
+		"--" + flags.L1ProposerPrivKey.Name, encoding.GoldenTouchPrivKey,
+		"--" + flags.L2SuggestedFeeRecipient.Name, goldenTouchAddress.Hex(),
+		"--" + flags.ProposeInterval.Name, proposeInterval,
+		"--" + flags.ProposeEmptyBlocksInterval.Name, proposeInterval,
+		"--" + flags.ProposeBlockTxReplacementMultiplier.Name, "2",
+		"--" + flags.MinTxListBytes.Name, "1024",
+		"--" + flags.MinTxListMaxWait.Name, "0s",
+	}), "invalid --txpool.minTxListMaxWait value")
+}
+
 func (s *ProposerTestSuite) SetupApp() *cli.App {
 	app := cli.NewApp()
 	app.Flags = []cli.Flag{
@@ -151,22 +257,55 @@ func (s *ProposerTestSuite) SetupApp() *cli.App {
 		&cli.StringFlag{Name: flags.TaikoL2Address.Name},
 		&cli.StringFlag{Name: flags.TaikoTokenAddress.Name},
 		&cli.StringFlag{Name: flags.L1ProposerPrivKey.Name},
+		&cli.StringFlag{Name: flags.L1ProposerPrivKeys.Name},
+		&cli.StringFlag{Name: flags.L1ProposerSigner.Name},
+		&cli.StringFlag{Name: flags.L1ProposerSignerAddress.Name},
 		&cli.StringFlag{Name: flags.L2SuggestedFeeRecipient.Name},
 		&cli.DurationFlag{Name: flags.ProposeEmptyBlocksInterval.Name},
 		&cli.DurationFlag{Name: flags.ProposeInterval.Name},
 		&cli.StringFlag{Name: flags.TxPoolLocals.Name},
 		&cli.StringFlag{Name: flags.ProverEndpoints.Name},
+		&cli.Uint64Flag{Name: flags.ProverEndpointFailureThreshold.Name},
+		&cli.DurationFlag{Name: flags.ProverEndpointCooldown.Name},
 		&cli.Uint64Flag{Name: flags.OptimisticTierFee.Name},
 		&cli.Uint64Flag{Name: flags.SgxTierFee.Name},
 		&cli.Uint64Flag{Name: flags.ProposeBlockTxReplacementMultiplier.Name},
 		&cli.DurationFlag{Name: flags.RPCTimeout.Name},
 		&cli.DurationFlag{Name: flags.WaitReceiptTimeout.Name},
+		&cli.DurationFlag{Name: flags.ReceiptPollInterval.Name},
 		&cli.Uint64Flag{Name: flags.ProposeBlockTxGasTipCap.Name},
 		&cli.Uint64Flag{Name: flags.ProposeBlockTxGasLimit.Name},
 		&cli.Uint64Flag{Name: flags.TierFeePriceBump.Name},
 		&cli.Uint64Flag{Name: flags.MaxTierFeePriceBumps.Name},
+		&cli.Uint64Flag{Name: flags.MinTxListBytes.Name},
+		&cli.DurationFlag{Name: flags.MinTxListMaxWait.Name},
+		&cli.BoolFlag{Name: flags.AdaptiveTierFee.Name},
+		&cli.Uint64Flag{Name: flags.OptimisticTierFeeFloor.Name},
+		&cli.Uint64Flag{Name: flags.SgxTierFeeFloor.Name},
 		&cli.BoolFlag{Name: flags.ProposeBlockIncludeParentMetaHash.Name},
 		&cli.StringFlag{Name: flags.ProposerAssignmentHookAddress.Name},
+		&cli.StringFlag{Name: flags.ProposeMode.Name},
+		&cli.BoolFlag{Name: flags.CheckBlobAvailability.Name},
+		&cli.BoolFlag{Name: flags.CheckProposerBond.Name},
+		&cli.DurationFlag{Name: flags.TxListsAssemblyDeadline.Name},
+		&cli.Uint64Flag{Name: flags.MaxL1BaseFee.Name},
+		&cli.Uint64Flag{Name: flags.SoftPauseBaseFee.Name},
+		&cli.DurationFlag{Name: flags.SoftPauseMaxInterval.Name},
+		&cli.BoolFlag{Name: flags.ForcePropose.Name},
+		&cli.DurationFlag{Name: flags.MinProposalGap.Name},
+		&cli.DurationFlag{Name: flags.ProtocolPausedPollInterval.Name},
+		&cli.StringFlag{Name: flags.NonceStoreFile.Name},
+		&cli.DurationFlag{Name: flags.StuckTxTimeout.Name},
+		&cli.Uint64Flag{Name: flags.StuckTxGasBumpRate.Name},
+		&cli.Uint64Flag{Name: flags.GasLimitMargin.Name},
+		&cli.Uint64Flag{Name: flags.GasLimitMarginCap.Name},
+		&cli.Uint64Flag{Name: flags.MaxTxsPerSecond.Name},
+		&cli.StringSliceFlag{Name: flags.L1FailoverWSEndpoints.Name},
+		&cli.Uint64Flag{Name: flags.L1FailoverMaxHeadLag.Name},
+		&cli.DurationFlag{Name: flags.L1FailoverCheckInterval.Name},
+		&cli.DurationFlag{Name: flags.HealthMaxL1HeadAge.Name},
+		&cli.Uint64Flag{Name: flags.HealthMaxL2BlockLag.Name},
+		&cli.Uint64Flag{Name: flags.HealthMaxBeaconSyncDistance.Name},
 	}
 	app.Action = func(ctx *cli.Context) error {
 		_, err := NewConfigFromCliContext(ctx)
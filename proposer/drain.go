@@ -0,0 +1,54 @@
+package proposer
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Interval to check whether the in-flight proposing epoch has finished when draining.
+var drainPollInterval = 100 * time.Millisecond
+
+// Drain stops starting new proposing epochs, and waits for the in-flight epoch and the confirmations of
+// the sent proposing transactions, until the given context is done. It implements the utils.Drainer
+// interface, so the proposer won't abandon the proposing transactions mid-flight on shutdown.
+func (p *Proposer) Drain(ctx context.Context) {
+	p.draining.Store(true)
+
+	var (
+		start      = time.Now()
+		proposing  = p.proposing.Load()
+		pendingTxs = len(p.txToConfirmChannels())
+		ticker     = time.NewTicker(drainPollInterval)
+	)
+	defer ticker.Stop()
+
+	log.Info("Draining proposer", "proposing", proposing, "pendingTxs", pendingTxs)
+
+	for p.proposing.Load() {
+		select {
+		case <-ctx.Done():
+			log.Warn(
+				"Proposer drain timed out, abandoning the in-flight proposing epoch",
+				"pendingTxs", len(p.txToConfirmChannels()),
+				"elapsed", time.Since(start),
+			)
+			return
+		case <-ticker.C:
+		}
+	}
+
+	if confirm, err := p.waitTxConfirmations(ctx); err != nil {
+		log.Warn(
+			"Proposer drain stopped waiting for transaction confirmations",
+			"txId", confirm.ID,
+			"pendingTxs", len(p.txToConfirmChannels()),
+			"elapsed", time.Since(start),
+			"error", err,
+		)
+		return
+	}
+
+	log.Info("Proposer drained", "proposing", proposing, "pendingTxs", pendingTxs, "elapsed", time.Since(start))
+}
@@ -0,0 +1,53 @@
+package proposer
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var errTxListTooSmall = errors.New("transactions lists too small")
+
+// checkTxListSize returns errTxListTooSmall if the transactions lists to propose in this epoch are smaller
+// than the configured minimum size, so the proposer won't waste L1 gas on near-empty blocks. Proposing is
+// deferred for at most the configured max wait, after which the transactions lists are proposed anyway, and
+// never beyond the empty block heartbeat, so the deferred transactions are proposed instead of an empty block.
+func (p *Proposer) checkTxListSize(txLists []types.Transactions, now time.Time) error {
+	if p.MinTxListBytes == 0 {
+		return nil
+	}
+
+	var size uint64
+	for i, txs := range txLists {
+		if i >= int(p.MaxProposedTxListsPerEpoch) {
+			break
+		}
+		for _, tx := range txs {
+			size += tx.Size()
+		}
+	}
+
+	if size >= p.MinTxListBytes {
+		p.txListDeferredSince = time.Time{}
+		return nil
+	}
+
+	if p.txListDeferredSince.IsZero() {
+		p.txListDeferredSince = now
+	}
+	if waited := now.Sub(p.txListDeferredSince); waited >= p.MinTxListMaxWait {
+		log.Info("Max wait for the minimum transactions lists size elapsed", "size", size, "waited", waited)
+		p.txListDeferredSince = time.Time{}
+		return nil
+	}
+	if p.heartbeatDue(now) {
+		log.Info("Empty block heartbeat due, proposing the small transactions lists", "size", size)
+		p.txListDeferredSince = time.Time{}
+		return nil
+	}
+
+	return fmt.Errorf("%w: %d bytes, minimum %d bytes", errTxListTooSmall, size, p.MinTxListBytes)
+}
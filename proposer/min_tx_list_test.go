@@ -0,0 +1,46 @@
+package proposer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTxListSize(t *testing.T) {
+	var (
+		tx      = types.NewTx(&types.DynamicFeeTx{Data: make([]byte, 100)})
+		small   = []types.Transactions{{tx}}
+		large   = []types.Transactions{{tx, tx, tx}}
+		now     = time.Now()
+		p       = &Proposer{Config: &Config{MaxProposedTxListsPerEpoch: 1}}
+		minimum = 2 * tx.Size()
+	)
+
+	// No minimum size configured.
+	require.Nil(t, p.checkTxListSize(small, now))
+
+	p.MinTxListBytes = minimum
+	p.MinTxListMaxWait = time.Minute
+
+	// Deferred until enough transactions are accumulated.
+	require.ErrorIs(t, p.checkTxListSize(small, now), errTxListTooSmall)
+	require.ErrorIs(t, p.checkTxListSize(small, now.Add(30*time.Second)), errTxListTooSmall)
+	require.Nil(t, p.checkTxListSize(large, now.Add(40*time.Second)))
+
+	// Only the transactions lists proposed in this epoch count.
+	require.ErrorIs(t, p.checkTxListSize(append(small, small...), now), errTxListTooSmall)
+
+	// Proposed anyway once the max wait elapses, and the wait starts over.
+	require.Nil(t, p.checkTxListSize(small, now.Add(time.Minute)))
+	require.ErrorIs(t, p.checkTxListSize(small, now.Add(61*time.Second)), errTxListTooSmall)
+
+	// Proposed instead of an empty block once the heartbeat is due.
+	p.MinTxListMaxWait = time.Hour
+	p.ProposeEmptyBlocksInterval = 10 * time.Minute
+	p.lastNonEmptyBlockProposedAt = now
+	require.ErrorIs(t, p.checkTxListSize(small, now.Add(5*time.Minute)), errTxListTooSmall)
+	require.Nil(t, p.checkTxListSize(small, now.Add(10*time.Minute)))
+	require.Zero(t, p.txListDeferredSince)
+}
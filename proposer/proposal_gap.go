@@ -0,0 +1,28 @@
+package proposer
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var errProposalGapNotElapsed = errors.New("minimum proposal gap not elapsed")
+
+// checkProposalGap returns errProposalGapNotElapsed if the last proposal was made within the configured
+// minimum gap before the given time, so the proposer never builds blocks faster than L2 can process them.
+func (p *Proposer) checkProposalGap(now time.Time) error {
+	if p.MinProposalGap == 0 || p.lastProposedAt.IsZero() {
+		return nil
+	}
+
+	if elapsed := now.Sub(p.lastProposedAt); elapsed < p.MinProposalGap {
+		return fmt.Errorf(
+			"%w: %s since the last proposal, minimum %s",
+			errProposalGapNotElapsed,
+			elapsed,
+			p.MinProposalGap,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package proposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckProposalGap(t *testing.T) {
+	p := &Proposer{Config: &Config{MinProposalGap: time.Second}}
+
+	// Fire the proposing triggers every 100ms, the proposals are still at least one gap apart.
+	var (
+		start     = time.Now()
+		proposals []time.Time
+	)
+	for now := start; now.Before(start.Add(3500 * time.Millisecond)); now = now.Add(100 * time.Millisecond) {
+		if err := p.checkProposalGap(now); err != nil {
+			require.ErrorIs(t, err, errProposalGapNotElapsed)
+			continue
+		}
+		proposals = append(proposals, now)
+		p.lastProposedAt = now
+	}
+
+	require.Len(t, proposals, 4)
+	for i := 1; i < len(proposals); i++ {
+		require.GreaterOrEqual(t, proposals[i].Sub(proposals[i-1]), time.Second)
+	}
+
+	// No gap configured.
+	p.MinProposalGap = 0
+	require.Nil(t, p.checkProposalGap(p.lastProposedAt))
+}
+
+func TestProposeTxListProposalGap(t *testing.T) {
+	p := &Proposer{Config: &Config{MinProposalGap: time.Minute}, lastProposedAt: time.Now()}
+
+	// The gap is enforced before building any transaction, including the empty block heartbeats.
+	require.ErrorIs(t, p.ProposeTxList(context.Background(), []byte{}, 0), errProposalGapNotElapsed)
+	require.ErrorIs(t, p.ProposeEmptyBlockOp(context.Background()), errProposalGapNotElapsed)
+	require.ErrorIs(t, p.ProposeOp(context.Background()), errProposalGapNotElapsed)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/rand"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/taikoxyz/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/internal/errlog"
 	"github.com/taikoxyz/taiko-client/internal/metrics"
 	"github.com/taikoxyz/taiko-client/internal/utils"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
@@ -29,6 +31,8 @@ import (
 
 var (
 	errNoNewTxs                = errors.New("no new transactions")
+	errAnchorGasLimitTooHigh   = errors.New("anchor gas limit exceeds block max gas limit")
+	errSendProposeTx           = errors.New("failed to send TaikoL1.proposeBlock transaction")
 	proverAssignmentTimeout    = 30 * time.Minute
 	requestProverServerTimeout = 12 * time.Second
 )
@@ -55,12 +59,31 @@ type Proposer struct {
 
 	// Protocol configurations
 	protocolConfigs *bindings.TaikoDataConfig
+	// Gas limit available for the transactions in a transaction list
+	txListGasLimit uint32
+	// Time of the last proposal, used to enforce the minimum proposal gap
+	lastProposedAt time.Time
+	// Number of times the proposing interval is doubled during a L1 congestion soft-pause
+	softPauseLevel uint64
+	// Time since when proposing has been deferred for the transactions lists being too small
+	txListDeferredSince time.Time
+	// Time of the last proposed block, used to decide whether the empty block heartbeat is due
+	lastNonEmptyBlockProposedAt time.Time
 
 	// Only for testing purposes
 	CustomProposeOpHook func() error
 	AfterCommitHook     func() error
 
+	// Sender of the primary L1 proposer key
 	sender *sender.Sender
+	// Senders of all the L1 proposer keys, rotated per proposal
+	senders    []*sender.Sender
+	nextSender int
+
+	// Set on shutdown, no new epochs are started once set
+	draining atomic.Bool
+	// Set while an epoch is being proposed, waited for when draining
+	proposing atomic.Bool
 
 	ctx context.Context
 	wg  sync.WaitGroup
@@ -78,7 +101,11 @@ func (p *Proposer) InitFromCli(ctx context.Context, c *cli.Context) error {
 
 // InitFromConfig initializes the proposer instance based on the given configurations.
 func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config) (err error) {
-	p.proposerAddress = crypto.PubkeyToAddress(cfg.L1ProposerPrivKey.PublicKey)
+	if cfg.L1ProposerSigner != "" {
+		p.proposerAddress = cfg.L1ProposerSignerAddress
+	} else {
+		p.proposerAddress = crypto.PubkeyToAddress(cfg.L1ProposerPrivKey.PublicKey)
+	}
 	p.ctx = ctx
 	p.Config = cfg
 
@@ -96,6 +123,10 @@ func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config) (err error)
 
 	log.Info("Protocol configs", "configs", p.protocolConfigs)
 
+	if p.txListGasLimit, err = txListGasLimit(protocolConfigs.BlockMaxGasLimit, cfg.AnchorGasLimit); err != nil {
+		return err
+	}
+
 	if p.tiers, err = p.rpc.GetTiers(ctx); err != nil {
 		return err
 	}
@@ -103,12 +134,24 @@ func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config) (err error)
 		return err
 	}
 
-	if p.sender, err = sender.NewSender(ctx, &sender.Config{
-		MaxGasFee:      20000000000,
-		GasGrowthRate:  20,
-		GasLimit:       cfg.ProposeBlockTxGasLimit,
-		MaxWaitingTime: time.Second * 30,
-	}, p.rpc.L1, cfg.L1ProposerPrivKey); err != nil {
+	senderCfg := &sender.Config{
+		MaxGasFee:           20000000000,
+		GasGrowthRate:       20,
+		GasLimit:            cfg.ProposeBlockTxGasLimit,
+		MaxWaitingTime:      time.Second * 30,
+		ReceiptPollInterval: cfg.ReceiptPollInterval,
+		StuckTxTimeout:      cfg.StuckTxTimeout,
+		StuckTxGasBumpRate:  cfg.StuckTxGasBumpRate,
+		GasLimitMargin:      cfg.GasLimitMargin,
+		GasLimitMarginCap:   cfg.GasLimitMarginCap,
+		MaxTxsPerSecond:     cfg.MaxTxsPerSecond,
+	}
+	if cfg.NonceStoreFile != "" {
+		if senderCfg.NonceStore, err = sender.NewFileNonceStore(cfg.NonceStoreFile); err != nil {
+			return err
+		}
+	}
+	if err := p.initSenders(ctx, senderCfg); err != nil {
 		return err
 	}
 
@@ -118,34 +161,54 @@ func (p *Proposer) InitFromConfig(ctx context.Context, cfg *Config) (err error)
 		cfg.TaikoL1Address,
 		cfg.AssignmentHookAddress,
 		p.tierFees,
+		cfg.TierFeeFloors,
 		cfg.TierFeePriceBump,
 		cfg.ProverEndpoints,
+		cfg.ProverEndpointFailureThreshold,
+		cfg.ProverEndpointCooldown,
 		cfg.MaxTierFeePriceBumps,
+		cfg.AdaptiveTierFee,
 		proverAssignmentTimeout,
 		requestProverServerTimeout,
 	); err != nil {
 		return err
 	}
 
-	if cfg.BlobAllowed {
-		p.txBuilder = builder.NewBlobTransactionBuilder(
+	var (
+		calldataTxBuilder = builder.NewCalldataTransactionBuilder(
 			p.rpc,
 			p.proverSelector,
 			p.Config.L1BlockBuilderTip,
-			cfg.TaikoL1Address,
 			cfg.L2SuggestedFeeRecipient,
 			cfg.AssignmentHookAddress,
 			cfg.ExtraData,
 		)
-	} else {
-		p.txBuilder = builder.NewCalldataTransactionBuilder(
+		blobTxBuilder = builder.NewBlobTransactionBuilder(
 			p.rpc,
 			p.proverSelector,
 			p.Config.L1BlockBuilderTip,
+			cfg.TaikoL1Address,
 			cfg.L2SuggestedFeeRecipient,
 			cfg.AssignmentHookAddress,
 			cfg.ExtraData,
 		)
+	)
+
+	switch {
+	case cfg.ProposeMode == builder.ProposeModeBlob || (cfg.ProposeMode == "" && cfg.BlobAllowed):
+		p.txBuilder = blobTxBuilder
+	case cfg.ProposeMode == builder.ProposeModeEconomic:
+		p.txBuilder = builder.NewEconomicTransactionBuilder(p.rpc, calldataTxBuilder, blobTxBuilder)
+	default:
+		p.txBuilder = calldataTxBuilder
+	}
+
+	if cfg.CheckBlobAvailability && p.txBuilder != calldataTxBuilder {
+		p.txBuilder = builder.NewBlobFallbackTransactionBuilder(
+			p.txBuilder,
+			calldataTxBuilder,
+			builder.NewBlobPoolChecker(p.rpc),
+		)
 	}
 
 	return nil
@@ -165,8 +228,9 @@ func (p *Proposer) eventLoop() {
 		p.wg.Done()
 	}()
 
-	var lastNonEmptyBlockProposedAt = time.Now()
+	p.lastNonEmptyBlockProposedAt = time.Now()
 	for {
+		p.proposing.Store(false)
 		p.updateProposingTicker()
 
 		select {
@@ -174,37 +238,73 @@ func (p *Proposer) eventLoop() {
 			return
 		// proposing interval timer has been reached
 		case <-p.proposingTimer.C:
+			// Mark the epoch as proposing before checking the draining flag, so that the drain
+			// either sees this epoch in flight or this epoch sees the drain.
+			if p.proposing.Store(true); p.draining.Load() {
+				log.Debug("Proposer draining, skipping the proposing epoch")
+				continue
+			}
 			metrics.ProposerProposeEpochCounter.Inc(1)
+			// wait until TaikoL1 is unpaused, instead of sending transactions which will revert
+			if p.ProtocolPausedPollInterval != 0 {
+				if err := rpc.WaitTillProtocolUnpaused(
+					p.ctx,
+					p.rpc.IsProtocolPaused,
+					p.ProtocolPausedPollInterval,
+					metrics.ProposerProtocolPausedGauge,
+				); err != nil {
+					log.Error("Failed to wait until TaikoL1 is unpaused", "error", err)
+					continue
+				}
+			}
 			// attempt propose operation
 			if err := p.ProposeOp(p.ctx); err != nil {
-				if !errors.Is(err, errNoNewTxs) {
+				reason, skipped := skipReasonOf(err)
+				if !skipped {
 					log.Error("Proposing operation error", "error", err)
+					errlog.Record(errlog.ComponentProposer, "Proposing operation error", err)
 					continue
 				}
+				recordSkip(reason)
+
 				// if no new transactions and empty block interval has passed, propose an empty block
 				if p.ProposeEmptyBlocksInterval != 0 {
-					if time.Now().Before(lastNonEmptyBlockProposedAt.Add(p.ProposeEmptyBlocksInterval)) {
+					if !p.heartbeatDue(time.Now()) {
 						continue
 					}
 
 					if err := p.ProposeEmptyBlockOp(p.ctx); err != nil {
+						// Retry the heartbeat in the next epoch.
+						if errors.Is(err, errProposalGapNotElapsed) {
+							log.Debug("Empty block heartbeat deferred", "error", err)
+							continue
+						}
 						log.Error("Proposing an empty block operation error", "error", err)
+						errlog.Record(errlog.ComponentProposer, "Proposing an empty block operation error", err)
 					}
 
-					lastNonEmptyBlockProposedAt = time.Now()
+					p.lastNonEmptyBlockProposedAt = time.Now()
 				}
 
 				continue
 			}
 
-			lastNonEmptyBlockProposedAt = time.Now()
+			p.lastNonEmptyBlockProposedAt = time.Now()
 		}
 	}
 }
 
+// heartbeatDue returns whether the empty block heartbeat is due at the given time.
+func (p *Proposer) heartbeatDue(now time.Time) bool {
+	return p.ProposeEmptyBlocksInterval != 0 &&
+		!now.Before(p.lastNonEmptyBlockProposedAt.Add(p.ProposeEmptyBlocksInterval))
+}
+
 // Close closes the proposer instance.
 func (p *Proposer) Close(_ context.Context) {
-	p.sender.Close()
+	for _, s := range p.senders {
+		s.Close()
+	}
 	p.wg.Wait()
 }
 
@@ -216,58 +316,40 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 		return p.CustomProposeOpHook()
 	}
 
+	if err := p.checkProposalGap(time.Now()); err != nil {
+		return err
+	}
+
 	// Wait until L2 execution engine is synced at first.
 	if err := p.rpc.WaitTillL2ExecutionEngineSynced(ctx); err != nil {
 		return fmt.Errorf("failed to wait until L2 execution engine synced: %w", err)
 	}
 
-	log.Info("Start fetching L2 execution engine's transaction pool content")
-
-	txLists, err := p.rpc.GetPoolContent(
-		ctx,
-		p.proposerAddress,
-		p.protocolConfigs.BlockMaxGasLimit,
-		rpc.BlockMaxTxListBytes,
-		p.LocalAddresses,
-		p.MaxProposedTxListsPerEpoch,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to fetch transaction pool content: %w", err)
+	// Slow down or defer proposing during a L1 base fee spike, an empty block will still be proposed
+	// if the heartbeat is due, unless proposing is forced regardless of the L1 base fee.
+	if !p.ForcePropose && (p.MaxL1BaseFee != nil || p.SoftPauseBaseFee != nil) {
+		if err := p.checkL1BaseFee(ctx); err != nil {
+			return err
+		}
 	}
 
-	if p.LocalAddressesOnly {
-		var (
-			localTxsLists []types.Transactions
-			signer        = types.LatestSignerForChainID(p.rpc.L2.ChainID)
-		)
-		for _, txs := range txLists {
-			var filtered types.Transactions
-			for _, tx := range txs {
-				sender, err := types.Sender(signer, tx)
-				if err != nil {
-					return err
-				}
-
-				for _, localAddress := range p.LocalAddresses {
-					if sender == localAddress {
-						filtered = append(filtered, tx)
-					}
-				}
-			}
-
-			if filtered.Len() != 0 {
-				localTxsLists = append(localTxsLists, filtered)
-			}
-		}
-		txLists = localTxsLists
+	txLists, err := assembleTxLists(ctx, p.poolContentSource(), p.TxListsAssemblyDeadline)
+	if err != nil {
+		return fmt.Errorf("failed to assemble transactions lists: %w", err)
 	}
 
 	log.Info("Transactions lists count", "count", len(txLists))
 
 	if len(txLists) == 0 {
+		// The pool has drained, so the next burst of transactions starts a new deferral.
+		p.txListDeferredSince = time.Time{}
 		return errNoNewTxs
 	}
 
+	if err := p.checkTxListSize(txLists, time.Now()); err != nil {
+		return err
+	}
+
 	// Wait for all transactions to be confirmed, if there is any.
 	defer func() {
 		if err := p.waitConfimations(); err != nil {
@@ -287,6 +369,10 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 		}
 
 		if err := p.ProposeTxList(ctx, txListBytes, uint(txs.Len())); err != nil {
+			// The remaining transactions lists will be proposed in the next epochs.
+			if errors.Is(err, errProposalGapNotElapsed) {
+				return nil
+			}
 			return fmt.Errorf("failed to send TaikoL1.proposeBlock transactions: %w", err)
 		}
 	}
@@ -297,12 +383,10 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 // waitConfimations waits for all current proposer transactions to be confirmed.
 func (p *Proposer) waitConfimations() error {
 	// Wait for all transactions to be confirmed.
-	for _, confirmCh := range p.sender.TxToConfirmChannels() {
-		confirm := <-confirmCh
-		if confirm.Err != nil {
-			log.Error("ProposeTxList error", "txId", confirm.ID, "error", confirm.Err)
-			return confirm.Err
-		}
+	if confirm, err := p.waitTxConfirmations(p.ctx); err != nil {
+		log.Error("ProposeTxList error", "txId", confirm.ID, "error", err)
+		errlog.Record(errlog.ComponentProposer, "ProposeTxList error", err, "txId", confirm.ID)
+		return err
 	}
 
 	if p.AfterCommitHook != nil {
@@ -320,33 +404,74 @@ func (p *Proposer) ProposeTxList(
 	txListBytes []byte,
 	txNum uint,
 ) error {
+	if err := p.checkProposalGap(time.Now()); err != nil {
+		return err
+	}
+
 	compressedTxListBytes, err := utils.Compress(txListBytes)
 	if err != nil {
 		return err
 	}
 
+	// Rotate among the L1 proposer keys, a key which can't send the transaction, or doesn't have enough
+	// bond, falls through to the next one.
+	var proposer common.Address
+	for _, s := range p.rotateSenders() {
+		if err = p.proposeTxListWith(ctx, s, compressedTxListBytes); err == nil {
+			proposer = s.Address()
+			break
+		}
+		if !errors.Is(err, errSendProposeTx) && !errors.Is(err, errInsufficientProposerBond) {
+			break
+		}
+	}
+	if err != nil {
+		return err
+	}
+	p.lastProposedAt = time.Now()
+
+	log.Info("📝 Propose transactions succeeded", "txs", txNum, "proposer", proposer)
+
+	metrics.ProposerProposedTxListsCounter.Inc(1)
+	metrics.ProposerProposedTxsCounter.Inc(int64(txNum))
+
+	return nil
+}
+
+// proposeTxListWith builds a TaikoL1.proposeBlock transaction with the given compressed transactions list,
+// and sends it by the given sender, the prover fee is paid by the sender's key.
+func (p *Proposer) proposeTxListWith(ctx context.Context, s *sender.Sender, compressedTxListBytes []byte) error {
+	if p.CheckProposerBond {
+		if err := p.checkProposerBond(ctx, s.Address()); err != nil {
+			return err
+		}
+	}
+
 	tx, err := p.txBuilder.Build(
 		ctx,
 		p.tierFees,
-		p.sender.GetOpts(p.ctx),
+		s.GetOpts(p.ctx),
 		p.IncludeParentMetaHash,
 		compressedTxListBytes,
 	)
 	if err != nil {
-		log.Warn("Failed to build TaikoL1.proposeBlock transaction", "error", encoding.TryParsingCustomError(err))
+		log.Warn(
+			"Failed to build TaikoL1.proposeBlock transaction",
+			"proposer", s.Address(),
+			"error", encoding.TryParsingCustomError(err),
+		)
 		return err
 	}
 
-	if _, err = p.sender.SendTransaction(tx); err != nil {
-		log.Warn("Failed to send TaikoL1.proposeBlock transaction", "error", encoding.TryParsingCustomError(err))
-		return err
+	if _, err = s.SendTransaction(tx); err != nil {
+		log.Warn(
+			"Failed to send TaikoL1.proposeBlock transaction",
+			"proposer", s.Address(),
+			"error", encoding.TryParsingCustomError(err),
+		)
+		return fmt.Errorf("%w: %w", errSendProposeTx, err)
 	}
 
-	log.Info("📝 Propose transactions succeeded", "txs", txNum)
-
-	metrics.ProposerProposedTxListsCounter.Inc(1)
-	metrics.ProposerProposedTxsCounter.Inc(int64(txNum))
-
 	return nil
 }
 
@@ -359,12 +484,9 @@ func (p *Proposer) ProposeEmptyBlockOp(ctx context.Context) error {
 	if err = p.ProposeTxList(ctx, emptyTxListBytes, 0); err != nil {
 		return err
 	}
-	for _, confirmCh := range p.sender.TxToConfirmChannels() {
-		confirm := <-confirmCh
-		if confirm.Err != nil {
-			log.Error("ProposeEmptyBlockOp error", "td_id", confirm.ID, "error", confirm.Err)
-			return confirm.Err
-		}
+	if confirm, err := p.waitTxConfirmations(ctx); err != nil {
+		log.Error("ProposeEmptyBlockOp error", "td_id", confirm.ID, "error", err)
+		return err
 	}
 	return nil
 }
@@ -383,6 +505,8 @@ func (p *Proposer) updateProposingTicker() {
 		randomSeconds := rand.Intn(120-11) + 12 // nolint: gosec
 		duration = time.Duration(randomSeconds) * time.Second
 	}
+	duration = p.softPauseInterval(duration)
+	metrics.ProposerEffectiveProposeIntervalGauge.Update(duration.Milliseconds())
 
 	p.proposingTimer = time.NewTimer(duration)
 }
@@ -392,6 +516,11 @@ func (p *Proposer) Name() string {
 	return "proposer"
 }
 
+// HealthCheck checks the health of the RPC connections, it implements the utils.HealthChecker interface.
+func (p *Proposer) HealthCheck(ctx context.Context) *rpc.HealthCheckResult {
+	return p.rpc.HealthCheck(ctx)
+}
+
 // GetSender returns the sender instance.
 func (p *Proposer) GetSender() *sender.Sender {
 	return p.sender
@@ -425,3 +554,13 @@ func (p *Proposer) initTierFees() error {
 
 	return nil
 }
+
+// txListGasLimit returns the gas limit available for the transactions in a transaction list, after
+// reserving the given anchor transaction gas limit from the protocol's block max gas limit.
+func txListGasLimit(blockMaxGasLimit uint32, anchorGasLimit uint64) (uint32, error) {
+	if anchorGasLimit >= uint64(blockMaxGasLimit) {
+		return 0, fmt.Errorf("%w: %d >= %d", errAnchorGasLimitTooHigh, anchorGasLimit, blockMaxGasLimit)
+	}
+
+	return blockMaxGasLimit - uint32(anchorGasLimit), nil
+}
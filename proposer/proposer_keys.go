@@ -0,0 +1,80 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/taikoxyz/taiko-client/pkg/sender"
+)
+
+// initSenders creates a transaction sender for each L1 proposer key, the primary key's sender comes first.
+// Each sender tracks its own nonce, so the proposals sent by different keys never contend for a nonce.
+func (p *Proposer) initSenders(ctx context.Context, cfg *sender.Config) error {
+	signers, err := p.initSigners(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.senders = make([]*sender.Sender, 0, len(signers))
+	for _, signer := range signers {
+		s, err := sender.NewSenderWithSigner(ctx, cfg, p.rpc.L1, signer)
+		if err != nil {
+			return fmt.Errorf("failed to create sender for proposer %s: %w", signer.Address(), err)
+		}
+		p.senders = append(p.senders, s)
+	}
+	p.sender = p.senders[0]
+
+	return nil
+}
+
+// initSigners creates a transaction signer for each L1 proposer key, the primary key is held by the
+// external signer if there is one.
+func (p *Proposer) initSigners(ctx context.Context) ([]sender.Signer, error) {
+	var primary sender.Signer
+	if p.L1ProposerSigner != "" {
+		remote, err := sender.NewRemoteSigner(ctx, p.L1ProposerSigner, p.L1ProposerSignerAddress, p.rpc.L1.ChainID)
+		if err != nil {
+			return nil, err
+		}
+		primary = remote
+	} else {
+		primary = sender.NewLocalSigner(p.L1ProposerPrivKey, p.rpc.L1.ChainID)
+	}
+
+	signers := []sender.Signer{primary}
+	for _, key := range p.L1ProposerPrivKeys {
+		signers = append(signers, sender.NewLocalSigner(key, p.rpc.L1.ChainID))
+	}
+
+	return signers, nil
+}
+
+// rotateSenders returns all the senders in the order they should be tried for the next proposal, starting
+// from the next one in rotation, and advances the rotation.
+func (p *Proposer) rotateSenders() []*sender.Sender {
+	if len(p.senders) == 0 {
+		return []*sender.Sender{p.sender}
+	}
+
+	start := p.nextSender % len(p.senders)
+	p.nextSender = (start + 1) % len(p.senders)
+
+	return append(append([]*sender.Sender{}, p.senders[start:]...), p.senders[:start]...)
+}
+
+// txToConfirmChannels returns the channels of all the transactions sent by all the senders.
+func (p *Proposer) txToConfirmChannels() map[string]<-chan *sender.TxToConfirm {
+	if len(p.senders) == 0 {
+		return p.sender.TxToConfirmChannels()
+	}
+
+	channels := make(map[string]<-chan *sender.TxToConfirm)
+	for _, s := range p.senders {
+		for id, ch := range s.TxToConfirmChannels() {
+			channels[id] = ch
+		}
+	}
+
+	return channels
+}
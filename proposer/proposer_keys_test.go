@@ -0,0 +1,28 @@
+package proposer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/pkg/sender"
+)
+
+func TestRotateSenders(t *testing.T) {
+	var (
+		a = &sender.Sender{Config: &sender.Config{GasLimit: 1}}
+		b = &sender.Sender{Config: &sender.Config{GasLimit: 2}}
+		c = &sender.Sender{Config: &sender.Config{GasLimit: 3}}
+		p = &Proposer{sender: a}
+	)
+
+	// Only the primary sender.
+	require.Equal(t, []*sender.Sender{a}, p.rotateSenders())
+
+	// Rotated per proposal, the other senders are the fallbacks in order.
+	p.senders = []*sender.Sender{a, b, c}
+	require.Equal(t, []*sender.Sender{a, b, c}, p.rotateSenders())
+	require.Equal(t, []*sender.Sender{b, c, a}, p.rotateSenders())
+	require.Equal(t, []*sender.Sender{c, a, b}, p.rotateSenders())
+	require.Equal(t, []*sender.Sender{a, b, c}, p.rotateSenders())
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/taikoxyz/taiko-client/bindings"
@@ -130,7 +131,10 @@ func (s *ProposerTestSuite) TestProposeOpLocalsOnly() {
 		close(sink)
 	}()
 
+	// The deferral of the small transactions lists ends once the pool has drained.
+	s.p.txListDeferredSince = time.Now()
 	s.Error(errNoNewTxs, s.p.ProposeOp(context.Background()))
+	s.Zero(s.p.txListDeferredSince)
 }
 
 func (s *ProposerTestSuite) TestProposeEmptyBlockOp() {
@@ -221,6 +225,34 @@ func (s *ProposerTestSuite) TestStartClose() {
 	s.NotPanics(func() { s.p.Close(context.Background()) })
 }
 
+func TestTxListGasLimit(t *testing.T) {
+	var blockMaxGasLimit uint32 = 15_000_000
+
+	for _, anchorGasLimit := range []uint64{0, 250_000, uint64(blockMaxGasLimit) - 1} {
+		gasLimit, err := txListGasLimit(blockMaxGasLimit, anchorGasLimit)
+		require.Nil(t, err)
+
+		// Assemble a transaction list which uses up all the available gas.
+		var (
+			txs     types.Transactions
+			gasUsed uint64
+		)
+		for gasUsed+21_000 <= uint64(gasLimit) {
+			txs = append(txs, types.NewTx(&types.DynamicFeeTx{Nonce: uint64(len(txs)), Gas: 21_000}))
+			gasUsed += 21_000
+		}
+
+		var total uint64
+		for _, tx := range txs {
+			total += tx.Gas()
+		}
+		require.LessOrEqual(t, total+anchorGasLimit, uint64(blockMaxGasLimit))
+	}
+
+	_, err := txListGasLimit(blockMaxGasLimit, uint64(blockMaxGasLimit))
+	require.ErrorIs(t, err, errAnchorGasLimitTooHigh)
+}
+
 func TestProposerTestSuite(t *testing.T) {
 	suite.Run(t, new(ProposerTestSuite))
 }
@@ -0,0 +1,92 @@
+package selector
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+)
+
+var (
+	// Number of the consecutive assignments which required the max price bumps, before the starting
+	// tier fees are raised.
+	adaptiveRaiseThreshold uint64 = 2
+	// Maximum multiplier (in percentage) of the starting tier fees.
+	maxAdaptiveMultiplier uint64 = 1000
+)
+
+// adaptiveTierFee adjusts the starting tier fees by the recent assignment results, the starting fees are
+// raised by the price bump percentage once the assignments repeatedly required the max price bumps, and
+// relaxed by the same percentage once an assignment succeeds on the first try.
+type adaptiveTierFee struct {
+	mu sync.Mutex
+	// Multiplier (in percentage) applied to the starting tier fees, never below 100
+	multiplier uint64
+	// Number of the consecutive assignments which required the max price bumps
+	maxBumpsStreak uint64
+	step           uint64
+}
+
+// newAdaptiveTierFee creates a new adaptiveTierFee instance, returns nil if the adaptivity is disabled.
+func newAdaptiveTierFee(enabled bool, tierFeePriceBump *big.Int) *adaptiveTierFee {
+	if !enabled || tierFeePriceBump == nil || tierFeePriceBump.Sign() <= 0 {
+		return nil
+	}
+
+	metrics.ProposerTierFeeMultiplierGauge.Update(100)
+	return &adaptiveTierFee{multiplier: 100, step: tierFeePriceBump.Uint64()}
+}
+
+// apply returns a copy of the given tier fees multiplied by the current multiplier.
+func (a *adaptiveTierFee) apply(tierFees []encoding.TierFee) []encoding.TierFee {
+	if a == nil {
+		return tierFees
+	}
+
+	a.mu.Lock()
+	multiplier := new(big.Int).SetUint64(a.multiplier)
+	a.mu.Unlock()
+
+	fees := make([]encoding.TierFee, len(tierFees))
+	for idx, tierFee := range tierFees {
+		fees[idx] = encoding.TierFee{
+			Tier: tierFee.Tier,
+			Fee:  new(big.Int).Div(new(big.Int).Mul(tierFee.Fee, multiplier), big.NewInt(100)),
+		}
+	}
+
+	return fees
+}
+
+// record adjusts the multiplier by the result of an assignment, which either succeeded after the given
+// number of price bumps, or required the max price bumps.
+func (a *adaptiveTierFee) record(bumps uint64, maxBumps bool) {
+	if a == nil {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	prev := a.multiplier
+	switch {
+	case maxBumps:
+		if a.maxBumpsStreak++; a.maxBumpsStreak >= adaptiveRaiseThreshold {
+			a.multiplier = min(a.multiplier+a.step, maxAdaptiveMultiplier)
+			a.maxBumpsStreak = 0
+		}
+	case bumps == 0:
+		a.multiplier = max(a.multiplier-min(a.step, a.multiplier), 100)
+		a.maxBumpsStreak = 0
+	default:
+		a.maxBumpsStreak = 0
+	}
+
+	if a.multiplier != prev {
+		log.Info("Adaptive tier fee multiplier updated", "multiplier", a.multiplier, "previous", prev)
+	}
+	metrics.ProposerTierFeeMultiplierGauge.Update(int64(a.multiplier))
+}
@@ -0,0 +1,52 @@
+package selector
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+func TestAdaptiveTierFee(t *testing.T) {
+	// Disabled by default.
+	require.Nil(t, newAdaptiveTierFee(false, big.NewInt(10)))
+	require.Nil(t, newAdaptiveTierFee(true, big.NewInt(0)))
+
+	var (
+		tierFees = []encoding.TierFee{{Tier: encoding.TierOptimisticID, Fee: big.NewInt(1000)}}
+		disabled *adaptiveTierFee
+	)
+	require.Equal(t, tierFees, disabled.apply(tierFees))
+	disabled.record(3, true)
+
+	a := newAdaptiveTierFee(true, big.NewInt(10))
+	require.Equal(t, big.NewInt(1000), a.apply(tierFees)[0].Fee)
+
+	// Raised only after repeatedly requiring the max bumps.
+	a.record(3, true)
+	require.Equal(t, uint64(100), a.multiplier)
+	a.record(3, true)
+	require.Equal(t, uint64(110), a.multiplier)
+	require.Equal(t, big.NewInt(1100), a.apply(tierFees)[0].Fee)
+	require.Equal(t, big.NewInt(1000), tierFees[0].Fee)
+
+	// A success in between resets the streak.
+	a.record(3, true)
+	a.record(1, false)
+	a.record(3, true)
+	require.Equal(t, uint64(110), a.multiplier)
+
+	// Relaxed on the first try successes, never below the initial tier fees.
+	a.record(0, false)
+	require.Equal(t, uint64(100), a.multiplier)
+	a.record(0, false)
+	require.Equal(t, uint64(100), a.multiplier)
+
+	// Capped.
+	for i := 0; i < 1000; i++ {
+		a.record(3, true)
+	}
+	require.Equal(t, maxAdaptiveMultiplier, a.multiplier)
+}
@@ -0,0 +1,111 @@
+package selector
+
+import (
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// endpointState is the circuit breaker state of a prover endpoint.
+type endpointState struct {
+	consecutiveFailures uint64
+	// The endpoint is removed from the rotation until this time, zero means it's in the rotation
+	excludedUntil time.Time
+}
+
+// endpointBreaker is a circuit breaker which temporarily removes a prover endpoint from the rotation
+// after the given number of consecutive failures, and adds it back after the cooldown.
+type endpointBreaker struct {
+	mu        sync.Mutex
+	threshold uint64
+	cooldown  time.Duration
+	states    map[string]*endpointState
+}
+
+// newEndpointBreaker creates a new endpointBreaker instance, returns nil if the threshold is zero.
+func newEndpointBreaker(threshold uint64, cooldown time.Duration) *endpointBreaker {
+	if threshold == 0 {
+		return nil
+	}
+
+	return &endpointBreaker{threshold: threshold, cooldown: cooldown, states: make(map[string]*endpointState)}
+}
+
+// available filters out the given endpoints which are currently removed from the rotation, and adds back
+// those whose cooldown has elapsed. If all the endpoints are removed, all of them are returned, so the
+// proposer can still try to find a prover.
+func (b *endpointBreaker) available(endpoints []*url.URL) []*url.URL {
+	if b == nil {
+		return endpoints
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var (
+		now       = time.Now()
+		available = make([]*url.URL, 0, len(endpoints))
+	)
+	for _, endpoint := range endpoints {
+		state, ok := b.states[endpoint.String()]
+		if ok && !state.excludedUntil.IsZero() {
+			if now.Before(state.excludedUntil) {
+				continue
+			}
+
+			log.Info("Prover endpoint added back to rotation after cooldown", "endpoint", endpoint)
+			state.excludedUntil = time.Time{}
+			state.consecutiveFailures = 0
+		}
+		available = append(available, endpoint)
+	}
+
+	if len(available) == 0 {
+		log.Warn("All prover endpoints are removed from rotation, trying all of them", "count", len(endpoints))
+		return endpoints
+	}
+
+	return available
+}
+
+// recordFailure records a failed request to the given endpoint, and removes the endpoint from the
+// rotation once it has failed consecutively for the threshold times.
+func (b *endpointBreaker) recordFailure(endpoint *url.URL, err error) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	state, ok := b.states[endpoint.String()]
+	if !ok {
+		state = &endpointState{}
+		b.states[endpoint.String()] = state
+	}
+
+	if state.consecutiveFailures++; state.consecutiveFailures >= b.threshold && state.excludedUntil.IsZero() {
+		state.excludedUntil = time.Now().Add(b.cooldown)
+		log.Warn(
+			"Prover endpoint removed from rotation",
+			"endpoint", endpoint,
+			"consecutiveFailures", state.consecutiveFailures,
+			"cooldown", b.cooldown,
+			"lastError", err,
+		)
+	}
+}
+
+// recordSuccess resets the consecutive failures of the given endpoint.
+func (b *endpointBreaker) recordSuccess(endpoint *url.URL) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.states, endpoint.String())
+}
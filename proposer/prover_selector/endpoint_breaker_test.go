@@ -0,0 +1,89 @@
+package selector
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointBreaker(t *testing.T) {
+	var (
+		a, _      = url.Parse("http://a:9876")
+		b, _      = url.Parse("http://b:9876")
+		endpoints = []*url.URL{a, b}
+		errFailed = errors.New("failed")
+	)
+
+	// Disabled with a zero threshold.
+	disabled := newEndpointBreaker(0, time.Minute)
+	require.Nil(t, disabled)
+	disabled.recordFailure(a, errFailed)
+	require.Equal(t, endpoints, disabled.available(endpoints))
+
+	breaker := newEndpointBreaker(2, 50*time.Millisecond)
+
+	// Removed after the consecutive failures only.
+	breaker.recordFailure(a, errFailed)
+	breaker.recordSuccess(a)
+	breaker.recordFailure(a, errFailed)
+	require.Equal(t, endpoints, breaker.available(endpoints))
+	breaker.recordFailure(a, errFailed)
+	require.Equal(t, []*url.URL{b}, breaker.available(endpoints))
+
+	// All the endpoints are tried once all of them are removed.
+	breaker.recordFailure(b, errFailed)
+	breaker.recordFailure(b, errFailed)
+	require.Equal(t, endpoints, breaker.available(endpoints))
+
+	// Added back after the cooldown.
+	time.Sleep(60 * time.Millisecond)
+	require.Equal(t, endpoints, breaker.available(endpoints))
+	breaker.recordFailure(a, errFailed)
+	require.Equal(t, endpoints, breaker.available(endpoints))
+}
+
+func TestAssignProverUnreachable(t *testing.T) {
+	assign := func(endpoint string, timeout time.Duration) error {
+		u, err := url.Parse(endpoint)
+		require.Nil(t, err)
+		_, _, err = assignProver(
+			context.Background(),
+			1,
+			u,
+			uint64(time.Now().Add(time.Minute).Unix()),
+			nil,
+			common.Address{},
+			common.Address{},
+			common.Hash{},
+			timeout,
+		)
+		return err
+	}
+
+	// A rejection is not a transport error.
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	}))
+	defer rejecting.Close()
+	err := assign(rejecting.URL, time.Second)
+	require.NotNil(t, err)
+	require.NotErrorIs(t, err, errProverUnreachable)
+
+	// Timeouts and connection errors are transport errors.
+	block := make(chan struct{})
+	hanging := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) { <-block }))
+	require.ErrorIs(t, assign(hanging.URL, 10*time.Millisecond), errProverUnreachable)
+	close(block)
+	hanging.Close()
+
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	require.ErrorIs(t, assign(closed.URL, time.Second), errProverUnreachable)
+}
@@ -25,6 +25,7 @@ var (
 	httpsScheme             = "https"
 	errEmptyProverEndpoints = errors.New("empty prover endpoints")
 	errUnableToFindProver   = errors.New("unable to find prover")
+	errProverUnreachable    = errors.New("prover endpoint unreachable")
 )
 
 // ETHFeeEOASelector is a prover selector implementation which use ETHs as prover fee and
@@ -35,9 +36,12 @@ type ETHFeeEOASelector struct {
 	taikoL1Address                common.Address
 	assignmentHookAddress         common.Address
 	tiersFee                      []encoding.TierFee
+	tierFeeFloors                 map[uint16]*big.Int
 	tierFeePriceBump              *big.Int
 	proverEndpoints               []*url.URL
+	endpointBreaker               *endpointBreaker
 	maxTierFeePriceBumpIterations uint64
+	adaptiveTierFee               *adaptiveTierFee
 	proposalExpiry                time.Duration
 	requestTimeout                time.Duration
 }
@@ -49,9 +53,13 @@ func NewETHFeeEOASelector(
 	taikoL1Address common.Address,
 	assignmentHookAddress common.Address,
 	tiersFee []encoding.TierFee,
+	tierFeeFloors map[uint16]*big.Int,
 	tierFeePriceBump *big.Int,
 	proverEndpoints []*url.URL,
+	endpointFailureThreshold uint64,
+	endpointCooldown time.Duration,
 	maxTierFeePriceBumpIterations uint64,
+	adaptiveTierFeeEnabled bool,
 	proposalExpiry time.Duration,
 	requestTimeout time.Duration,
 ) (*ETHFeeEOASelector, error) {
@@ -71,9 +79,12 @@ func NewETHFeeEOASelector(
 		taikoL1Address,
 		assignmentHookAddress,
 		tiersFee,
+		tierFeeFloors,
 		tierFeePriceBump,
 		proverEndpoints,
+		newEndpointBreaker(endpointFailureThreshold, endpointCooldown),
 		maxTierFeePriceBumpIterations,
+		newAdaptiveTierFee(adaptiveTierFeeEnabled, tierFeePriceBump),
 		proposalExpiry,
 		requestTimeout,
 	}, nil
@@ -88,6 +99,9 @@ func (s *ETHFeeEOASelector) AssignProver(
 	tierFees []encoding.TierFee,
 	txListHash common.Hash,
 ) (*encoding.ProverAssignment, common.Address, *big.Int, error) {
+	// Start from the adaptive tier fees, and never offer less than the configured floor of each tier.
+	tierFees = clampTierFees(s.adaptiveTierFee.apply(tierFees), s.tierFeeFloors)
+
 	var (
 		expiry       = uint64(time.Now().Add(s.proposalExpiry).Unix())
 		fees         = make([]encoding.TierFee, len(tierFees))
@@ -112,7 +126,7 @@ func (s *ETHFeeEOASelector) AssignProver(
 			}
 		}
 
-		for _, endpoint := range s.shuffleProverEndpoints() {
+		for _, endpoint := range s.endpointBreaker.available(s.shuffleProverEndpoints()) {
 			encodedAssignment, proverAddress, err := assignProver(
 				ctx,
 				s.protocolConfigs.ChainId,
@@ -124,6 +138,13 @@ func (s *ETHFeeEOASelector) AssignProver(
 				txListHash,
 				s.requestTimeout,
 			)
+			// Only the transport errors and timeouts count as the endpoint failures, a prover which rejects
+			// the offered fees is still alive.
+			if errors.Is(err, errProverUnreachable) {
+				s.endpointBreaker.recordFailure(endpoint, err)
+			} else {
+				s.endpointBreaker.recordSuccess(endpoint)
+			}
 			if err != nil {
 				log.Warn("Failed to assign prover", "endpoint", endpoint, "error", err)
 				continue
@@ -144,10 +165,12 @@ func (s *ETHFeeEOASelector) AssignProver(
 				continue
 			}
 
+			s.adaptiveTierFee.record(uint64(i), i > 0 && uint64(i+1) >= s.maxTierFeePriceBumpIterations)
 			return encodedAssignment, proverAddress, maxProverFee, nil
 		}
 	}
 
+	s.adaptiveTierFee.record(s.maxTierFeePriceBumpIterations, true)
 	return nil, common.Address{}, nil, errUnableToFindProver
 }
 
@@ -209,7 +232,7 @@ func assignProver(
 		SetResult(&result).
 		Post(requestURL)
 	if err != nil {
-		return nil, common.Address{}, err
+		return nil, common.Address{}, fmt.Errorf("%w: %w", errProverUnreachable, err)
 	}
 	if !resp.IsSuccess() {
 		return nil, common.Address{}, fmt.Errorf("unsuccessful response %d", resp.StatusCode())
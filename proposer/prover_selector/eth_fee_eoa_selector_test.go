@@ -37,9 +37,13 @@ func (s *ProverSelectorTestSuite) SetupTest() {
 		common.HexToAddress(os.Getenv("TAIKO_L1_ADDRESS")),
 		common.HexToAddress(os.Getenv("ASSIGNMENT_HOOK_ADDRESS")),
 		[]encoding.TierFee{},
+		nil,
 		common.Big2,
 		[]*url.URL{s.ProverEndpoints[0]},
+		0,
+		0,
 		32,
+		false,
 		1*time.Minute,
 		1*time.Minute,
 	)
@@ -0,0 +1,28 @@
+package selector
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+// clampTierFees returns a copy of the given tier fees, with every fee below its tier's floor raised to
+// the floor. Tiers without a floor are kept as they are.
+func clampTierFees(tierFees []encoding.TierFee, floors map[uint16]*big.Int) []encoding.TierFee {
+	clamped := make([]encoding.TierFee, len(tierFees))
+	copy(clamped, tierFees)
+
+	for idx, tierFee := range clamped {
+		floor, ok := floors[tierFee.Tier]
+		if !ok || floor == nil || tierFee.Fee.Cmp(floor) >= 0 {
+			continue
+		}
+
+		log.Info("Tier fee clamped to floor", "tier", tierFee.Tier, "fee", tierFee.Fee, "floor", floor)
+		clamped[idx].Fee = new(big.Int).Set(floor)
+	}
+
+	return clamped
+}
@@ -0,0 +1,34 @@
+package selector
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+func TestClampTierFees(t *testing.T) {
+	tierFees := []encoding.TierFee{
+		{Tier: encoding.TierOptimisticID, Fee: big.NewInt(100)},
+		{Tier: encoding.TierSgxID, Fee: big.NewInt(100)},
+		{Tier: encoding.TierGuardianID, Fee: big.NewInt(0)},
+	}
+
+	clamped := clampTierFees(tierFees, map[uint16]*big.Int{
+		encoding.TierOptimisticID: big.NewInt(50),
+		encoding.TierSgxID:        big.NewInt(200),
+	})
+
+	// Only the fees below their floors are raised.
+	require.Equal(t, big.NewInt(100), clamped[0].Fee)
+	require.Equal(t, big.NewInt(200), clamped[1].Fee)
+	require.Equal(t, big.NewInt(0), clamped[2].Fee)
+
+	// The given fees are never modified.
+	require.Equal(t, big.NewInt(100), tierFees[1].Fee)
+
+	// Kept as they are without floors.
+	require.Equal(t, tierFees, clampTierFees(tierFees, nil))
+}
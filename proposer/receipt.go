@@ -0,0 +1,33 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/taikoxyz/taiko-client/pkg/sender"
+)
+
+// waitTxConfirmations waits for the confirmations of all the transactions sent by the proposer, for at most
+// the configured receipt timeout in total, the senders poll the receipts at the configured interval. It
+// returns the transaction which failed, or was still waited for when the timeout elapsed or the given
+// context was cancelled.
+func (p *Proposer) waitTxConfirmations(ctx context.Context) (*sender.TxToConfirm, error) {
+	if p.WaitReceiptTimeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.WaitReceiptTimeout)
+		defer cancel()
+	}
+
+	for id, confirmCh := range p.txToConfirmChannels() {
+		select {
+		case <-ctx.Done():
+			return &sender.TxToConfirm{ID: id}, fmt.Errorf("failed to wait for transaction confirmation: %w", ctx.Err())
+		case confirm := <-confirmCh:
+			if confirm.Err != nil {
+				return confirm, confirm.Err
+			}
+		}
+	}
+
+	return nil, nil
+}
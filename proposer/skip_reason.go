@@ -0,0 +1,56 @@
+package proposer
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+)
+
+// SkipReason describes why a proposing epoch didn't propose any transactions.
+type SkipReason string
+
+// Skip reasons.
+const (
+	SkipReasonNoNewTxs         SkipReason = "noNewTxs"
+	SkipReasonNoLocalTxs       SkipReason = "noLocalTxs"
+	SkipReasonInsufficientBond SkipReason = "insufficientBond"
+	SkipReasonHighL1BaseFee    SkipReason = "highL1BaseFee"
+	SkipReasonProposalGap      SkipReason = "proposalGap"
+	SkipReasonTxListTooSmall   SkipReason = "txListTooSmall"
+)
+
+var (
+	errNoLocalTxs = errors.New("no new transactions from local addresses")
+	// skipReasons maps the errors returned by the proposer guards to their skip reasons.
+	skipReasons = []struct {
+		err    error
+		reason SkipReason
+	}{
+		{errNoNewTxs, SkipReasonNoNewTxs},
+		{errNoLocalTxs, SkipReasonNoLocalTxs},
+		{errInsufficientProposerBond, SkipReasonInsufficientBond},
+		{errL1BaseFeeTooHigh, SkipReasonHighL1BaseFee},
+		{errProposalGapNotElapsed, SkipReasonProposalGap},
+		{errTxListTooSmall, SkipReasonTxListTooSmall},
+	}
+)
+
+// skipReasonOf returns the skip reason of the given proposing error, reports false if the
+// error is not caused by a proposer guard.
+func skipReasonOf(err error) (SkipReason, bool) {
+	for _, r := range skipReasons {
+		if errors.Is(err, r.err) {
+			return r.reason, true
+		}
+	}
+
+	return "", false
+}
+
+// recordSkip records a skipped proposing epoch with the given reason.
+func recordSkip(reason SkipReason) {
+	log.Info("Proposing skipped", "reason", reason)
+	metrics.ProposerSkippedCounter(string(reason)).Inc(1)
+}
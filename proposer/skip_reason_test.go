@@ -0,0 +1,41 @@
+package proposer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	gethMetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+)
+
+func TestSkipReasons(t *testing.T) {
+	enabled := gethMetrics.Enabled
+	gethMetrics.Enabled = true
+	defer func() { gethMetrics.Enabled = enabled }()
+
+	recorded := make(map[SkipReason]bool)
+	for _, guardErr := range []error{
+		errNoNewTxs,
+		errNoLocalTxs,
+		errInsufficientProposerBond,
+		errL1BaseFeeTooHigh,
+		errProposalGapNotElapsed,
+		errTxListTooSmall,
+	} {
+		reason, skipped := skipReasonOf(fmt.Errorf("propose: %w", guardErr))
+		require.True(t, skipped)
+		require.False(t, recorded[reason], "duplicated skip reason %s", reason)
+		recorded[reason] = true
+
+		before := metrics.ProposerSkippedCounter(string(reason)).Snapshot().Count()
+		recordSkip(reason)
+		require.Equal(t, before+1, metrics.ProposerSkippedCounter(string(reason)).Snapshot().Count())
+	}
+	require.Len(t, recorded, len(skipReasons))
+
+	_, skipped := skipReasonOf(errors.New("failed to fetch transaction pool content"))
+	require.False(t, skipped)
+}
@@ -0,0 +1,52 @@
+package proposer
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+)
+
+// maxSoftPauseLevel is the maximum number of times the proposing interval is doubled during a soft-pause.
+const maxSoftPauseLevel = 8
+
+// updateSoftPause updates the soft-pause state by the given L1 base fee. While the base fee exceeds the
+// configured threshold, the proposing interval is doubled on each epoch, and it's restored once the base
+// fee subsides.
+func (p *Proposer) updateSoftPause(baseFee *big.Int) {
+	if p.SoftPauseBaseFee == nil || baseFee == nil {
+		return
+	}
+
+	if baseFee.Cmp(p.SoftPauseBaseFee) <= 0 {
+		if p.softPauseLevel != 0 {
+			log.Info("L1 base fee subsided, soft-pause lifted", "baseFee", baseFee, "threshold", p.SoftPauseBaseFee)
+		}
+		p.softPauseLevel = 0
+		metrics.ProposerSoftPausedGauge.Update(0)
+		return
+	}
+
+	p.softPauseLevel = min(p.softPauseLevel+1, maxSoftPauseLevel)
+	log.Warn(
+		"L1 congested, soft-pausing proposer",
+		"baseFee", baseFee,
+		"threshold", p.SoftPauseBaseFee,
+		"level", p.softPauseLevel,
+	)
+	metrics.ProposerSoftPausedGauge.Update(1)
+}
+
+// softPauseInterval returns the effective proposing interval of the given interval under the current
+// soft-pause state, which is never longer than the configured maximum interval, unless the given
+// interval itself is.
+func (p *Proposer) softPauseInterval(interval time.Duration) time.Duration {
+	effective := interval << p.softPauseLevel
+	if p.softPauseLevel != 0 && p.SoftPauseMaxInterval != 0 && effective > p.SoftPauseMaxInterval {
+		effective = max(interval, p.SoftPauseMaxInterval)
+	}
+
+	return effective
+}
@@ -0,0 +1,45 @@
+package proposer
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSoftPause(t *testing.T) {
+	var (
+		low  = big.NewInt(10_000_000_000)
+		high = big.NewInt(200_000_000_000)
+		p    = &Proposer{Config: &Config{SoftPauseMaxInterval: time.Minute}}
+	)
+
+	// Disabled without a threshold.
+	p.updateSoftPause(high)
+	require.Equal(t, 12*time.Second, p.softPauseInterval(12*time.Second))
+
+	p.SoftPauseBaseFee = big.NewInt(100_000_000_000)
+
+	// Doubled on each congested epoch, up to the maximum interval.
+	p.updateSoftPause(high)
+	require.Equal(t, 24*time.Second, p.softPauseInterval(12*time.Second))
+	p.updateSoftPause(high)
+	require.Equal(t, 48*time.Second, p.softPauseInterval(12*time.Second))
+	p.updateSoftPause(high)
+	require.Equal(t, time.Minute, p.softPauseInterval(12*time.Second))
+
+	// Never shorter than the configured interval.
+	require.Equal(t, 2*time.Minute, p.softPauseInterval(2*time.Minute))
+
+	// Restored once the base fee subsides.
+	p.updateSoftPause(low)
+	require.Equal(t, 12*time.Second, p.softPauseInterval(12*time.Second))
+
+	// Bounded without a maximum interval.
+	p.SoftPauseMaxInterval = 0
+	for i := 0; i < 100; i++ {
+		p.updateSoftPause(high)
+	}
+	require.Equal(t, 12*time.Second<<maxSoftPauseLevel, p.softPauseInterval(12*time.Second))
+}
@@ -0,0 +1,133 @@
+package builder
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// ErrBlobUnavailable is returned when the blobs of a proposing transaction won't be accepted into
+// the L1 node's blob pool, so they won't be retrievable by the drivers later.
+var ErrBlobUnavailable = errors.New("blob unavailable")
+
+// BlobAvailabilityChecker checks whether the blobs of the given proposing transaction will be accepted
+// into the L1 node's blob pool.
+type BlobAvailabilityChecker func(ctx context.Context, tx *types.Transaction) error
+
+// NewBlobPoolChecker creates a new BlobAvailabilityChecker, which checks the given blob transaction
+// against the L1 node's blob pool rules, i.e. the node supports blobs, the blob sidecar matches the
+// blob hashes with valid KZG proofs, and the blob fee cap covers the node's current blob base fee.
+func NewBlobPoolChecker(rpc *rpc.Client) BlobAvailabilityChecker {
+	return func(ctx context.Context, tx *types.Transaction) error {
+		header, err := rpc.L1.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		var blobBaseFee *big.Int
+		if header.ExcessBlobGas != nil {
+			blobBaseFee = eip4844.CalcBlobFee(*header.ExcessBlobGas)
+		}
+
+		return checkBlobTx(tx, blobBaseFee)
+	}
+}
+
+// checkBlobTx checks the given blob transaction against the blob pool rules, a nil blob base fee means
+// the L1 node doesn't support blobs.
+func checkBlobTx(tx *types.Transaction, blobBaseFee *big.Int) error {
+	if blobBaseFee == nil {
+		return fmt.Errorf("%w: L1 node doesn't support blobs", ErrBlobUnavailable)
+	}
+
+	sidecar := tx.BlobTxSidecar()
+	if sidecar == nil {
+		return fmt.Errorf("%w: blob sidecar not found", ErrBlobUnavailable)
+	}
+
+	blobHashes := tx.BlobHashes()
+	if len(sidecar.Blobs) != len(blobHashes) ||
+		len(sidecar.Commitments) != len(blobHashes) ||
+		len(sidecar.Proofs) != len(blobHashes) {
+		return fmt.Errorf(
+			"%w: %d blob hashes, %d blobs, %d commitments, %d proofs",
+			ErrBlobUnavailable,
+			len(blobHashes),
+			len(sidecar.Blobs),
+			len(sidecar.Commitments),
+			len(sidecar.Proofs),
+		)
+	}
+
+	for i, blobHash := range blobHashes {
+		if kzg4844.CalcBlobHashV1(sha256.New(), &sidecar.Commitments[i]) != blobHash {
+			return fmt.Errorf("%w: blob %d commitment doesn't match blob hash %s", ErrBlobUnavailable, i, blobHash)
+		}
+		if err := kzg4844.VerifyBlobProof(sidecar.Blobs[i], sidecar.Commitments[i], sidecar.Proofs[i]); err != nil {
+			return fmt.Errorf("%w: blob %d invalid KZG proof: %w", ErrBlobUnavailable, i, err)
+		}
+	}
+
+	if tx.BlobGasFeeCap().Cmp(blobBaseFee) < 0 {
+		return fmt.Errorf(
+			"%w: blob fee cap %s less than blob base fee %s",
+			ErrBlobUnavailable,
+			tx.BlobGasFeeCap(),
+			blobBaseFee,
+		)
+	}
+
+	return nil
+}
+
+// BlobFallbackTransactionBuilder is responsible for building a TaikoL1.proposeBlock transaction with
+// the given builder, and falling back to calldata if the built blob transaction's blobs are unavailable.
+type BlobFallbackTransactionBuilder struct {
+	txBuilder         ProposeBlockTransactionBuilder
+	calldataTxBuilder ProposeBlockTransactionBuilder
+	checker           BlobAvailabilityChecker
+}
+
+// NewBlobFallbackTransactionBuilder creates a new BlobFallbackTransactionBuilder instance based on giving
+// builders and blob availability checker.
+func NewBlobFallbackTransactionBuilder(
+	txBuilder ProposeBlockTransactionBuilder,
+	calldataTxBuilder ProposeBlockTransactionBuilder,
+	checker BlobAvailabilityChecker,
+) *BlobFallbackTransactionBuilder {
+	return &BlobFallbackTransactionBuilder{txBuilder, calldataTxBuilder, checker}
+}
+
+// Build implements the ProposeBlockTransactionBuilder interface.
+func (b *BlobFallbackTransactionBuilder) Build(
+	ctx context.Context,
+	tierFees []encoding.TierFee,
+	opts *bind.TransactOpts,
+	includeParentMetaHash bool,
+	txListBytes []byte,
+) (*types.Transaction, error) {
+	tx, err := b.txBuilder.Build(ctx, tierFees, opts, includeParentMetaHash, txListBytes)
+	if err != nil || tx.Type() != types.BlobTxType {
+		return tx, err
+	}
+
+	if err := b.checker(ctx, tx); err != nil {
+		log.Warn("Blob unavailable, fall back to calldata", "txListBytes", len(txListBytes), "error", err)
+		metrics.ProposerBlobFallbackCounter.Inc(1)
+		return b.calldataTxBuilder.Build(ctx, tierFees, opts, includeParentMetaHash, txListBytes)
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,100 @@
+package builder
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/internal/testutils"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// staticTxBuilder is a ProposeBlockTransactionBuilder which always builds the given transaction.
+type staticTxBuilder struct {
+	tx     *types.Transaction
+	builds int
+}
+
+func (b *staticTxBuilder) Build(
+	_ context.Context,
+	_ []encoding.TierFee,
+	_ *bind.TransactOpts,
+	_ bool,
+	_ []byte,
+) (*types.Transaction, error) {
+	b.builds++
+	return b.tx, nil
+}
+
+func newTestBlobTx(t *testing.T, blobFeeCap uint64) *types.Transaction {
+	sidecar, err := rpc.MakeSidecar([]byte("txList"))
+	require.Nil(t, err)
+
+	return types.NewTx(&types.BlobTx{
+		BlobFeeCap: uint256.NewInt(blobFeeCap),
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	})
+}
+
+func TestCheckBlobTx(t *testing.T) {
+	tx := newTestBlobTx(t, 10)
+	require.Nil(t, checkBlobTx(tx, big.NewInt(10)))
+
+	// The L1 node doesn't support blobs.
+	require.ErrorIs(t, checkBlobTx(tx, nil), ErrBlobUnavailable)
+
+	// The blob fee cap doesn't cover the blob base fee.
+	require.ErrorIs(t, checkBlobTx(tx, big.NewInt(11)), ErrBlobUnavailable)
+
+	// No blob sidecar.
+	require.ErrorIs(t, checkBlobTx(tx.WithoutBlobTxSidecar(), big.NewInt(10)), ErrBlobUnavailable)
+
+	// The sidecar doesn't match the blob hashes.
+	mismatched := types.NewTx(&types.BlobTx{
+		BlobFeeCap: uint256.NewInt(10),
+		BlobHashes: []common.Hash{testutils.RandomHash()},
+		Sidecar:    tx.BlobTxSidecar(),
+	})
+	require.ErrorIs(t, checkBlobTx(mismatched, big.NewInt(10)), ErrBlobUnavailable)
+}
+
+func TestBlobFallbackTransactionBuilder(t *testing.T) {
+	var (
+		blobTx            = newTestBlobTx(t, 10)
+		calldataTx        = types.NewTx(&types.DynamicFeeTx{Data: []byte("txList")})
+		blobTxBuilder     = &staticTxBuilder{tx: blobTx}
+		calldataTxBuilder = &staticTxBuilder{tx: calldataTx}
+		checkErr          error
+		checker           = func(context.Context, *types.Transaction) error { return checkErr }
+		txBuilder         = NewBlobFallbackTransactionBuilder(blobTxBuilder, calldataTxBuilder, checker)
+	)
+
+	// The blob pool accepts the blob.
+	tx, err := txBuilder.Build(context.Background(), nil, nil, false, []byte("txList"))
+	require.Nil(t, err)
+	require.Equal(t, blobTx, tx)
+	require.Zero(t, calldataTxBuilder.builds)
+
+	// The blob pool rejects the blob, fall back to calldata.
+	checkErr = errors.New("blob pool full")
+	tx, err = txBuilder.Build(context.Background(), nil, nil, false, []byte("txList"))
+	require.Nil(t, err)
+	require.Equal(t, calldataTx, tx)
+	require.Equal(t, 1, calldataTxBuilder.builds)
+
+	// Calldata transactions are not checked.
+	blobTxBuilder.tx = calldataTx
+	tx, err = txBuilder.Build(context.Background(), nil, nil, false, []byte("txList"))
+	require.Nil(t, err)
+	require.Equal(t, calldataTx, tx)
+	require.Equal(t, 1, calldataTxBuilder.builds)
+}
@@ -0,0 +1,106 @@
+package builder
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// ProposeMode decides how the txList bytes are saved when proposing a block.
+type ProposeMode string
+
+// Propose modes.
+const (
+	ProposeModeCalldata ProposeMode = "calldata"
+	ProposeModeBlob     ProposeMode = "blob"
+	// ProposeModeEconomic picks the cheaper one of calldata and blob for each proposal.
+	ProposeModeEconomic ProposeMode = "economic"
+)
+
+// EconomicTransactionBuilder is responsible for building a TaikoL1.proposeBlock transaction with txList
+// bytes saved in either calldata or blob, whichever is cheaper under the current L1 fee conditions.
+type EconomicTransactionBuilder struct {
+	rpc               *rpc.Client
+	calldataTxBuilder *CalldataTransactionBuilder
+	blobTxBuilder     *BlobTransactionBuilder
+}
+
+// NewEconomicTransactionBuilder creates a new EconomicTransactionBuilder instance based on giving builders.
+func NewEconomicTransactionBuilder(
+	rpc *rpc.Client,
+	calldataTxBuilder *CalldataTransactionBuilder,
+	blobTxBuilder *BlobTransactionBuilder,
+) *EconomicTransactionBuilder {
+	return &EconomicTransactionBuilder{rpc, calldataTxBuilder, blobTxBuilder}
+}
+
+// Build implements the ProposeBlockTransactionBuilder interface.
+func (b *EconomicTransactionBuilder) Build(
+	ctx context.Context,
+	tierFees []encoding.TierFee,
+	opts *bind.TransactOpts,
+	includeParentMetaHash bool,
+	txListBytes []byte,
+) (*types.Transaction, error) {
+	header, err := b.rpc.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var blobBaseFee *big.Int
+	if header.ExcessBlobGas != nil {
+		blobBaseFee = eip4844.CalcBlobFee(*header.ExcessBlobGas)
+	}
+
+	useBlob, calldataCost, blobCost := selectBlob(txListBytes, header.BaseFee, blobBaseFee)
+
+	log.Info(
+		"Propose mode selected",
+		"blob", useBlob,
+		"calldataCost", calldataCost,
+		"blobCost", blobCost,
+		"baseFee", header.BaseFee,
+		"blobBaseFee", blobBaseFee,
+		"txListBytes", len(txListBytes),
+	)
+
+	if useBlob {
+		metrics.ProposerEconomicBlobCounter.Inc(1)
+		return b.blobTxBuilder.Build(ctx, tierFees, opts, includeParentMetaHash, txListBytes)
+	}
+
+	metrics.ProposerEconomicCalldataCounter.Inc(1)
+	return b.calldataTxBuilder.Build(ctx, tierFees, opts, includeParentMetaHash, txListBytes)
+}
+
+// selectBlob compares the costs of saving the given txList bytes in calldata and in a blob, and
+// reports whether the blob is cheaper. A nil blob base fee means blobs are not available, in that case
+// calldata will always be selected, so does a txList which doesn't fit in a single blob.
+func selectBlob(txListBytes []byte, baseFee *big.Int, blobBaseFee *big.Int) (bool, *big.Int, *big.Int) {
+	var calldataGas uint64
+	for _, b := range txListBytes {
+		if b == 0 {
+			calldataGas += params.TxDataZeroGas
+		} else {
+			calldataGas += params.TxDataNonZeroGasEIP2028
+		}
+	}
+	calldataCost := new(big.Int).Mul(new(big.Int).SetUint64(calldataGas), baseFee)
+
+	if blobBaseFee == nil || len(txListBytes) > rpc.MaxBlobDataSize {
+		return false, calldataCost, nil
+	}
+
+	blobCost := new(big.Int).Mul(big.NewInt(params.BlobTxBlobGasPerBlob), blobBaseFee)
+
+	return blobCost.Cmp(calldataCost) < 0, calldataCost, blobCost
+}
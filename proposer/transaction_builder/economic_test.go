@@ -0,0 +1,129 @@
+package builder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/blob"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	txlistdecoder "github.com/taikoxyz/taiko-client/driver/txlist_fetcher"
+	"github.com/taikoxyz/taiko-client/internal/testutils"
+	"github.com/taikoxyz/taiko-client/internal/utils"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+func TestSelectBlob(t *testing.T) {
+	var (
+		txListBytes = bytes.Repeat([]byte{1}, 10_000)
+		baseFee     = big.NewInt(10)
+	)
+
+	// Cheap blob gas, blob should be selected.
+	useBlob, calldataCost, blobCost := selectBlob(txListBytes, baseFee, big.NewInt(1))
+	require.True(t, useBlob)
+	require.Equal(t, big.NewInt(int64(10_000*params.TxDataNonZeroGasEIP2028*10)), calldataCost)
+	require.Equal(t, big.NewInt(params.BlobTxBlobGasPerBlob), blobCost)
+
+	// Blob base fee spikes, calldata should be selected.
+	useBlob, _, _ = selectBlob(txListBytes, baseFee, big.NewInt(100))
+	require.False(t, useBlob)
+
+	// Small txList, calldata is cheaper even with the cheap blob gas.
+	useBlob, calldataCost, _ = selectBlob([]byte{0, 1}, baseFee, big.NewInt(1))
+	require.False(t, useBlob)
+	require.Equal(t, big.NewInt(int64((params.TxDataZeroGas+params.TxDataNonZeroGasEIP2028)*10)), calldataCost)
+
+	// Blobs are not available.
+	useBlob, _, blobCost = selectBlob(txListBytes, baseFee, nil)
+	require.False(t, useBlob)
+	require.Nil(t, blobCost)
+}
+
+func TestSelectBlobTooLarge(t *testing.T) {
+	// A txList which doesn't fit in a single blob is always saved in calldata.
+	useBlob, _, blobCost := selectBlob(bytes.Repeat([]byte{1}, rpc.MaxBlobDataSize+1), big.NewInt(10), common.Big1)
+	require.False(t, useBlob)
+	require.Nil(t, blobCost)
+}
+
+func TestProposeModeRoundTrip(t *testing.T) {
+	var (
+		raw         = testutils.RandomBytes(10_000)
+		modes       []bool
+		txList, err = utils.Compress(raw)
+	)
+	require.Nil(t, err)
+
+	// Blob is selected under the cheap blob gas, and calldata under the expensive one.
+	for _, blobBaseFee := range []*big.Int{common.Big1, big.NewInt(1_000_000)} {
+		useBlob, _, _ := selectBlob(txList, big.NewInt(10), blobBaseFee)
+		modes = append(modes, useBlob)
+
+		var (
+			b    []byte
+			meta = &bindings.TaikoDataBlockMetadata{BlobUsed: useBlob}
+		)
+		if useBlob {
+			b, err = fetchBlobTxList(t, txList, meta)
+		} else {
+			b, err = fetchCalldataTxList(t, txList, meta)
+		}
+		require.Nil(t, err)
+
+		// The driver reads back exactly what the proposer saved.
+		decompressed, err := utils.Decompress(b)
+		require.Nil(t, err)
+		require.Equal(t, raw, decompressed)
+	}
+	require.Equal(t, []bool{true, false}, modes)
+}
+
+// fetchCalldataTxList saves the given txList in a proposeBlock transaction's calldata, and fetches it
+// back by the driver's calldata fetcher.
+func fetchCalldataTxList(t *testing.T, txList []byte, meta *bindings.TaikoDataBlockMetadata) ([]byte, error) {
+	data, err := encoding.TaikoL1ABI.Pack("proposeBlock", []byte{}, txList)
+	require.Nil(t, err)
+
+	tx := types.NewTx(&types.DynamicFeeTx{Data: data})
+	return new(txlistdecoder.CalldataFetcher).Fetch(context.Background(), tx, meta)
+}
+
+// fetchBlobTxList saves the given txList in a blob, and fetches it back by the driver's blob fetcher from a
+// fake beacon node serving the blob's sidecar.
+func fetchBlobTxList(t *testing.T, txList []byte, meta *bindings.TaikoDataBlockMetadata) ([]byte, error) {
+	sidecar, err := rpc.MakeSidecar(txList)
+	require.Nil(t, err)
+
+	res := &blob.SidecarsResponse{Data: []*blob.Sidecar{{
+		Blob:          common.Bytes2Hex(sidecar.Blobs[0][:]),
+		KzgCommitment: common.Bytes2Hex(sidecar.Commitments[0][:]),
+	}}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		require.Nil(t, json.NewEncoder(w).Encode(res))
+	}))
+	defer srv.Close()
+
+	beacon, err := rpc.NewBeaconClient(srv.URL, time.Second)
+	require.Nil(t, err)
+
+	meta.BlobHash = sidecar.BlobHashes()[0]
+	meta.Timestamp = uint64(time.Now().Add(-time.Hour).Unix())
+
+	return txlistdecoder.NewBlobTxListFetcher(&rpc.Client{L1Beacon: beacon}).Fetch(
+		context.Background(),
+		types.NewTx(&types.BlobTx{BlobHashes: sidecar.BlobHashes()}),
+		meta,
+	)
+}
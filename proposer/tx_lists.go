@@ -0,0 +1,147 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// errTxListsAssemblyDeadline is returned if the assembly deadline is hit before any transactions list is
+// assembled, it's not a skip reason, since the transaction pool content is unknown rather than empty.
+var errTxListsAssemblyDeadline = errors.New("transactions lists assembly deadline reached before any list assembled")
+
+// txListsSource returns the next transactions list to propose, or nil if there is no more list.
+type txListsSource func(ctx context.Context) (types.Transactions, error)
+
+// poolContentSource returns a txListsSource based on the L2 execution engine's transaction pool content,
+// if --txpool.localsOnly is set, only the transactions sent from the local addresses will be returned.
+func (p *Proposer) poolContentSource() txListsSource {
+	var (
+		txLists      []types.Transactions
+		fetched      bool
+		poolNonEmpty bool
+		emitted      int
+	)
+
+	return func(ctx context.Context) (types.Transactions, error) {
+		if !fetched {
+			log.Info("Start fetching L2 execution engine's transaction pool content")
+
+			content, err := p.rpc.GetPoolContent(
+				ctx,
+				p.proposerAddress,
+				p.txListGasLimit,
+				rpc.BlockMaxTxListBytes,
+				p.LocalAddresses,
+				p.MaxProposedTxListsPerEpoch,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch transaction pool content: %w", err)
+			}
+			txLists, fetched, poolNonEmpty = content, true, len(content) != 0
+		}
+
+		for len(txLists) != 0 {
+			txs := txLists[0]
+			txLists = txLists[1:]
+
+			if p.LocalAddressesOnly {
+				var err error
+				if txs, err = p.filterLocalTxs(txs); err != nil {
+					return nil, err
+				}
+			}
+
+			if txs.Len() != 0 {
+				emitted++
+				return txs, nil
+			}
+		}
+
+		if p.LocalAddressesOnly && poolNonEmpty && emitted == 0 {
+			return nil, errNoLocalTxs
+		}
+
+		return nil, nil
+	}
+}
+
+// filterLocalTxs returns the transactions sent from the local addresses in the given list.
+func (p *Proposer) filterLocalTxs(txs types.Transactions) (types.Transactions, error) {
+	var (
+		filtered types.Transactions
+		signer   = types.LatestSignerForChainID(p.rpc.L2.ChainID)
+	)
+	for _, tx := range txs {
+		sender, err := types.Sender(signer, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, localAddress := range p.LocalAddresses {
+			if sender == localAddress {
+				filtered = append(filtered, tx)
+			}
+		}
+	}
+
+	return filtered, nil
+}
+
+// assembleTxLists assembles the transactions lists to propose from the given source. If the given
+// deadline is not zero and is hit before the source is drained, the lists assembled so far will
+// be returned, so that building the lists from a large mempool won't make the proposer miss its
+// proposing epoch, errTxListsAssemblyDeadline will be returned if no list is assembled before the deadline.
+func assembleTxLists(
+	ctx context.Context,
+	source txListsSource,
+	deadline time.Duration,
+) ([]types.Transactions, error) {
+	var (
+		assembleCtx = ctx
+		cancel      context.CancelFunc
+		txLists     []types.Transactions
+	)
+	if deadline != 0 {
+		assembleCtx, cancel = context.WithTimeout(ctx, deadline)
+		defer cancel()
+	}
+
+	// The source may not respect the context while assembling, so also check the deadline
+	// before fetching the next list.
+	for assembleCtx.Err() == nil {
+		txs, err := source(assembleCtx)
+		if err != nil {
+			if assembleCtx.Err() != nil {
+				break
+			}
+			return nil, err
+		}
+		if txs == nil {
+			return txLists, nil
+		}
+
+		txLists = append(txLists, txs)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(txLists) == 0 {
+		return nil, fmt.Errorf("%w: %s", errTxListsAssemblyDeadline, deadline)
+	}
+
+	log.Warn(
+		"Transactions lists assembly deadline reached, proposing the assembled lists",
+		"deadline", deadline,
+		"lists", len(txLists),
+	)
+
+	return txLists, nil
+}
@@ -0,0 +1,62 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// slowTxListsSource returns a txListsSource which returns the given number of single transaction
+// lists, taking the given delay for each list.
+func slowTxListsSource(lists int, delay time.Duration) txListsSource {
+	return func(ctx context.Context) (types.Transactions, error) {
+		if lists == 0 {
+			return nil, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+		lists--
+
+		return types.Transactions{types.NewTx(&types.LegacyTx{Nonce: uint64(lists)})}, nil
+	}
+}
+
+func TestAssembleTxLists(t *testing.T) {
+	// No deadline, all lists should be assembled.
+	txLists, err := assembleTxLists(context.Background(), slowTxListsSource(3, 10*time.Millisecond), 0)
+	require.Nil(t, err)
+	require.Len(t, txLists, 3)
+
+	// The deadline is hit, the assembled lists should be returned.
+	start := time.Now()
+	txLists, err = assembleTxLists(context.Background(), slowTxListsSource(10, 100*time.Millisecond), 250*time.Millisecond)
+	require.Nil(t, err)
+	require.Len(t, txLists, 2)
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+
+	// The deadline is hit before any list is assembled, which is not a skip reason.
+	_, err = assembleTxLists(context.Background(), slowTxListsSource(1, time.Second), 50*time.Millisecond)
+	require.ErrorIs(t, err, errTxListsAssemblyDeadline)
+	_, skipped := skipReasonOf(err)
+	require.False(t, skipped)
+
+	// Source errors should be returned.
+	_, err = assembleTxLists(context.Background(), func(context.Context) (types.Transactions, error) {
+		return nil, errNoLocalTxs
+	}, time.Second)
+	require.ErrorIs(t, err, errNoLocalTxs)
+
+	// The parent context is cancelled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = assembleTxLists(ctx, slowTxListsSource(1, 0), time.Second)
+	require.True(t, errors.Is(err, context.Canceled))
+}
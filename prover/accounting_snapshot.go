@@ -0,0 +1,41 @@
+package prover
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// accountingSnapshotLoop keeps snapshotting the contest accounting state and the fee history to disk, so they
+// can be recovered on the next startup even after a crash, a final snapshot is taken when the prover stops.
+func (p *Prover) accountingSnapshotLoop() {
+	p.wg.Add(1)
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.cfg.AccountingSnapshotInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			p.snapshotAccounting()
+			return
+		case <-ticker.C:
+			p.snapshotAccounting()
+		}
+	}
+}
+
+// snapshotAccounting writes the current contest accounting state and the fee history to the configured files.
+func (p *Prover) snapshotAccounting() {
+	if p.cfg.AccountingSnapshotFile != "" {
+		if err := p.contestTracker.SaveSnapshot(p.cfg.AccountingSnapshotFile); err != nil {
+			log.Warn("Failed to snapshot the accounting state", "path", p.cfg.AccountingSnapshotFile, "error", err)
+		}
+	}
+	if p.cfg.FeeHistoryFile != "" && p.server != nil {
+		if err := p.server.SaveFeeHistory(p.cfg.FeeHistoryFile); err != nil {
+			log.Warn("Failed to persist the fee history", "path", p.cfg.FeeHistoryFile, "error", err)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+var errInvalidRange = errors.New("invalid audit range")
+
+// Result is the audit result of a single L2 block.
+type Result struct {
+	BlockID          uint64      `json:"blockID"`
+	ParentHash       common.Hash `json:"parentHash"`
+	LocalBlockHash   common.Hash `json:"localBlockHash"`
+	LocalStateRoot   common.Hash `json:"localStateRoot"`
+	OnchainBlockHash common.Hash `json:"onchainBlockHash"`
+	OnchainStateRoot common.Hash `json:"onchainStateRoot"`
+	Match            bool        `json:"match"`
+	Error            string      `json:"error,omitempty"`
+}
+
+// BlockFetcher fetches the data needed to audit a L2 block.
+type BlockFetcher interface {
+	// LocalHeader returns the header of the given L2 block from the local L2 execution engine.
+	LocalHeader(ctx context.Context, blockID uint64) (*types.Header, error)
+	// OnchainTransition returns the on-chain transition of the given L2 block and its parent.
+	OnchainTransition(
+		ctx context.Context,
+		blockID uint64,
+		parentHash common.Hash,
+	) (*bindings.TaikoDataTransitionState, error)
+}
+
+// rpcBlockFetcher is the BlockFetcher implementation based on the RPC clients.
+type rpcBlockFetcher struct {
+	rpc *rpc.Client
+}
+
+// LocalHeader implements the BlockFetcher interface.
+func (f *rpcBlockFetcher) LocalHeader(ctx context.Context, blockID uint64) (*types.Header, error) {
+	return f.rpc.L2.HeaderByNumber(ctx, new(big.Int).SetUint64(blockID))
+}
+
+// OnchainTransition implements the BlockFetcher interface.
+func (f *rpcBlockFetcher) OnchainTransition(
+	ctx context.Context,
+	blockID uint64,
+	parentHash common.Hash,
+) (*bindings.TaikoDataTransitionState, error) {
+	transition, err := f.rpc.TaikoL1.GetTransition(&bind.CallOpts{Context: ctx}, blockID, parentHash)
+	if err != nil {
+		return nil, encoding.TryParsingCustomError(err)
+	}
+
+	return &transition, nil
+}
+
+// Auditor compares the on-chain transitions of L2 blocks with the local L2 execution engine's blocks,
+// multiple blocks can be audited in parallel.
+type Auditor struct {
+	fetcher     BlockFetcher
+	concurrency int
+	// Minimum interval between two blocks' fetches, to respect the RPC rate limits, 0 means no limit
+	fetchInterval time.Duration
+}
+
+// New creates a new Auditor instance, which audits at most concurrency blocks in parallel, and at most
+// blocksPerSecond blocks per second (0 means no limit).
+func New(rpc *rpc.Client, concurrency int, blocksPerSecond int) *Auditor {
+	return NewWithFetcher(&rpcBlockFetcher{rpc}, concurrency, blocksPerSecond)
+}
+
+// NewWithFetcher creates a new Auditor instance with the given block fetcher.
+func NewWithFetcher(fetcher BlockFetcher, concurrency int, blocksPerSecond int) *Auditor {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	var fetchInterval time.Duration
+	if blocksPerSecond > 0 {
+		fetchInterval = time.Second / time.Duration(blocksPerSecond)
+	}
+
+	return &Auditor{fetcher: fetcher, concurrency: concurrency, fetchInterval: fetchInterval}
+}
+
+// Audit audits all L2 blocks in the given range (both inclusive), the results are returned in
+// block ID order.
+func (a *Auditor) Audit(ctx context.Context, fromBlockID uint64, toBlockID uint64) ([]*Result, error) {
+	if fromBlockID > toBlockID {
+		return nil, fmt.Errorf("%w: from %d > to %d", errInvalidRange, fromBlockID, toBlockID)
+	}
+
+	var (
+		results = make([]*Result, toBlockID-fromBlockID+1)
+		g, gCtx = errgroup.WithContext(ctx)
+		ticker  *time.Ticker
+	)
+	g.SetLimit(a.concurrency)
+	if a.fetchInterval != 0 {
+		ticker = time.NewTicker(a.fetchInterval)
+		defer ticker.Stop()
+	}
+
+	for i := range results {
+		if ticker != nil {
+			select {
+			case <-gCtx.Done():
+				return nil, g.Wait()
+			case <-ticker.C:
+			}
+		}
+
+		i := i
+		g.Go(func() error {
+			result, err := a.auditBlock(gCtx, fromBlockID+uint64(i))
+			if err != nil {
+				return err
+			}
+			results[i] = result
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// auditBlock audits a single L2 block, only the context errors will be returned, the other errors
+// will be recorded in the result.
+func (a *Auditor) auditBlock(ctx context.Context, blockID uint64) (*Result, error) {
+	result := &Result{BlockID: blockID}
+
+	header, err := a.fetcher.LocalHeader(ctx, blockID)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		result.Error = fmt.Sprintf("failed to fetch local header: %s", err)
+		return result, nil
+	}
+	result.ParentHash = header.ParentHash
+	result.LocalBlockHash = header.Hash()
+	result.LocalStateRoot = header.Root
+
+	transition, err := a.fetcher.OnchainTransition(ctx, blockID, header.ParentHash)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		result.Error = fmt.Sprintf("failed to fetch on-chain transition: %s", err)
+		return result, nil
+	}
+	result.OnchainBlockHash = transition.BlockHash
+	result.OnchainStateRoot = transition.StateRoot
+	result.Match = result.LocalBlockHash == result.OnchainBlockHash && result.LocalStateRoot == result.OnchainStateRoot
+
+	if !result.Match {
+		log.Warn(
+			"Audit mismatch",
+			"blockID", blockID,
+			"localBlockHash", result.LocalBlockHash,
+			"onchainBlockHash", result.OnchainBlockHash,
+			"localStateRoot", result.LocalStateRoot,
+			"onchainStateRoot", result.OnchainStateRoot,
+		)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,127 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+// testBlockFetcher is a BlockFetcher implementation for testing, which records the maximum
+// number of concurrent fetches.
+type testBlockFetcher struct {
+	mismatched  map[uint64]bool
+	missing     map[uint64]bool
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (f *testBlockFetcher) header(blockID uint64) *types.Header {
+	return &types.Header{
+		Number:     new(big.Int).SetUint64(blockID),
+		ParentHash: crypto.Keccak256Hash([]byte("parent"), new(big.Int).SetUint64(blockID).Bytes()),
+		Root:       crypto.Keccak256Hash([]byte("root"), new(big.Int).SetUint64(blockID).Bytes()),
+	}
+}
+
+func (f *testBlockFetcher) LocalHeader(_ context.Context, blockID uint64) (*types.Header, error) {
+	inFlight := f.inFlight.Add(1)
+	defer f.inFlight.Add(-1)
+	for {
+		maxInFlight := f.maxInFlight.Load()
+		if inFlight <= maxInFlight || f.maxInFlight.CompareAndSwap(maxInFlight, inFlight) {
+			break
+		}
+	}
+
+	// Finish the fetches out of order.
+	time.Sleep(time.Duration(10-blockID%10) * time.Millisecond)
+
+	return f.header(blockID), nil
+}
+
+func (f *testBlockFetcher) OnchainTransition(
+	_ context.Context,
+	blockID uint64,
+	parentHash common.Hash,
+) (*bindings.TaikoDataTransitionState, error) {
+	if f.missing[blockID] {
+		return nil, errors.New("L1_TRANSITION_NOT_FOUND")
+	}
+
+	header := f.header(blockID)
+	if parentHash != header.ParentHash {
+		return nil, errors.New("unexpected parent hash")
+	}
+
+	transition := &bindings.TaikoDataTransitionState{BlockHash: header.Hash(), StateRoot: header.Root}
+	if f.mismatched[blockID] {
+		transition.StateRoot = common.Hash{}
+	}
+
+	return transition, nil
+}
+
+func TestAuditConcurrently(t *testing.T) {
+	var (
+		fetcher = &testBlockFetcher{
+			mismatched: map[uint64]bool{5: true, 17: true},
+			missing:    map[uint64]bool{11: true},
+		}
+		auditor = NewWithFetcher(fetcher, 4, 0)
+	)
+
+	results, err := auditor.Audit(context.Background(), 1, 20)
+	require.Nil(t, err)
+	require.Len(t, results, 20)
+	require.LessOrEqual(t, fetcher.maxInFlight.Load(), int32(4))
+	require.Greater(t, fetcher.maxInFlight.Load(), int32(1))
+
+	for i, result := range results {
+		blockID := uint64(i + 1)
+		header := fetcher.header(blockID)
+
+		require.Equal(t, blockID, result.BlockID)
+		require.Equal(t, header.ParentHash, result.ParentHash)
+		require.Equal(t, header.Hash(), result.LocalBlockHash)
+
+		switch {
+		case fetcher.missing[blockID]:
+			require.False(t, result.Match)
+			require.Contains(t, result.Error, "L1_TRANSITION_NOT_FOUND")
+		case fetcher.mismatched[blockID]:
+			require.False(t, result.Match)
+			require.Empty(t, result.Error)
+		default:
+			require.True(t, result.Match)
+			require.Empty(t, result.Error)
+		}
+	}
+}
+
+func TestAuditRateLimit(t *testing.T) {
+	var (
+		fetcher = new(testBlockFetcher)
+		auditor = NewWithFetcher(fetcher, 10, 100)
+	)
+
+	start := time.Now()
+	results, err := auditor.Audit(context.Background(), 1, 10)
+	require.Nil(t, err)
+	require.Len(t, results, 10)
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestAuditInvalidRange(t *testing.T) {
+	_, err := NewWithFetcher(new(testBlockFetcher), 1, 0).Audit(context.Background(), 2, 1)
+	require.ErrorIs(t, err, errInvalidRange)
+}
@@ -0,0 +1,120 @@
+package prover
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/go-resty/resty/v2"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+)
+
+// balanceAlertTimeout is the timeout of a low balance webhook request.
+var balanceAlertTimeout = 10 * time.Second
+
+// BalanceAlert is the payload of the low balance webhook.
+type BalanceAlert struct {
+	Account   common.Address `json:"account"`
+	Balance   *big.Int       `json:"balance"`
+	Threshold *big.Int       `json:"threshold"`
+	GasPrice  *big.Int       `json:"gasPrice"`
+	// The number of the queued and in-flight proof submissions, and their estimated total cost
+	PendingSubmissions int      `json:"pendingSubmissions"`
+	PendingCost        *big.Int `json:"pendingCost"`
+	// The estimated number of the proof submissions the balance can still pay for
+	Runway uint64    `json:"runway"`
+	Time   time.Time `json:"time"`
+}
+
+// balanceAlerter posts a low balance alert to the webhook when the prover's balance drops below the
+// threshold, it alerts only once until the balance is topped up above the threshold again.
+type balanceAlerter struct {
+	webhook   string
+	threshold *big.Int
+	// Whether the balance has been alerted, and whether an alert is being posted
+	alerted atomic.Bool
+	posting atomic.Bool
+}
+
+// newBalanceAlerter creates a new balanceAlerter instance, returns nil if no webhook is given.
+func newBalanceAlerter(webhook string, threshold *big.Int) *balanceAlerter {
+	if webhook == "" {
+		return nil
+	}
+
+	return &balanceAlerter{webhook: webhook, threshold: threshold}
+}
+
+// check posts the given alert to the webhook in background if the balance drops below the threshold,
+// the webhook failures are only logged, so the caller is never blocked.
+func (a *balanceAlerter) check(ctx context.Context, alert *BalanceAlert) {
+	if a == nil {
+		return
+	}
+
+	if alert.Balance.Cmp(a.threshold) >= 0 {
+		a.alerted.Store(false)
+		return
+	}
+
+	if a.alerted.Load() || !a.posting.CompareAndSwap(false, true) {
+		return
+	}
+	alert.Threshold = a.threshold
+
+	go func() {
+		defer a.posting.Store(false)
+
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, balanceAlertTimeout)
+		defer cancel()
+
+		if err := a.post(ctxWithTimeout, alert); err != nil {
+			// Not marked as alerted, so the alert will be posted again in the next check.
+			metrics.ProverBalanceAlertFailedCounter.Inc(1)
+			log.Warn("Failed to post low balance alert", "account", alert.Account, "error", err)
+			return
+		}
+
+		a.alerted.Store(true)
+		log.Info("Low balance alert posted", "account", alert.Account, "balance", alert.Balance)
+	}()
+}
+
+// post sends the given alert to the webhook.
+func (a *balanceAlerter) post(ctx context.Context, alert *BalanceAlert) error {
+	resp, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetBody(alert).
+		Post(a.webhook)
+	if err != nil {
+		return err
+	}
+
+	if !resp.IsSuccess() {
+		return fmt.Errorf("unexpected webhook response status code: %d", resp.StatusCode())
+	}
+
+	return nil
+}
+
+// estimateRunway estimates the number of the proof submissions the given balance can still pay for, with
+// the most expensive proof submission gas estimate.
+func (p *Prover) estimateRunway(balance *big.Int, gasPrice *big.Int) uint64 {
+	gas := defaultSubmissionGasEstimate
+	if p.cfg.ProveBlockGasLimit != nil {
+		gas = *p.cfg.ProveBlockGasLimit
+	}
+
+	cost := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gas))
+	if cost.Sign() == 0 {
+		return 0
+	}
+
+	return new(big.Int).Div(balance, cost).Uint64()
+}
@@ -0,0 +1,108 @@
+package prover
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceAlert(t *testing.T) {
+	alerts := make(chan *BalanceAlert, 1)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		alert := new(BalanceAlert)
+		require.Nil(t, json.NewDecoder(r.Body).Decode(alert))
+		alerts <- alert
+	}))
+	defer webhook.Close()
+
+	var (
+		p         = &Prover{cfg: &Config{}}
+		threshold = big.NewInt(1_000_000)
+		gasPrice  = big.NewInt(1)
+		account   = common.HexToAddress("0x01")
+		alerter   = newBalanceAlerter(webhook.URL, threshold)
+		newAlert  = func(balance *big.Int) *BalanceAlert {
+			return &BalanceAlert{
+				Account:            account,
+				Balance:            balance,
+				GasPrice:           gasPrice,
+				PendingSubmissions: 2,
+				PendingCost:        big.NewInt(600_000),
+				Runway:             p.estimateRunway(balance, gasPrice),
+				Time:               time.Now(),
+			}
+		}
+	)
+
+	// Enough balance, no alert.
+	alerter.check(context.Background(), newAlert(threshold))
+	requireNoAlert(t, alerts)
+
+	// Low balance.
+	balance := big.NewInt(999_999)
+	alerter.check(context.Background(), newAlert(balance))
+
+	select {
+	case alert := <-alerts:
+		require.Equal(t, account, alert.Account)
+		require.Equal(t, balance, alert.Balance)
+		require.Equal(t, threshold, alert.Threshold)
+		require.Equal(t, 2, alert.PendingSubmissions)
+		require.Equal(t, uint64(1), alert.Runway)
+	case <-time.After(5 * time.Second):
+		t.Fatal("low balance alert not posted")
+	}
+	require.Eventually(t, alerter.alerted.Load, 5*time.Second, 10*time.Millisecond)
+
+	// Alerted only once until topped up.
+	alerter.check(context.Background(), newAlert(balance))
+	requireNoAlert(t, alerts)
+
+	alerter.check(context.Background(), newAlert(threshold))
+	require.False(t, alerter.alerted.Load())
+}
+
+func TestBalanceAlertWebhookFailure(t *testing.T) {
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer webhook.Close()
+
+	alerter := newBalanceAlerter(webhook.URL, big.NewInt(1))
+
+	// The webhook failure never blocks the check, and the alert will be posted again.
+	alerter.check(context.Background(), &BalanceAlert{Balance: common.Big0})
+	require.Eventually(t, func() bool { return !alerter.posting.Load() }, 5*time.Second, 10*time.Millisecond)
+	require.False(t, alerter.alerted.Load())
+
+	// No webhook, no alerter.
+	require.Nil(t, newBalanceAlerter("", big.NewInt(1)))
+}
+
+func TestEstimateRunway(t *testing.T) {
+	p := &Prover{cfg: &Config{}}
+	require.Equal(t, uint64(2), p.estimateRunway(big.NewInt(int64(2*defaultSubmissionGasEstimate+1)), common.Big1))
+	require.Zero(t, p.estimateRunway(common.Big1, common.Big0))
+
+	gasLimit := uint64(1_000)
+	p.cfg.ProveBlockGasLimit = &gasLimit
+	require.Equal(t, uint64(10), p.estimateRunway(big.NewInt(10_000), common.Big1))
+}
+
+// requireNoAlert asserts no alert is posted within a short period.
+func requireNoAlert(t *testing.T, alerts chan *BalanceAlert) {
+	select {
+	case alert := <-alerts:
+		t.Fatalf("unexpected alert: %v", alert)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
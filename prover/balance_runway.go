@@ -0,0 +1,168 @@
+package prover
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+var (
+	// defaultSubmissionGasEstimates are the rough gas estimates of a TaikoL1.proveBlock transaction
+	// for each tier, used when no proof submission gas limit is configured.
+	defaultSubmissionGasEstimates = map[uint16]uint64{
+		encoding.TierOptimisticID: 300_000,
+		encoding.TierSgxID:        400_000,
+		encoding.TierGuardianID:   500_000,
+	}
+	defaultSubmissionGasEstimate uint64 = 500_000
+)
+
+// pendingSubmissions counts the in-flight proof submissions of each tier.
+type pendingSubmissions struct {
+	mu     sync.Mutex
+	counts map[uint16]int
+}
+
+// add adds the given delta to the in-flight submissions count of the given tier.
+func (s *pendingSubmissions) add(tier uint16, delta int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.counts == nil {
+		s.counts = make(map[uint16]int)
+	}
+	s.counts[tier] += delta
+}
+
+// snapshot returns a copy of the in-flight submissions counts.
+func (s *pendingSubmissions) snapshot() map[uint16]int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counts := make(map[uint16]int, len(s.counts))
+	for tier, count := range s.counts {
+		counts[tier] = count
+	}
+	return counts
+}
+
+// submitProofWithRetry submits the given proof with the prover backoff policy, the proof is counted as
+// an in-flight submission until all retries finish.
+func (p *Prover) submitProofWithRetry(proofWithHeader *proofProducer.ProofWithHeader) {
+	p.provingTimelines.record(proofWithHeader.BlockID, StageProofProduced)
+	p.pendingSubmissions.add(proofWithHeader.Tier, 1)
+
+	p.withRetryDone(
+		func() error { return p.submitProofOp(proofWithHeader) },
+		func() { p.pendingSubmissions.add(proofWithHeader.Tier, -1) },
+	)
+}
+
+// balanceRunwayLoop periodically checks whether the prover's balance can cover all pending proof submissions.
+func (p *Prover) balanceRunwayLoop() {
+	p.wg.Add(1)
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.cfg.BalanceRunwayCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.checkBalanceRunway(p.ctx); err != nil {
+				log.Warn("Failed to check the balance runway", "error", err)
+			}
+		}
+	}
+}
+
+// checkBalanceRunway estimates the total cost of all queued and in-flight proof submissions, and
+// warns if the prover's balance can not cover all of them, a low balance alert is posted to the
+// webhook if the balance drops below the alert threshold.
+func (p *Prover) checkBalanceRunway(ctx context.Context) error {
+	balance, err := p.rpc.L1.BalanceAt(ctx, p.ProverAddress(), nil)
+	if err != nil {
+		return err
+	}
+	gasPrice, err := p.rpc.L1.SuggestGasPrice(ctx)
+	if err != nil {
+		return err
+	}
+
+	required, pending := p.pendingSubmissionsCost(gasPrice)
+	metrics.ProverPendingSubmissionsGauge.Update(int64(pending))
+
+	if balance.Cmp(required) < 0 {
+		metrics.ProverBalanceRunwayInsufficientCounter.Inc(1)
+		log.Warn(
+			"Prover balance can not cover all pending proof submissions",
+			"balance", balance,
+			"required", required,
+			"pending", pending,
+			"gasPrice", gasPrice,
+		)
+	}
+
+	p.balanceAlerter.check(ctx, &BalanceAlert{
+		Account:            p.ProverAddress(),
+		Balance:            balance,
+		GasPrice:           gasPrice,
+		PendingSubmissions: pending,
+		PendingCost:        required,
+		Runway:             p.estimateRunway(balance, gasPrice),
+		Time:               time.Now(),
+	})
+
+	return nil
+}
+
+// pendingSubmissionsCost returns the estimated total cost and the number of all queued and
+// in-flight proof submissions.
+func (p *Prover) pendingSubmissionsCost(gasPrice *big.Int) (*big.Int, int) {
+	pending := p.pendingSubmissions.snapshot()
+	if p.proofRequestQueue != nil {
+		for tier, count := range p.proofRequestQueue.TierCounts() {
+			pending[tier] += count
+		}
+	}
+
+	var total int
+	for _, count := range pending {
+		total += count
+	}
+
+	return p.estimateSubmissionsCost(pending, gasPrice), total
+}
+
+// estimateSubmissionsCost estimates the total cost of the given numbers of proof submissions of each tier.
+func (p *Prover) estimateSubmissionsCost(pending map[uint16]int, gasPrice *big.Int) *big.Int {
+	var totalGas uint64
+	for tier, count := range pending {
+		if count <= 0 {
+			continue
+		}
+		totalGas += p.submissionGasEstimate(tier) * uint64(count)
+	}
+
+	return new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(totalGas))
+}
+
+// submissionGasEstimate returns the estimated gas used by a proof submission of the given tier.
+func (p *Prover) submissionGasEstimate(tier uint16) uint64 {
+	if p.cfg.ProveBlockGasLimit != nil {
+		return *p.cfg.ProveBlockGasLimit
+	}
+	if estimate, ok := defaultSubmissionGasEstimates[tier]; ok {
+		return estimate
+	}
+	return defaultSubmissionGasEstimate
+}
@@ -0,0 +1,89 @@
+package prover
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+func TestPendingSubmissionsCost(t *testing.T) {
+	queue := newProofRequestQueue(func() []*rpc.TierProviderTierWithID { return nil })
+	for i, tier := range []uint16{encoding.TierOptimisticID, encoding.TierOptimisticID, encoding.TierSgxID} {
+		queue.Push(&proofProducer.ProofRequestBody{
+			Tier:  tier,
+			Event: &bindings.TaikoL1ClientBlockProposed{BlockId: big.NewInt(int64(i))},
+		})
+	}
+
+	p := &Prover{cfg: &Config{}, proofRequestQueue: queue}
+	p.pendingSubmissions.add(encoding.TierGuardianID, 1)
+
+	var (
+		gasPrice = big.NewInt(10)
+		required = new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(
+			2*defaultSubmissionGasEstimates[encoding.TierOptimisticID]+
+				defaultSubmissionGasEstimates[encoding.TierSgxID]+
+				defaultSubmissionGasEstimates[encoding.TierGuardianID],
+		))
+		balance = new(big.Int).Sub(required, common.Big1)
+	)
+
+	cost, pending := p.pendingSubmissionsCost(gasPrice)
+	require.Equal(t, 4, pending)
+	require.Equal(t, required, cost)
+	require.Negative(t, balance.Cmp(cost))
+
+	// A configured proof submission gas limit overrides the per-tier estimates.
+	gasLimit := uint64(1_000_000)
+	p.cfg.ProveBlockGasLimit = &gasLimit
+	cost, _ = p.pendingSubmissionsCost(gasPrice)
+	require.Equal(t, new(big.Int).Mul(gasPrice, big.NewInt(4*1_000_000)), cost)
+
+	// Finished submissions are no longer counted.
+	p.pendingSubmissions.add(encoding.TierGuardianID, -1)
+	_, pending = p.pendingSubmissionsCost(gasPrice)
+	require.Equal(t, 3, pending)
+}
+
+func TestWithRetryDone(t *testing.T) {
+	p := &Prover{
+		backoff: backoff.WithContext(
+			backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Millisecond), 2),
+			context.Background(),
+		),
+	}
+
+	// The in-flight submission is only released once all retries finish.
+	var (
+		attempts int
+		done     = make(chan struct{})
+	)
+	p.pendingSubmissions.add(encoding.TierSgxID, 1)
+	p.withRetryDone(
+		func() error {
+			attempts++
+			require.Equal(t, map[uint16]int{encoding.TierSgxID: 1}, p.pendingSubmissions.snapshot())
+			return errors.New("submission failed")
+		},
+		func() {
+			p.pendingSubmissions.add(encoding.TierSgxID, -1)
+			close(done)
+		},
+	)
+	<-done
+	p.wg.Wait()
+
+	require.Equal(t, 3, attempts)
+	require.Equal(t, map[uint16]int{encoding.TierSgxID: 0}, p.pendingSubmissions.snapshot())
+}
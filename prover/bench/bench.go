@@ -0,0 +1,203 @@
+package bench
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/cmd/flags"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+// syntheticBlockGasLimit is the gas limit of the synthetic L2 blocks used for benchmarking.
+const syntheticBlockGasLimit = 15_000_000
+
+var errInvalidCount = errors.New("invalid benchmark count")
+
+// Report contains the result of a proof production benchmark.
+type Report struct {
+	Tier       uint16        `json:"tier"`
+	Count      int           `json:"count"`
+	Failed     int           `json:"failed"`
+	Elapsed    time.Duration `json:"elapsed"`
+	Throughput float64       `json:"throughput"` // Produced proofs per second
+	P50        time.Duration `json:"p50"`
+	P95        time.Duration `json:"p95"`
+	P99        time.Duration `json:"p99"`
+	// Resource usage
+	TotalAllocBytes uint64 `json:"totalAllocBytes"`
+	MaxHeapBytes    uint64 `json:"maxHeapBytes"`
+	NumGC           uint32 `json:"numGC"`
+}
+
+// Action is the action of the `bench` command.
+func Action(c *cli.Context) error {
+	producer, err := newProofProducer(c)
+	if err != nil {
+		return err
+	}
+
+	report, err := Run(c.Context, producer, c.Int(flags.BenchCount.Name), c.Uint64(flags.BenchStartingBlockID.Name))
+	if err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(c.App.Writer, string(output))
+
+	return nil
+}
+
+// Run runs the given number of proof productions against synthetic blocks, and reports the
+// throughput, latency percentiles and resource usage.
+func Run(
+	ctx context.Context,
+	producer proofProducer.ProofProducer,
+	count int,
+	startingBlockID uint64,
+) (*Report, error) {
+	if count <= 0 {
+		return nil, fmt.Errorf("%w: %d", errInvalidCount, count)
+	}
+
+	var (
+		latencies = make([]time.Duration, 0, count)
+		failed    int
+		memBefore runtime.MemStats
+		memAfter  runtime.MemStats
+	)
+	runtime.ReadMemStats(&memBefore)
+	maxHeapBytes := memBefore.HeapAlloc
+
+	start := time.Now()
+	for i := 0; i < count; i++ {
+		blockID := new(big.Int).SetUint64(startingBlockID + uint64(i))
+		opts, meta, header := syntheticBlock(blockID)
+
+		proofStart := time.Now()
+		if _, err := producer.RequestProof(ctx, opts, blockID, meta, header); err != nil {
+			log.Warn("Failed to produce proof", "blockID", blockID, "error", err)
+			failed++
+			continue
+		}
+		latencies = append(latencies, time.Since(proofStart))
+
+		var memStats runtime.MemStats
+		runtime.ReadMemStats(&memStats)
+		if memStats.HeapAlloc > maxHeapBytes {
+			maxHeapBytes = memStats.HeapAlloc
+		}
+	}
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&memAfter)
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	report := &Report{
+		Tier:            producer.Tier(),
+		Count:           count,
+		Failed:          failed,
+		Elapsed:         elapsed,
+		P50:             percentile(latencies, 50),
+		P95:             percentile(latencies, 95),
+		P99:             percentile(latencies, 99),
+		TotalAllocBytes: memAfter.TotalAlloc - memBefore.TotalAlloc,
+		MaxHeapBytes:    maxHeapBytes,
+		NumGC:           memAfter.NumGC - memBefore.NumGC,
+	}
+	if elapsed > 0 {
+		report.Throughput = float64(len(latencies)) / elapsed.Seconds()
+	}
+
+	log.Info(
+		"Proof production benchmark finished",
+		"tier", report.Tier,
+		"count", report.Count,
+		"failed", report.Failed,
+		"elapsed", report.Elapsed,
+		"throughput", report.Throughput,
+		"p50", report.P50,
+		"p95", report.P95,
+		"p99", report.P99,
+	)
+
+	return report, nil
+}
+
+// newProofProducer creates a proof producer with the given tier from the command line flags.
+func newProofProducer(c *cli.Context) (proofProducer.ProofProducer, error) {
+	switch tier := uint16(c.Uint(flags.BenchTier.Name)); tier {
+	case encoding.TierOptimisticID:
+		return &proofProducer.OptimisticProofProducer{}, nil
+	case encoding.TierSgxID:
+		return &proofProducer.SGXProofProducer{
+			RaikoHostEndpoint: c.String(flags.RaikoHostEndpoint.Name),
+			L1Endpoint:        c.String(flags.L1HTTPEndpoint.Name),
+			L1BeaconEndpoint:  c.String(flags.L1BeaconEndpoint.Name),
+			L2Endpoint:        c.String(flags.L2HTTPEndpoint.Name),
+			Dummy:             !c.IsSet(flags.RaikoHostEndpoint.Name),
+		}, nil
+	case encoding.TierGuardianID:
+		return proofProducer.NewGuardianProofProducer(false), nil
+	default:
+		return nil, fmt.Errorf("unsupported tier: %d", tier)
+	}
+}
+
+// syntheticBlock creates the proof request parameters of a synthetic L2 block with the given ID.
+func syntheticBlock(
+	blockID *big.Int,
+) (*proofProducer.ProofRequestOptions, *bindings.TaikoDataBlockMetadata, *types.Header) {
+	header := &types.Header{
+		ParentHash: crypto.Keccak256Hash([]byte("parent"), blockID.Bytes()),
+		Root:       crypto.Keccak256Hash([]byte("root"), blockID.Bytes()),
+		Number:     blockID,
+		GasLimit:   syntheticBlockGasLimit,
+		Time:       uint64(time.Now().Unix()),
+	}
+	meta := &bindings.TaikoDataBlockMetadata{
+		Id:        blockID.Uint64(),
+		Timestamp: header.Time,
+		MinTier:   encoding.TierOptimisticID,
+	}
+	opts := &proofProducer.ProofRequestOptions{
+		BlockID:    blockID,
+		BlockHash:  header.Hash(),
+		ParentHash: header.ParentHash,
+		StateRoot:  header.Root,
+		Graffiti:   common.Bytes2Hex(make([]byte, 32)),
+	}
+
+	return opts, meta, header
+}
+
+// percentile returns the given percentile of the sorted latencies.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	idx := (len(sorted)*p+99)/100 - 1
+	if idx < 0 {
+		idx = 0
+	}
+
+	return sorted[idx]
+}
@@ -0,0 +1,41 @@
+package bench
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	producer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+func TestRun(t *testing.T) {
+	report, err := Run(context.Background(), &producer.OptimisticProofProducer{}, 20, 1)
+	require.Nil(t, err)
+
+	require.Equal(t, encoding.TierOptimisticID, report.Tier)
+	require.Equal(t, 20, report.Count)
+	require.Zero(t, report.Failed)
+	require.Greater(t, report.Elapsed, time.Duration(0))
+	require.Greater(t, report.Throughput, float64(0))
+	require.LessOrEqual(t, report.P50, report.P95)
+	require.LessOrEqual(t, report.P95, report.P99)
+	require.NotZero(t, report.MaxHeapBytes)
+
+	_, err = Run(context.Background(), &producer.OptimisticProofProducer{}, 0, 1)
+	require.ErrorIs(t, err, errInvalidCount)
+}
+
+func TestPercentile(t *testing.T) {
+	var latencies []time.Duration
+	for i := 1; i <= 100; i++ {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+
+	require.Equal(t, 50*time.Millisecond, percentile(latencies, 50))
+	require.Equal(t, 95*time.Millisecond, percentile(latencies, 95))
+	require.Equal(t, 99*time.Millisecond, percentile(latencies, 99))
+	require.Zero(t, percentile(nil, 50))
+}
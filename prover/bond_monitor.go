@@ -0,0 +1,103 @@
+package prover
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+)
+
+// bondStatus is the prover's TaikoToken bond status, the liveness bond of each assigned block is
+// transferred from the prover's balance through the AssignmentHook's allowance, so the bondable amount
+// is the smaller of the two.
+type bondStatus struct {
+	balance   *big.Int
+	allowance *big.Int
+	bondable  *big.Int
+	// The number of the assignments the bondable amount can still pay the liveness bonds for
+	assignments uint64
+}
+
+// newBondStatus creates a new bondStatus instance from the given balance, allowance and liveness bond.
+func newBondStatus(balance *big.Int, allowance *big.Int, livenessBond *big.Int) *bondStatus {
+	status := &bondStatus{balance: balance, allowance: allowance, bondable: balance}
+	if allowance.Cmp(balance) < 0 {
+		status.bondable = allowance
+	}
+	if livenessBond != nil && livenessBond.Sign() > 0 {
+		status.assignments = new(big.Int).Div(status.bondable, livenessBond).Uint64()
+	}
+
+	return status
+}
+
+// low returns whether the bondable amount is below the given threshold, a nil or zero threshold means
+// never low.
+func (s *bondStatus) low(threshold *big.Int) bool {
+	return threshold != nil && threshold.Sign() > 0 && s.bondable.Cmp(threshold) < 0
+}
+
+// bondMonitorLoop periodically checks the prover's TaikoToken balance and the amount it can still bond.
+func (p *Prover) bondMonitorLoop() {
+	p.wg.Add(1)
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.cfg.BondMonitorInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.checkBond(p.ctx); err != nil {
+				log.Warn("Failed to check the prover bond", "error", err)
+			}
+		}
+	}
+}
+
+// checkBond reads the prover's TaikoToken balance and its allowance for the AssignmentHook, updates the
+// bond gauges, and warns if the bondable amount is below the alert threshold.
+func (p *Prover) checkBond(ctx context.Context) error {
+	opts := &bind.CallOpts{Context: ctx}
+
+	balance, err := p.rpc.TaikoToken.BalanceOf(opts, p.ProverAddress())
+	if err != nil {
+		return err
+	}
+	allowance, err := p.rpc.TaikoToken.Allowance(opts, p.ProverAddress(), p.cfg.AssignmentHookAddress)
+	if err != nil {
+		return err
+	}
+
+	status := newBondStatus(balance, allowance, p.protocolConfig.LivenessBond)
+	metrics.ProverTaikoTokenBalanceGauge.Update(toEther(status.balance))
+	metrics.ProverBondAllowanceGauge.Update(toEther(status.allowance))
+	metrics.ProverBondAssignmentsGauge.Update(int64(status.assignments))
+
+	if status.low(p.cfg.BondAlertThreshold) {
+		log.Warn(
+			"Prover bondable TaikoToken amount is running low, assignments will fail once it is used up",
+			"balance", status.balance,
+			"allowance", status.allowance,
+			"threshold", p.cfg.BondAlertThreshold,
+			"livenessBond", p.protocolConfig.LivenessBond,
+			"assignmentsLeft", status.assignments,
+		)
+	}
+
+	return nil
+}
+
+// toEther converts the given amount in wei to ether, i.e. 10^18 wei, for the gauges, which can't hold a
+// token amount in wei.
+func toEther(wei *big.Int) float64 {
+	f, _ := new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(params.Ether)).Float64()
+	return f
+}
@@ -0,0 +1,42 @@
+package prover
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBondStatus(t *testing.T) {
+	livenessBond := big.NewInt(100)
+
+	// Bounded by the balance.
+	status := newBondStatus(big.NewInt(250), big.NewInt(1000), livenessBond)
+	require.Equal(t, big.NewInt(250), status.bondable)
+	require.Equal(t, uint64(2), status.assignments)
+
+	// Bounded by the allowance.
+	status = newBondStatus(big.NewInt(1000), big.NewInt(99), livenessBond)
+	require.Equal(t, big.NewInt(99), status.bondable)
+	require.Zero(t, status.assignments)
+
+	// No liveness bond.
+	status = newBondStatus(big.NewInt(1000), big.NewInt(1000), nil)
+	require.Zero(t, status.assignments)
+
+	// Below the threshold.
+	require.True(t, status.low(big.NewInt(1001)))
+	require.False(t, status.low(big.NewInt(1000)))
+	require.False(t, status.low(big.NewInt(0)))
+	require.False(t, status.low(nil))
+}
+
+func TestToEther(t *testing.T) {
+	require.Equal(t, 0.0, toEther(big.NewInt(0)))
+	require.Equal(t, 1.5, toEther(big.NewInt(1_500_000_000_000_000_000)))
+
+	// Larger than the maximum int64.
+	wei, ok := new(big.Int).SetString("100000000000000000000000", 10)
+	require.True(t, ok)
+	require.Equal(t, 100_000.0, toEther(wei))
+}
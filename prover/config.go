@@ -1,23 +1,33 @@
 package prover
 
 import (
+	"bytes"
 	"crypto/ecdsa"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/urfave/cli/v2"
 
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/cmd/flags"
 )
 
 // Config contains the configurations to initialize a Taiko prover.
 type Config struct {
 	L1WsEndpoint                            string
+	L1FailoverWsEndpoints                   []string
+	L1FailoverMaxHeadLag                    uint64
+	L1FailoverCheckInterval                 time.Duration
+	HealthMaxL1HeadAge                      time.Duration
+	HealthMaxL2BlockLag                     uint64
+	HealthMaxBeaconSyncDistance             uint64
 	L1HttpEndpoint                          string
 	L1BeaconEndpoint                        string
 	L2WsEndpoint                            string
@@ -27,24 +37,74 @@ type Config struct {
 	TaikoTokenAddress                       common.Address
 	AssignmentHookAddress                   common.Address
 	L1ProverPrivKey                         *ecdsa.PrivateKey
+	L1ProverSigner                          string
+	L1ContesterPrivKey                      *ecdsa.PrivateKey
 	StartingBlockID                         *big.Int
 	Dummy                                   bool
 	GuardianProverAddress                   common.Address
 	GuardianProofSubmissionDelay            time.Duration
+	SubmissionCooldown                      time.Duration
+	MaxProofAge                             time.Duration
+	AbandonNotAssigned                      bool
+	ProofSubmissionDryRun                   bool
+	LateProofGraceWindow                    time.Duration
+	BlockShards                             uint64
+	BlockShardIndex                         uint64
+	MaxStartupBackfill                      uint64
 	ProofSubmissionMaxRetry                 uint64
 	Graffiti                                string
 	BackOffMaxRetrys                        uint64
 	BackOffRetryInterval                    time.Duration
 	ProveUnassignedBlocks                   bool
 	ContesterMode                           bool
+	SkipInsufficientContestBond             bool
 	EnableLivenessBondProof                 bool
 	RPCTimeout                              time.Duration
 	WaitReceiptTimeout                      time.Duration
 	ProveBlockGasLimit                      *uint64
 	ProveBlockTxReplacementGasGrowthRate    uint64
 	ProveBlockMaxTxGasFeeCap                *big.Int
+	VerifySubmittedProof                    bool
+	BroadcastEndpoints                      []string
+	TxNonceStrategy                         string
+	TxDuplicateNonceRetrys                  uint64
+	ProofSubmissionConcurrency              uint64
+	ResubmissionMaxRetrys                   uint64
+	ResubmissionMaxInterval                 time.Duration
+	ResubmissionGasBump                     uint64
+	GasPriceCeilings                        map[uint16]*big.Int
+	FallbackTiers                           map[uint16][]uint16
 	HTTPServerPort                          uint64
+	ConfigAPIToken                          string
+	AuditConcurrency                        uint64
+	AuditBlocksPerSecond                    uint64
+	GRPCAddr                                string
 	Capacity                                uint64
+	ProofRequestConcurrency                 uint64
+	ProducerConcurrency                     uint64
+	OrderedProofResults                     bool
+	ProofRequestDedupWindow                 time.Duration
+	ProofRequestTimeout                     time.Duration
+	BalanceRunwayCheckInterval              time.Duration
+	BalanceAlertWebhook                     string
+	BalanceAlertThreshold                   *big.Int
+	BondMonitorInterval                     time.Duration
+	BondAlertThreshold                      *big.Int
+	MaxSyncLag                              uint64
+	AssignmentWarmupMaxSyncLag              *uint64
+	MaxProverReorgDepth                     uint64
+	ProtocolPausedPollInterval              time.Duration
+	NonceStoreFile                          string
+	StuckTxTimeout                          time.Duration
+	StuckTxGasBumpRate                      uint64
+	GasLimitMargin                          uint64
+	GasLimitMarginCap                       uint64
+	MaxTxsPerSecond                         uint64
+	LeaseFile                               string
+	LeaseTTL                                time.Duration
+	AccountingSnapshotFile                  string
+	AccountingSnapshotInterval              time.Duration
+	FeeHistoryFile                          string
 	MinOptimisticTierFee                    *big.Int
 	MinSgxTierFee                           *big.Int
 	MinSgxAndZkVMTierFee                    *big.Int
@@ -56,6 +116,9 @@ type Config struct {
 	Allowance                               *big.Int
 	GuardianProverHealthCheckServerEndpoint *url.URL
 	RaikoHostEndpoint                       string
+	ZKProverEndpoint                        string
+	ZKRequestTimeout                        time.Duration
+	ZKPollingInterval                       time.Duration
 	L1NodeVersion                           string
 	L2NodeVersion                           string
 	BlockConfirmations                      uint64
@@ -72,6 +135,13 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, errors.New("empty L1 beacon endpoint")
 	}
 
+	var l1ContesterPrivKey *ecdsa.PrivateKey
+	if c.IsSet(flags.L1ContesterPrivKey.Name) {
+		if l1ContesterPrivKey, err = crypto.ToECDSA(common.FromHex(c.String(flags.L1ContesterPrivKey.Name))); err != nil {
+			return nil, fmt.Errorf("invalid L1 contester private key: %w", err)
+		}
+	}
+
 	var startingBlockID *big.Int
 	if c.IsSet(flags.StartingBlockID.Name) {
 		startingBlockID = new(big.Int).SetUint64(c.Uint64(flags.StartingBlockID.Name))
@@ -83,6 +153,53 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		proveBlockTxGasLimit = &gasLimit
 	}
 
+	var assignmentWarmupMaxSyncLag *uint64
+	if c.IsSet(flags.AssignmentWarmupMaxSyncLag.Name) {
+		maxSyncLag := c.Uint64(flags.AssignmentWarmupMaxSyncLag.Name)
+		assignmentWarmupMaxSyncLag = &maxSyncLag
+	}
+
+	gasPriceCeilings := make(map[uint16]*big.Int)
+	for tier, flag := range map[uint16]*cli.Uint64Flag{
+		encoding.TierOptimisticID: flags.GasPriceCeilingOptimistic,
+		encoding.TierSgxID:        flags.GasPriceCeilingSgx,
+		encoding.TierSgxAndZkVMID: flags.GasPriceCeilingSgxAndZkVM,
+		encoding.TierGuardianID:   flags.GasPriceCeilingGuardian,
+	} {
+		if c.IsSet(flag.Name) {
+			gasPriceCeilings[tier] = new(big.Int).SetUint64(c.Uint64(flag.Name))
+		}
+	}
+
+	fallbackTiers := make(map[uint16][]uint16)
+	for tier, flag := range map[uint16]*cli.Uint64SliceFlag{
+		encoding.TierOptimisticID: flags.FallbackTiersOptimistic,
+		encoding.TierSgxID:        flags.FallbackTiersSgx,
+		encoding.TierSgxAndZkVMID: flags.FallbackTiersSgxAndZkVM,
+		encoding.TierGuardianID:   flags.FallbackTiersGuardian,
+	} {
+		for _, fallback := range c.Uint64Slice(flag.Name) {
+			switch fallback {
+			case uint64(encoding.TierOptimisticID),
+				uint64(encoding.TierSgxID),
+				uint64(encoding.TierSgxAndZkVMID),
+				uint64(encoding.TierGuardianID):
+				fallbackTiers[tier] = append(fallbackTiers[tier], uint16(fallback))
+			default:
+				return nil, fmt.Errorf("invalid --%s value: unknown tier %d", flag.Name, fallback)
+			}
+		}
+	}
+
+	// The low balance alerts are posted by the balance runway checks.
+	if c.IsSet(flags.BalanceAlertWebhook.Name) && c.Duration(flags.BalanceRunwayCheckInterval.Name) == 0 {
+		return nil, fmt.Errorf(
+			"--%s requires --%s to be set",
+			flags.BalanceAlertWebhook.Name,
+			flags.BalanceRunwayCheckInterval.Name,
+		)
+	}
+
 	proveBlockTxReplacementMultiplier := c.Uint64(flags.TxReplacementGasGrowthRate.Name)
 	if proveBlockTxReplacementMultiplier == 0 {
 		return nil, fmt.Errorf(
@@ -139,8 +256,37 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, fmt.Errorf("raiko host not provided")
 	}
 
+	if c.Uint64(flags.BlockShards.Name) > 1 &&
+		c.Uint64(flags.BlockShardIndex.Name) >= c.Uint64(flags.BlockShards.Name) {
+		return nil, fmt.Errorf(
+			"invalid --%s value: %d, must be less than --%s",
+			flags.BlockShardIndex.Name,
+			c.Uint64(flags.BlockShardIndex.Name),
+			flags.BlockShards.Name,
+		)
+	}
+
+	if c.String(flags.LeaseFile.Name) != "" && c.Duration(flags.LeaseTTL.Name) <= 0 {
+		return nil, fmt.Errorf("invalid --%s value: %s", flags.LeaseTTL.Name, c.Duration(flags.LeaseTTL.Name))
+	}
+
+	if (c.String(flags.AccountingSnapshotFile.Name) != "" || c.String(flags.FeeHistoryFile.Name) != "") &&
+		c.Duration(flags.AccountingSnapshotInterval.Name) <= 0 {
+		return nil, fmt.Errorf(
+			"invalid --%s value: %s",
+			flags.AccountingSnapshotInterval.Name,
+			c.Duration(flags.AccountingSnapshotInterval.Name),
+		)
+	}
+
 	return &Config{
 		L1WsEndpoint:                            c.String(flags.L1WSEndpoint.Name),
+		L1FailoverWsEndpoints:                   c.StringSlice(flags.L1FailoverWSEndpoints.Name),
+		L1FailoverMaxHeadLag:                    c.Uint64(flags.L1FailoverMaxHeadLag.Name),
+		L1FailoverCheckInterval:                 c.Duration(flags.L1FailoverCheckInterval.Name),
+		HealthMaxL1HeadAge:                      c.Duration(flags.HealthMaxL1HeadAge.Name),
+		HealthMaxL2BlockLag:                     c.Uint64(flags.HealthMaxL2BlockLag.Name),
+		HealthMaxBeaconSyncDistance:             c.Uint64(flags.HealthMaxBeaconSyncDistance.Name),
 		L1HttpEndpoint:                          c.String(flags.L1HTTPEndpoint.Name),
 		L1BeaconEndpoint:                        c.String(flags.L1BeaconEndpoint.Name),
 		L2WsEndpoint:                            c.String(flags.L2WSEndpoint.Name),
@@ -150,26 +296,79 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		TaikoTokenAddress:                       common.HexToAddress(c.String(flags.TaikoTokenAddress.Name)),
 		AssignmentHookAddress:                   common.HexToAddress(c.String(flags.ProverAssignmentHookAddress.Name)),
 		L1ProverPrivKey:                         l1ProverPrivKey,
+		L1ContesterPrivKey:                      l1ContesterPrivKey,
+		L1ProverSigner:                          c.String(flags.L1ProverSigner.Name),
 		RaikoHostEndpoint:                       c.String(flags.RaikoHostEndpoint.Name),
+		ZKProverEndpoint:                        c.String(flags.ZKProverEndpoint.Name),
+		ZKRequestTimeout:                        c.Duration(flags.ZKRequestTimeout.Name),
+		ZKPollingInterval:                       c.Duration(flags.ZKPollingInterval.Name),
 		StartingBlockID:                         startingBlockID,
 		Dummy:                                   c.Bool(flags.Dummy.Name),
 		GuardianProverAddress:                   common.HexToAddress(c.String(flags.GuardianProver.Name)),
 		GuardianProofSubmissionDelay:            c.Duration(flags.GuardianProofSubmissionDelay.Name),
 		GuardianProverHealthCheckServerEndpoint: guardianProverHealthCheckServerEndpoint,
+		SubmissionCooldown:                      c.Duration(flags.SubmissionCooldown.Name),
+		MaxProofAge:                             c.Duration(flags.MaxProofAge.Name),
+		AbandonNotAssigned:                      c.Bool(flags.AbandonNotAssigned.Name),
+		ProofSubmissionDryRun:                   c.Bool(flags.ProofSubmissionDryRun.Name),
+		LateProofGraceWindow:                    c.Duration(flags.LateProofGraceWindow.Name),
+		BlockShards:                             c.Uint64(flags.BlockShards.Name),
+		BlockShardIndex:                         c.Uint64(flags.BlockShardIndex.Name),
+		MaxStartupBackfill:                      c.Uint64(flags.MaxStartupBackfill.Name),
 		ProofSubmissionMaxRetry:                 c.Uint64(flags.ProofSubmissionMaxRetry.Name),
 		Graffiti:                                c.String(flags.Graffiti.Name),
 		BackOffMaxRetrys:                        c.Uint64(flags.BackOffMaxRetrys.Name),
 		BackOffRetryInterval:                    c.Duration(flags.BackOffRetryInterval.Name),
 		ProveUnassignedBlocks:                   c.Bool(flags.ProveUnassignedBlocks.Name),
 		ContesterMode:                           c.Bool(flags.ContesterMode.Name),
+		SkipInsufficientContestBond:             c.Bool(flags.SkipInsufficientContestBond.Name),
 		EnableLivenessBondProof:                 c.Bool(flags.EnableLivenessBondProof.Name),
 		RPCTimeout:                              c.Duration(flags.RPCTimeout.Name),
 		WaitReceiptTimeout:                      c.Duration(flags.WaitReceiptTimeout.Name),
 		ProveBlockGasLimit:                      proveBlockTxGasLimit,
 		Capacity:                                c.Uint64(flags.ProverCapacity.Name),
+		BalanceRunwayCheckInterval:              c.Duration(flags.BalanceRunwayCheckInterval.Name),
+		BalanceAlertWebhook:                     c.String(flags.BalanceAlertWebhook.Name),
+		BalanceAlertThreshold:                   new(big.Int).SetUint64(c.Uint64(flags.BalanceAlertThreshold.Name)),
+		BondMonitorInterval:                     c.Duration(flags.BondMonitorInterval.Name),
+		BondAlertThreshold:                      new(big.Int).SetUint64(c.Uint64(flags.BondAlertThreshold.Name)),
+		LeaseFile:                               c.String(flags.LeaseFile.Name),
+		LeaseTTL:                                c.Duration(flags.LeaseTTL.Name),
+		AccountingSnapshotFile:                  c.String(flags.AccountingSnapshotFile.Name),
+		AccountingSnapshotInterval:              c.Duration(flags.AccountingSnapshotInterval.Name),
+		FeeHistoryFile:                          c.String(flags.FeeHistoryFile.Name),
+		MaxSyncLag:                              c.Uint64(flags.MaxSyncLag.Name),
+		AssignmentWarmupMaxSyncLag:              assignmentWarmupMaxSyncLag,
+		MaxProverReorgDepth:                     c.Uint64(flags.MaxProverReorgDepth.Name),
+		ProtocolPausedPollInterval:              c.Duration(flags.ProtocolPausedPollInterval.Name),
+		NonceStoreFile:                          c.String(flags.NonceStoreFile.Name),
+		StuckTxTimeout:                          c.Duration(flags.StuckTxTimeout.Name),
+		StuckTxGasBumpRate:                      c.Uint64(flags.StuckTxGasBumpRate.Name),
+		GasLimitMargin:                          c.Uint64(flags.GasLimitMargin.Name),
+		GasLimitMarginCap:                       c.Uint64(flags.GasLimitMarginCap.Name),
+		MaxTxsPerSecond:                         c.Uint64(flags.MaxTxsPerSecond.Name),
+		ProofRequestConcurrency:                 c.Uint64(flags.ProofRequestConcurrency.Name),
+		ProducerConcurrency:                     c.Uint64(flags.ProducerConcurrency.Name),
+		OrderedProofResults:                     c.Bool(flags.OrderedProofResults.Name),
+		ProofRequestDedupWindow:                 c.Duration(flags.ProofRequestDedupWindow.Name),
+		ProofRequestTimeout:                     c.Duration(flags.ProofRequestTimeout.Name),
 		ProveBlockTxReplacementGasGrowthRate:    proveBlockTxReplacementMultiplier,
 		ProveBlockMaxTxGasFeeCap:                proveBlockMaxTxGasTipCap,
+		VerifySubmittedProof:                    c.Bool(flags.VerifySubmittedProof.Name),
+		BroadcastEndpoints:                      c.StringSlice(flags.BroadcastEndpoints.Name),
+		TxNonceStrategy:                         c.String(flags.TxNonceStrategy.Name),
+		TxDuplicateNonceRetrys:                  c.Uint64(flags.TxDuplicateNonceRetrys.Name),
+		ProofSubmissionConcurrency:              c.Uint64(flags.ProofSubmissionConcurrency.Name),
+		ResubmissionMaxRetrys:                   c.Uint64(flags.ResubmissionMaxRetrys.Name),
+		ResubmissionMaxInterval:                 c.Duration(flags.ResubmissionMaxInterval.Name),
+		ResubmissionGasBump:                     c.Uint64(flags.ResubmissionGasBump.Name),
+		GasPriceCeilings:                        gasPriceCeilings,
+		FallbackTiers:                           fallbackTiers,
 		HTTPServerPort:                          c.Uint64(flags.ProverHTTPServerPort.Name),
+		ConfigAPIToken:                          c.String(flags.ConfigAPIToken.Name),
+		AuditConcurrency:                        c.Uint64(flags.AuditConcurrency.Name),
+		AuditBlocksPerSecond:                    c.Uint64(flags.AuditBlocksPerSecond.Name),
+		GRPCAddr:                                c.String(flags.GRPCAddr.Name),
 		MinOptimisticTierFee:                    new(big.Int).SetUint64(c.Uint64(flags.MinOptimisticTierFee.Name)),
 		MinSgxTierFee:                           new(big.Int).SetUint64(c.Uint64(flags.MinSgxTierFee.Name)),
 		MinSgxAndZkVMTierFee:                    new(big.Int).SetUint64(c.Uint64(flags.MinSgxAndZkVMTierFee.Name)),
@@ -184,3 +383,93 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		BlockConfirmations:                      c.Uint64(flags.BlockConfirmations.Name),
 	}, nil
 }
+
+// redactedConfigValue is the placeholder of the redacted secrets.
+const redactedConfigValue = "<redacted>"
+
+// Redacted returns the configuration as a JSON object, with all secrets (private keys and API token) redacted,
+// only the scheme and host of the endpoint URLs are kept, since the RPC providers usually put the API keys in
+// the userinfo, path or query.
+func (c *Config) Redacted() (map[string]interface{}, error) {
+	// Remove the secrets before marshalling.
+	cfg := *c
+	cfg.L1ProverPrivKey = nil
+	cfg.L1ContesterPrivKey = nil
+	cfg.ConfigAPIToken = ""
+	cfg.BalanceAlertWebhook = ""
+	cfg.L1ProverSigner = ""
+
+	b, err := json.Marshal(&cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the big integers' precision.
+	var redacted map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&redacted); err != nil {
+		return nil, err
+	}
+
+	for name, isSet := range map[string]bool{
+		"L1ProverPrivKey":    c.L1ProverPrivKey != nil,
+		"L1ContesterPrivKey": c.L1ContesterPrivKey != nil,
+		"ConfigAPIToken":     c.ConfigAPIToken != "",
+		// The webhook URLs usually carry the credentials
+		"BalanceAlertWebhook": c.BalanceAlertWebhook != "",
+		"L1ProverSigner":      c.L1ProverSigner != "",
+	} {
+		if isSet {
+			redacted[name] = redactedConfigValue
+		}
+	}
+
+	for name, endpoint := range map[string]string{
+		"L1WsEndpoint":      c.L1WsEndpoint,
+		"L1HttpEndpoint":    c.L1HttpEndpoint,
+		"L1BeaconEndpoint":  c.L1BeaconEndpoint,
+		"L2WsEndpoint":      c.L2WsEndpoint,
+		"L2HttpEndpoint":    c.L2HttpEndpoint,
+		"RaikoHostEndpoint": c.RaikoHostEndpoint,
+		"ZKProverEndpoint":  c.ZKProverEndpoint,
+	} {
+		if endpoint != "" {
+			redacted[name] = redactURL(endpoint)
+		}
+	}
+	for name, endpoints := range map[string][]string{
+		"L1FailoverWsEndpoints": c.L1FailoverWsEndpoints,
+		"BroadcastEndpoints":    c.BroadcastEndpoints,
+	} {
+		if len(endpoints) == 0 {
+			continue
+		}
+		redactedEndpoints := make([]string, len(endpoints))
+		for i, endpoint := range endpoints {
+			redactedEndpoints[i] = redactURL(endpoint)
+		}
+		redacted[name] = redactedEndpoints
+	}
+	if c.GuardianProverHealthCheckServerEndpoint != nil {
+		redacted["GuardianProverHealthCheckServerEndpoint"] = redactURL(c.GuardianProverHealthCheckServerEndpoint.String())
+	}
+
+	return redacted, nil
+}
+
+// redactURL returns the scheme and host of the given URL, the userinfo, path, query and fragment are replaced
+// by the redacted placeholder, the whole URL is redacted if it can't be parsed.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return redactedConfigValue
+	}
+
+	redacted := (&url.URL{Scheme: u.Scheme, Host: u.Host}).String()
+	if u.User != nil || strings.Trim(u.Path, "/") != "" || u.RawQuery != "" || u.Fragment != "" || u.Opaque != "" {
+		redacted += "/" + redactedConfigValue
+	}
+
+	return redacted
+}
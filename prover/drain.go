@@ -0,0 +1,69 @@
+package prover
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	errProverDraining = errors.New("prover is draining, no new work is accepted")
+	// Interval to check whether the in-flight work has finished when draining.
+	drainPollInterval = 100 * time.Millisecond
+)
+
+// Drain stops accepting new proof requests and contests, and waits for the in-flight proof requests and
+// submissions to finish, until the given context is done. It implements the utils.Drainer interface, so
+// the prover won't abandon the proof transactions mid-flight on shutdown.
+func (p *Prover) Drain(ctx context.Context) {
+	p.draining.Store(true)
+
+	var (
+		start                 = time.Now()
+		requests, submissions = p.inFlight()
+		ticker                = time.NewTicker(drainPollInterval)
+	)
+	defer ticker.Stop()
+
+	log.Info("Draining prover", "requests", requests, "submissions", submissions)
+
+	// A produced proof is handed over from its request to its submission, so the prover is only
+	// considered idle after two consecutive idle checks.
+	for idleChecks := 0; idleChecks < 2; {
+		if r, s := p.inFlight(); r+s == 0 {
+			idleChecks++
+		} else {
+			idleChecks = 0
+		}
+
+		select {
+		case <-ctx.Done():
+			r, s := p.inFlight()
+			log.Warn(
+				"Prover drain timed out, abandoning the in-flight work",
+				"requests", requests,
+				"submissions", submissions,
+				"abandonedRequests", r,
+				"abandonedSubmissions", s,
+				"elapsed", time.Since(start),
+			)
+			return
+		case <-ticker.C:
+		}
+	}
+
+	log.Info("Prover drained", "requests", requests, "submissions", submissions, "elapsed", time.Since(start))
+}
+
+// inFlight returns the numbers of the in-flight proof requests and proof submissions, the produced proofs
+// which are waiting to be submitted are counted as submissions.
+func (p *Prover) inFlight() (int64, int64) {
+	submissions := int64(len(p.proofGenerationCh))
+	for _, count := range p.pendingSubmissions.snapshot() {
+		submissions += int64(count)
+	}
+
+	return p.inFlightRequests.Load(), submissions
+}
@@ -0,0 +1,69 @@
+package prover
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+func TestDrainWaitsInFlightWork(t *testing.T) {
+	defer func(interval time.Duration) { drainPollInterval = interval }(drainPollInterval)
+	drainPollInterval = time.Millisecond
+
+	p := &Prover{proofGenerationCh: make(chan *proofProducer.ProofWithHeader, 1)}
+	p.inFlightRequests.Add(1)
+	p.pendingSubmissions.add(encoding.TierOptimisticID, 1)
+
+	drained := make(chan struct{})
+	go func() {
+		p.Drain(context.Background())
+		close(drained)
+	}()
+
+	// The in-flight request produces a proof, which is then submitted.
+	time.Sleep(10 * time.Millisecond)
+	p.proofGenerationCh <- &proofProducer.ProofWithHeader{}
+	p.inFlightRequests.Add(-1)
+	time.Sleep(10 * time.Millisecond)
+	<-p.proofGenerationCh
+	p.pendingSubmissions.add(encoding.TierOptimisticID, -1)
+
+	select {
+	case <-drained:
+		require.True(t, p.draining.Load())
+	case <-time.After(time.Second):
+		t.Fatal("prover not drained")
+	}
+}
+
+func TestDrainTimeout(t *testing.T) {
+	p := &Prover{}
+	p.inFlightRequests.Add(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	p.Drain(ctx)
+	require.Equal(t, int64(1), p.inFlightRequests.Load())
+}
+
+func TestDrainingRejectsNewWork(t *testing.T) {
+	p := &Prover{}
+	p.draining.Store(true)
+
+	err := p.requestProofOp(nil, encoding.TierOptimisticID)
+	require.ErrorIs(t, err, errProverDraining)
+	var permanent *backoff.PermanentError
+	require.True(t, errors.As(err, &permanent))
+
+	_, err = p.contestProofOp(nil)
+	require.ErrorIs(t, err, errProverDraining)
+	require.Zero(t, p.inFlightRequests.Load())
+}
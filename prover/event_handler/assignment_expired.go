@@ -67,11 +67,12 @@ func (h *AssignmentExpiredEventHandler) Handle(
 	go func() {
 		if proofStatus.CurrentTransitionState.Contester == rpc.ZeroAddress {
 			h.proofContestCh <- &proofProducer.ContestRequestBody{
-				BlockID:    e.BlockId,
-				ProposedIn: new(big.Int).SetUint64(e.Raw.BlockNumber),
-				ParentHash: proofStatus.ParentHeader.Hash(),
-				Meta:       &e.Meta,
-				Tier:       proofStatus.CurrentTransitionState.Tier,
+				BlockID:          e.BlockId,
+				ProposedIn:       new(big.Int).SetUint64(e.Raw.BlockNumber),
+				ProposedInL1Hash: e.Raw.BlockHash,
+				ParentHash:       proofStatus.ParentHeader.Hash(),
+				Meta:             &e.Meta,
+				Tier:             proofStatus.CurrentTransitionState.Tier,
 			}
 		} else {
 			h.proofSubmissionCh <- &proofProducer.ProofRequestBody{
@@ -22,7 +22,10 @@ import (
 )
 
 var (
-	errL1Reorged         = errors.New("L1 reorged")
+	errL1Reorged = errors.New("L1 reorged")
+	// ErrReorgTooDeep is returned when the prover observes a reorg deeper than the configured limit,
+	// which likely indicates a serious problem, so the prover should halt instead of reconciling.
+	ErrReorgTooDeep      = errors.New("reorg too deep")
 	proofExpirationDelay = 1 * time.Minute
 )
 
@@ -41,6 +44,7 @@ type BlockProposedEventHandler struct {
 	contesterMode         bool
 	proveUnassignedBlocks bool
 	tierToOverride        uint16
+	maxReorgDepth         uint64
 }
 
 // NewBlockProposedEventHandlerOps is the options for creating a new BlockProposedEventHandler.
@@ -57,6 +61,7 @@ type NewBlockProposedEventHandlerOps struct {
 	BackOffMaxRetrys      uint64
 	ContesterMode         bool
 	ProveUnassignedBlocks bool
+	MaxReorgDepth         uint64
 }
 
 // NewBlockProposedEventHandler creates a new BlockProposedEventHandler instance.
@@ -75,6 +80,7 @@ func NewBlockProposedEventHandler(opts *NewBlockProposedEventHandlerOps) *BlockP
 		opts.ContesterMode,
 		opts.ProveUnassignedBlocks,
 		0,
+		opts.MaxReorgDepth,
 	}
 }
 
@@ -177,6 +183,14 @@ func (h *BlockProposedEventHandler) checkL1Reorg(
 	}
 
 	if reorgCheckResult.IsReorged {
+		if err := checkReorgDepth(
+			h.sharedState.GetLastHandledBlockID(),
+			reorgCheckResult.LastHandledBlockIDToReset,
+			h.maxReorgDepth,
+		); err != nil {
+			return err
+		}
+
 		log.Info(
 			"Reset L1Current cursor due to reorg",
 			"l1CurrentHeightOld", h.sharedState.GetL1Current().Number,
@@ -216,6 +230,38 @@ func (h *BlockProposedEventHandler) checkL1Reorg(
 	return nil
 }
 
+// checkReorgDepth checks whether the reorg which resets the last handled block ID from the given old
+// one to the given new one (nil means resetting to genesis) exceeds the given maximum depth, 0 means no limit.
+func checkReorgDepth(lastHandledBlockID uint64, lastHandledBlockIDToReset *big.Int, maxDepth uint64) error {
+	if maxDepth == 0 {
+		return nil
+	}
+
+	var depth uint64
+	switch {
+	case lastHandledBlockIDToReset == nil:
+		depth = lastHandledBlockID
+	case lastHandledBlockIDToReset.Uint64() < lastHandledBlockID:
+		depth = lastHandledBlockID - lastHandledBlockIDToReset.Uint64()
+	}
+
+	if depth <= maxDepth {
+		return nil
+	}
+
+	log.Error(
+		"Reorg deeper than the limit detected, halting the prover",
+		"lastHandledBlockID", lastHandledBlockID,
+		"lastHandledBlockIDToReset", lastHandledBlockIDToReset,
+		"depth", depth,
+		"maxDepth", maxDepth,
+	)
+	metrics.ProverReorgTooDeepCounter.Inc(1)
+
+	// Stop the event iterator from retrying.
+	return backoff.Permanent(fmt.Errorf("%w: depth %d, max depth %d", ErrReorgTooDeep, depth, maxDepth))
+}
+
 // checkExpirationAndSubmitProof checks whether the proposed block's proving window is expired,
 // and submits a new proof if necessary.
 func (h *BlockProposedEventHandler) checkExpirationAndSubmitProof(
@@ -267,11 +313,12 @@ func (h *BlockProposedEventHandler) checkExpirationAndSubmitProof(
 
 		// The proof submitted to protocol is invalid.
 		h.proofContestCh <- &proofProducer.ContestRequestBody{
-			BlockID:    e.BlockId,
-			ProposedIn: new(big.Int).SetUint64(e.Raw.BlockNumber),
-			ParentHash: proofStatus.ParentHeader.Hash(),
-			Meta:       &e.Meta,
-			Tier:       e.Meta.MinTier,
+			BlockID:          e.BlockId,
+			ProposedIn:       new(big.Int).SetUint64(e.Raw.BlockNumber),
+			ProposedInL1Hash: e.Raw.BlockHash,
+			ParentHash:       proofStatus.ParentHeader.Hash(),
+			Meta:             &e.Meta,
+			Tier:             e.Meta.MinTier,
 		}
 		return nil
 	}
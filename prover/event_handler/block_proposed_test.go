@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckReorgDepth(t *testing.T) {
+	// No limit.
+	require.Nil(t, checkReorgDepth(100, nil, 0))
+
+	// Reorgs within the limit are reconciled.
+	require.Nil(t, checkReorgDepth(100, big.NewInt(90), 10))
+	require.Nil(t, checkReorgDepth(100, big.NewInt(100), 10))
+	require.Nil(t, checkReorgDepth(5, nil, 10))
+
+	// Reorgs beyond the limit halt the prover.
+	for _, toReset := range []*big.Int{big.NewInt(89), big.NewInt(0), nil} {
+		err := checkReorgDepth(100, toReset, 10)
+		require.ErrorIs(t, err, ErrReorgTooDeep)
+
+		var permanent *backoff.PermanentError
+		require.True(t, errors.As(err, &permanent))
+	}
+}
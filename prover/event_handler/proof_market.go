@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+)
+
+// proofMarketCacheSize is the number of the latest proven blocks remembered by a proofMarket.
+const proofMarketCacheSize = 1024
+
+// proofMarket tracks the current prover's competitive position in the proof market, by classifying
+// the first proven transition of each block, either proven by the current prover first, or by another
+// prover before the current prover, which is the block's assigned prover.
+type proofMarket struct {
+	proverAddress common.Address
+	proven        *lru.Cache[uint64, struct{}]
+}
+
+// newProofMarket creates a new proofMarket instance for the given prover.
+func newProofMarket(proverAddress common.Address) *proofMarket {
+	return &proofMarket{proverAddress: proverAddress, proven: lru.NewCache[uint64, struct{}](proofMarketCacheSize)}
+}
+
+// observe records a proven transition of the given block, only the first proven transition of each block
+// is counted, the assigned prover of the block is only fetched when the block is proven by another prover.
+func (m *proofMarket) observe(
+	blockID uint64,
+	prover common.Address,
+	assignedProver func() (common.Address, error),
+) error {
+	if m.proven.Contains(blockID) {
+		return nil
+	}
+
+	if prover == m.proverAddress {
+		m.proven.Add(blockID, struct{}{})
+		metrics.ProverMarketProofsFirstCounter().Inc(1)
+		return nil
+	}
+
+	assigned, err := assignedProver()
+	if err != nil {
+		return err
+	}
+	m.proven.Add(blockID, struct{}{})
+
+	if assigned == m.proverAddress {
+		log.Info("Block proven by another prover first", "blockID", blockID, "prover", prover)
+		metrics.ProverMarketProofsBeatenCounter().Inc(1)
+	}
+
+	return nil
+}
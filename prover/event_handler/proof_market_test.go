@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethMetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+)
+
+func TestProofMarketObserve(t *testing.T) {
+	enabled := gethMetrics.Enabled
+	gethMetrics.Enabled = true
+	defer func() { gethMetrics.Enabled = enabled }()
+
+	var (
+		prover  = common.HexToAddress("0x01")
+		another = common.HexToAddress("0x02")
+		market  = newProofMarket(prover)
+		first   = metrics.ProverMarketProofsFirstCounter().Snapshot().Count()
+		beaten  = metrics.ProverMarketProofsBeatenCounter().Snapshot().Count()
+	)
+	assignedTo := func(addr common.Address) func() (common.Address, error) {
+		return func() (common.Address, error) { return addr, nil }
+	}
+
+	// Another prover proves the block assigned to the current prover first.
+	require.Nil(t, market.observe(1, another, assignedTo(prover)))
+	require.Equal(t, beaten+1, metrics.ProverMarketProofsBeatenCounter().Snapshot().Count())
+
+	// Only the first proven transition of a block is counted.
+	require.Nil(t, market.observe(1, prover, assignedTo(prover)))
+	require.Nil(t, market.observe(1, another, assignedTo(prover)))
+	require.Equal(t, first, metrics.ProverMarketProofsFirstCounter().Snapshot().Count())
+	require.Equal(t, beaten+1, metrics.ProverMarketProofsBeatenCounter().Snapshot().Count())
+
+	// The current prover proves the block first.
+	require.Nil(t, market.observe(2, prover, assignedTo(another)))
+	require.Equal(t, first+1, metrics.ProverMarketProofsFirstCounter().Snapshot().Count())
+
+	// Another prover proves the block assigned to someone else.
+	require.Nil(t, market.observe(3, another, assignedTo(another)))
+	require.Equal(t, beaten+1, metrics.ProverMarketProofsBeatenCounter().Snapshot().Count())
+
+	// The block is observed again if its assigned prover can't be fetched.
+	errTest := errors.New("test")
+	require.ErrorIs(t, market.observe(4, another, func() (common.Address, error) {
+		return common.Address{}, errTest
+	}), errTest)
+	require.Nil(t, market.observe(4, another, assignedTo(prover)))
+	require.Equal(t, beaten+2, metrics.ProverMarketProofsBeatenCounter().Snapshot().Count())
+}
@@ -17,15 +17,17 @@ type TransitionProvedEventHandler struct {
 	rpc            *rpc.Client
 	proofContestCh chan<- *proofProducer.ContestRequestBody
 	contesterMode  bool
+	market         *proofMarket
 }
 
 // NewTransitionProvedEventHandler creates a new TransitionProvedEventHandler instance.
 func NewTransitionProvedEventHandler(
 	rpc *rpc.Client,
+	proverAddress common.Address,
 	proofContestCh chan *proofProducer.ContestRequestBody,
 	contesterMode bool,
 ) *TransitionProvedEventHandler {
-	return &TransitionProvedEventHandler{rpc, proofContestCh, contesterMode}
+	return &TransitionProvedEventHandler{rpc, proofContestCh, contesterMode, newProofMarket(proverAddress)}
 }
 
 // Handle implements the TransitionProvedHandler interface.
@@ -35,6 +37,17 @@ func (h *TransitionProvedEventHandler) Handle(
 ) error {
 	metrics.ProverReceivedProvenBlockGauge.Update(e.BlockId.Int64())
 
+	// Track the proof market competition, no need to retry the event if it fails.
+	if err := h.market.observe(e.BlockId.Uint64(), e.Prover, func() (common.Address, error) {
+		blockInfo, err := h.rpc.GetL2BlockInfo(ctx, e.BlockId)
+		if err != nil {
+			return common.Address{}, err
+		}
+		return blockInfo.Blk.AssignedProver, nil
+	}); err != nil {
+		log.Warn("Failed to track the proof market competition", "blockID", e.BlockId, "error", err)
+	}
+
 	// If this prover is in contest mode, we check the validity of this proof and if it's invalid,
 	// contest it with a higher tier proof.
 	if !h.contesterMode {
@@ -93,17 +93,15 @@ func (p *Prover) setApprovalAmount(ctx context.Context, contract common.Address)
 	return nil
 }
 
-// initProofSubmitters initializes the proof submitters from the given tiers in protocol.
+// initProofSubmitters initializes the proof submitters from the given tiers in protocol, all of them share
+// a proof producer registry of the given tiers.
 func (p *Prover) initProofSubmitters(
 	sender *sender.Sender,
 	txBuilder *transaction.ProveBlockTxBuilder,
 ) error {
+	var producers []proofProducer.ProofProducer
 	for _, tier := range p.sharedState.GetTiers() {
-		var (
-			producer  proofProducer.ProofProducer
-			submitter proofSubmitter.Submitter
-			err       error
-		)
+		var producer proofProducer.ProofProducer
 		switch tier.ID {
 		case encoding.TierOptimisticID:
 			producer = &proofProducer.OptimisticProofProducer{}
@@ -115,12 +113,37 @@ func (p *Prover) initProofSubmitters(
 				L2Endpoint:        p.cfg.L2HttpEndpoint,
 				Dummy:             p.cfg.Dummy,
 			}
+		case encoding.TierSgxAndZkVMID:
+			if p.cfg.ZKProverEndpoint == "" && !p.cfg.Dummy {
+				return fmt.Errorf("unsupported tier: %d, ZK proving service endpoint not set", tier.ID)
+			}
+			producer = &proofProducer.ZKProofProducer{
+				Endpoint:         p.cfg.ZKProverEndpoint,
+				L1Endpoint:       p.cfg.L1HttpEndpoint,
+				L1BeaconEndpoint: p.cfg.L1BeaconEndpoint,
+				L2Endpoint:       p.cfg.L2HttpEndpoint,
+				RequestTimeout:   p.cfg.ZKRequestTimeout,
+				PollingInterval:  p.cfg.ZKPollingInterval,
+				Dummy:            p.cfg.Dummy,
+			}
 		case encoding.TierGuardianID:
 			producer = proofProducer.NewGuardianProofProducer(p.cfg.EnableLivenessBondProof)
 		default:
 			return fmt.Errorf("unsupported tier: %d", tier.ID)
 		}
+		producers = append(producers, producer)
+	}
+
+	p.producerRegistry = proofProducer.NewProofProducerRegistry(producers...)
+	for tier, fallbacks := range p.cfg.FallbackTiers {
+		p.producerRegistry.SetFallbackTiers(tier, fallbacks...)
+	}
 
+	for _, producer := range producers {
+		var (
+			submitter *proofSubmitter.ProofSubmitter
+			err       error
+		)
 		if submitter, err = proofSubmitter.NewProofSubmitter(
 			p.rpc,
 			producer,
@@ -129,10 +152,33 @@ func (p *Prover) initProofSubmitters(
 			p.cfg.Graffiti,
 			sender,
 			txBuilder,
+			&proofSubmitter.ProofSubmitterConfig{
+				VerifySubmittedProof:  p.cfg.VerifySubmittedProof,
+				SubmissionCooldown:    p.cfg.SubmissionCooldown,
+				MaxProofAge:           p.cfg.MaxProofAge,
+				AbandonNotAssigned:    p.cfg.AbandonNotAssigned,
+				GraceWindow:           p.cfg.LateProofGraceWindow,
+				BlockFilter:           proofSubmitter.ModuloBlockFilter(p.cfg.BlockShards, p.cfg.BlockShardIndex),
+				DryRun:                p.cfg.ProofSubmissionDryRun,
+				RequestConcurrency:    p.cfg.ProducerConcurrency,
+				OrderedResults:        p.cfg.OrderedProofResults,
+				RequestDedupWindow:    p.cfg.ProofRequestDedupWindow,
+				SubmissionConcurrency: p.cfg.ProofSubmissionConcurrency,
+				RequestTimeout:        p.cfg.ProofRequestTimeout,
+			},
 		); err != nil {
 			return err
 		}
 
+		submitter.SetProducerRegistry(p.producerRegistry)
+		submitter.SetGraffiti(p.graffiti)
+		submitter.SetAssignmentHookAddress(p.cfg.AssignmentHookAddress)
+		submitter.SetGasPriceCeilings(p.cfg.GasPriceCeilings)
+		submitter.SetResubmission(&transaction.Resubmission{
+			MaxRetrys:         p.cfg.ResubmissionMaxRetrys,
+			MaxInterval:       p.cfg.ResubmissionMaxInterval,
+			GasBumpPercentage: p.cfg.ResubmissionGasBump,
+		})
 		p.proofSubmitters = append(p.proofSubmitters, submitter)
 	}
 
@@ -151,18 +197,36 @@ func (p *Prover) initL1Current(startingBlockID *big.Int) error {
 	}
 	p.genesisHeightL1 = stateVars.A.GenesisHeight
 
+	var startFromGenesis bool
 	if startingBlockID == nil {
-		if stateVars.B.LastVerifiedBlockId == 0 {
-			genesisL1Header, err := p.rpc.L1.HeaderByNumber(p.ctx, new(big.Int).SetUint64(stateVars.A.GenesisHeight))
-			if err != nil {
-				return err
-			}
+		startingBlockID = new(big.Int).SetUint64(stateVars.B.LastVerifiedBlockId)
+		startFromGenesis = stateVars.B.LastVerifiedBlockId == 0
+	}
 
-			p.sharedState.SetL1Current(genesisL1Header)
-			return nil
+	// Skip the old blocks if there are too many blocks to backfill.
+	if cappedID, capped := capStartupBackfill(
+		startingBlockID.Uint64(),
+		stateVars.B.NumBlocks-1,
+		p.cfg.MaxStartupBackfill,
+	); capped {
+		log.Warn(
+			"Too many blocks to backfill, skip the older blocks",
+			"startingBlockID", startingBlockID,
+			"cappedStartingBlockID", cappedID,
+			"maxStartupBackfill", p.cfg.MaxStartupBackfill,
+		)
+		startingBlockID = new(big.Int).SetUint64(cappedID)
+		startFromGenesis = false
+	}
+
+	if startFromGenesis {
+		genesisL1Header, err := p.rpc.L1.HeaderByNumber(p.ctx, new(big.Int).SetUint64(stateVars.A.GenesisHeight))
+		if err != nil {
+			return err
 		}
 
-		startingBlockID = new(big.Int).SetUint64(stateVars.B.LastVerifiedBlockId)
+		p.sharedState.SetL1Current(genesisL1Header)
+		return nil
 	}
 
 	log.Info("Init L1Current cursor", "startingBlockID", startingBlockID)
@@ -194,6 +258,16 @@ func (p *Prover) initL1Current(startingBlockID *big.Int) error {
 	return nil
 }
 
+// capStartupBackfill caps the starting block ID, so that at most maxBackfill blocks before the latest
+// proposed block will be backfilled, 0 means no limit. Reports whether the starting block ID is capped.
+func capStartupBackfill(startingBlockID uint64, latestBlockID uint64, maxBackfill uint64) (uint64, bool) {
+	if maxBackfill == 0 || latestBlockID <= startingBlockID || latestBlockID-startingBlockID <= maxBackfill {
+		return startingBlockID, false
+	}
+
+	return latestBlockID - maxBackfill, true
+}
+
 // initEventHandlers initialize all event handlers which will be used by the current prover.
 func (p *Prover) initEventHandlers() {
 	// ------- BlockProposed -------
@@ -210,6 +284,7 @@ func (p *Prover) initEventHandlers() {
 		BackOffMaxRetrys:      p.cfg.BackOffMaxRetrys,
 		ContesterMode:         p.cfg.ContesterMode,
 		ProveUnassignedBlocks: p.cfg.ProveUnassignedBlocks,
+		MaxReorgDepth:         p.cfg.MaxProverReorgDepth,
 	}
 	if p.IsGuardianProver() {
 		p.blockProposedHandler = handler.NewBlockProposedEventGuardianHandler(
@@ -224,6 +299,7 @@ func (p *Prover) initEventHandlers() {
 	// ------- TransitionProved -------
 	p.transitionProvedHandler = handler.NewTransitionProvedEventHandler(
 		p.rpc,
+		p.ProverAddress(),
 		p.proofContestCh,
 		p.cfg.ContesterMode,
 	)
@@ -3,10 +3,17 @@ package prover
 import (
 	"context"
 	"math/big"
+	"testing"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+	proofSubmitter "github.com/taikoxyz/taiko-client/prover/proof_submitter"
+	"github.com/taikoxyz/taiko-client/prover/proof_submitter/transaction"
 )
 
 func (s *ProverTestSuite) TestSetApprovalAmount() {
@@ -37,3 +44,57 @@ func (s *ProverTestSuite) TestSetApprovalAmount() {
 
 	s.Equal(0, amt.Cmp(allowance))
 }
+
+func (s *ProverTestSuite) TestInitProofSubmittersProducerRegistry() {
+	s.NotNil(s.p.producerRegistry)
+	s.Equal(len(s.p.proofSubmitters), len(s.p.producerRegistry.Tiers()))
+
+	for _, submitter := range s.p.proofSubmitters {
+		s.Same(s.p.producerRegistry, submitter.(*proofSubmitter.ProofSubmitter).ProducerRegistry())
+
+		producer, err := s.p.producerRegistry.Producer(submitter.Tier())
+		s.Nil(err)
+		s.Equal(submitter.Tier(), producer.Tier())
+
+		// The SGX + zkVM tier is served by the ZK proof producer.
+		if submitter.Tier() == encoding.TierSgxAndZkVMID {
+			s.IsType(&proofProducer.ZKProofProducer{}, producer)
+		}
+	}
+}
+
+func (s *ProverTestSuite) TestInitProofSubmittersFallbackTiers() {
+	s.p.cfg.FallbackTiers = map[uint16][]uint16{encoding.TierGuardianID: {encoding.TierOptimisticID}}
+	s.p.proofSubmitters = nil
+	s.Nil(s.p.initProofSubmitters(s.p.txSender, transaction.NewProveBlockTxBuilder(s.p.rpc)))
+
+	// The configured fallback tiers are used by all proof submitters.
+	for _, submitter := range s.p.proofSubmitters {
+		registry := submitter.(*proofSubmitter.ProofSubmitter).ProducerRegistry()
+		fallbacks := registry.Fallbacks(encoding.TierGuardianID, encoding.TierOptimisticID)
+		s.Len(fallbacks, 1)
+		s.Equal(encoding.TierOptimisticID, fallbacks[0].Tier())
+	}
+}
+
+func TestCapStartupBackfill(t *testing.T) {
+	// No limit.
+	id, capped := capStartupBackfill(0, 10_000, 0)
+	require.False(t, capped)
+	require.Equal(t, uint64(0), id)
+
+	// Within the limit.
+	id, capped = capStartupBackfill(9_000, 10_000, 1_000)
+	require.False(t, capped)
+	require.Equal(t, uint64(9_000), id)
+
+	// Backfill stops at the cap, the blocks after it will be processed.
+	id, capped = capStartupBackfill(0, 10_000, 1_000)
+	require.True(t, capped)
+	require.Equal(t, uint64(9_000), id)
+
+	// Starting block ID is ahead of the latest proposed block.
+	id, capped = capStartupBackfill(10_001, 10_000, 1_000)
+	require.False(t, capped)
+	require.Equal(t, uint64(10_001), id)
+}
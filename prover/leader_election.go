@@ -0,0 +1,179 @@
+package prover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-client/prover/lease"
+)
+
+var errLeaseExpired = errors.New("prover lease expired")
+
+// leaderElector makes sure only one prover in a HA setup is active at a time, the active prover keeps
+// renewing a shared lease, and a standby prover takes over once the lease expires.
+type leaderElector struct {
+	mu     sync.Mutex
+	lease  lease.Lease
+	holder string
+	ttl    time.Duration
+	// The active prover stops proving this long before its lease expires, to tolerate clock drifts
+	margin time.Duration
+	// Time until which the current prover is allowed to be active, zero if standby
+	activeUntil time.Time
+	// Closed when the current prover becomes active, nil if active
+	activeCh chan struct{}
+	nowFn    func() time.Time
+}
+
+// newLeaderElector creates a new leaderElector instance, returns nil if the given lease is nil,
+// which means the prover is always active.
+func newLeaderElector(l lease.Lease, holder string, ttl time.Duration) *leaderElector {
+	if l == nil {
+		return nil
+	}
+
+	return &leaderElector{
+		lease:    l,
+		holder:   holder,
+		ttl:      ttl,
+		margin:   ttl / 5,
+		activeCh: make(chan struct{}),
+		nowFn:    time.Now,
+	}
+}
+
+// defaultLeaseHolder returns the lease holder ID of the current prover process.
+func defaultLeaseHolder() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}
+
+// tick tries to acquire or renew the lease, and updates the current prover's state, returns whether the
+// current prover is active.
+func (e *leaderElector) tick() bool {
+	if e == nil {
+		return true
+	}
+
+	expiry, err := e.lease.TryAcquire(e.holder, e.ttl)
+	if err != nil {
+		log.Warn("Failed to acquire the prover lease", "holder", e.holder, "error", err)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	switch {
+	case err != nil:
+		// Keep the current state until the lease acquired before expires.
+	case expiry.IsZero():
+		e.activeUntil = time.Time{}
+	default:
+		e.activeUntil = expiry.Add(-e.margin)
+	}
+
+	active := e.nowFn().Before(e.activeUntil)
+	switch {
+	case active && e.activeCh != nil:
+		log.Info("Prover lease acquired, start proving", "holder", e.holder, "activeUntil", e.activeUntil)
+		close(e.activeCh)
+		e.activeCh = nil
+		metrics.ProverLeaderGauge.Update(1)
+	case !active && e.activeCh == nil:
+		log.Warn("Prover lease lost, switch to standby", "holder", e.holder)
+		e.activeCh = make(chan struct{})
+		metrics.ProverLeaderGauge.Update(0)
+	}
+
+	return active
+}
+
+// isActive returns whether the current prover is active.
+func (e *leaderElector) isActive() bool {
+	if e == nil {
+		return true
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.activeCh == nil && e.nowFn().Before(e.activeUntil)
+}
+
+// checkActive returns errLeaseExpired if the current prover is not active, it's checked before each proof
+// transaction is sent, so the in-flight submissions of a prover which just lost its lease are fenced.
+func (e *leaderElector) checkActive() error {
+	if !e.isActive() {
+		return errLeaseExpired
+	}
+
+	return nil
+}
+
+// wait blocks until the current prover is active, returns errLeaseExpired if the lease expires
+// before it can be renewed.
+func (e *leaderElector) wait(ctx context.Context) error {
+	if e == nil {
+		return nil
+	}
+
+	e.mu.Lock()
+	activeCh := e.activeCh
+	e.mu.Unlock()
+
+	if activeCh != nil {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-activeCh:
+		}
+	}
+
+	if !e.isActive() {
+		return errLeaseExpired
+	}
+
+	return nil
+}
+
+// release releases the lease if the current prover owns it, so a standby prover can take over immediately.
+func (e *leaderElector) release() {
+	if e == nil {
+		return
+	}
+
+	if err := e.lease.Release(e.holder); err != nil {
+		log.Warn("Failed to release the prover lease", "holder", e.holder, "error", err)
+	}
+}
+
+// leaderElectionLoop keeps acquiring or renewing the prover lease.
+func (p *Prover) leaderElectionLoop() {
+	p.wg.Add(1)
+	defer p.wg.Done()
+	defer p.leaderElector.release()
+
+	ticker := time.NewTicker(p.leaderElector.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		p.leaderElector.tick()
+
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
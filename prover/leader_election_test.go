@@ -0,0 +1,123 @@
+package prover
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/prover/lease"
+)
+
+// memoryLease is an in-memory lease.Lease implementation for testing.
+type memoryLease struct {
+	holder string
+	expiry time.Time
+	nowFn  func() time.Time
+}
+
+func (l *memoryLease) TryAcquire(holder string, ttl time.Duration) (time.Time, error) {
+	if l.holder != holder && l.nowFn().Before(l.expiry) {
+		return time.Time{}, nil
+	}
+	l.holder, l.expiry = holder, l.nowFn().Add(ttl)
+	return l.expiry, nil
+}
+
+func (l *memoryLease) Release(holder string) error {
+	if l.holder == holder {
+		l.holder, l.expiry = "", time.Time{}
+	}
+	return nil
+}
+
+// failingLease is a lease.Lease implementation for testing, which simulates a failed prover whose
+// lease can not be renewed anymore.
+type failingLease struct {
+	lease.Lease
+	failed bool
+}
+
+func (l *failingLease) TryAcquire(holder string, ttl time.Duration) (time.Time, error) {
+	if l.failed {
+		return time.Time{}, context.DeadlineExceeded
+	}
+	return l.Lease.TryAcquire(holder, ttl)
+}
+
+func TestLeaderElectorFailover(t *testing.T) {
+	var (
+		now       = time.Now()
+		ttl       = 30 * time.Second
+		shared    = &memoryLease{}
+		primaryL  = &failingLease{Lease: shared}
+		primary   = newLeaderElector(primaryL, "primary", ttl)
+		standby   = newLeaderElector(shared, "standby", ttl)
+		nowFn     = func() time.Time { return now }
+		noOverlap = func() {
+			require.False(t, primary.isActive() && standby.isActive(), "both provers are active at %s", now)
+		}
+	)
+	primary.nowFn, standby.nowFn, shared.nowFn = nowFn, nowFn, nowFn
+
+	// Disabled leader election.
+	require.Nil(t, newLeaderElector(nil, "", ttl))
+	require.True(t, (*leaderElector)(nil).tick())
+	require.Nil(t, (*leaderElector)(nil).wait(context.Background()))
+	require.Nil(t, (*leaderElector)(nil).checkActive())
+
+	// The primary prover acquires the lease, while the standby one waits.
+	require.True(t, primary.tick())
+	require.False(t, standby.tick())
+	require.Nil(t, primary.wait(context.Background()))
+	noOverlap()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, standby.wait(ctx), context.DeadlineExceeded)
+
+	// The primary prover keeps renewing the lease.
+	for i := 0; i < 3; i++ {
+		now = now.Add(ttl / 3)
+		require.True(t, primary.tick())
+		require.False(t, standby.tick())
+		noOverlap()
+	}
+
+	// The primary prover fails, it keeps active until shortly before its lease expires.
+	primaryL.failed = true
+	for i := 0; i < 2; i++ {
+		now = now.Add(ttl / 3)
+		require.True(t, primary.tick())
+		require.False(t, standby.tick())
+		noOverlap()
+	}
+	require.Nil(t, primary.checkActive())
+	now = now.Add(ttl / 5)
+	require.ErrorIs(t, primary.wait(context.Background()), errLeaseExpired)
+	// The in-flight submissions are fenced before sending any transaction.
+	require.ErrorIs(t, primary.checkActive(), errLeaseExpired)
+	noOverlap()
+	require.False(t, primary.tick())
+	require.False(t, standby.tick())
+	noOverlap()
+
+	// The standby prover takes over once the lease expires.
+	now = now.Add(ttl / 5)
+	require.True(t, standby.tick())
+	require.Nil(t, standby.wait(context.Background()))
+	require.False(t, primary.tick())
+	noOverlap()
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, primary.wait(ctx), context.DeadlineExceeded)
+
+	// The recovered primary prover stays standby.
+	primaryL.failed = false
+	now = now.Add(ttl / 3)
+	require.False(t, primary.tick())
+	require.True(t, standby.tick())
+	noOverlap()
+}
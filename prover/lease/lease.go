@@ -0,0 +1,185 @@
+package lease
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	// lockRetryInterval is the interval to retry acquiring the lease file's lock.
+	lockRetryInterval = 10 * time.Millisecond
+	// lockTimeout is the maximum time to wait for the lease file's lock.
+	lockTimeout = 5 * time.Second
+	// staleLockAge is the age after which a lock file is considered left by a crashed holder.
+	staleLockAge = 30 * time.Second
+
+	errLockTimeout = errors.New("timeout waiting for the lease file lock")
+)
+
+// Lease is a shared lease which makes sure only one holder is active at a time.
+type Lease interface {
+	// TryAcquire tries to acquire the lease for the given holder, or renews it if the holder already
+	// owns it, returns the lease's new expiry time, or a zero time if it's owned by another holder.
+	TryAcquire(holder string, ttl time.Duration) (time.Time, error)
+	// Release releases the lease if it's owned by the given holder.
+	Release(holder string) error
+}
+
+// state is the content of a lease file.
+type state struct {
+	Holder string    `json:"holder"`
+	Expiry time.Time `json:"expiry"`
+}
+
+// FileLease is a Lease implementation based on a file on a shared file system, the reads and writes of
+// the file are guarded by an exclusively created lock file.
+type FileLease struct {
+	path  string
+	nowFn func() time.Time
+}
+
+// NewFileLease creates a new FileLease instance with the given lease file path.
+func NewFileLease(path string) *FileLease {
+	return &FileLease{path: path, nowFn: time.Now}
+}
+
+// TryAcquire implements the Lease interface.
+func (l *FileLease) TryAcquire(holder string, ttl time.Duration) (time.Time, error) {
+	unlock, err := l.lock()
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer unlock()
+
+	current, err := l.read()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	now := l.nowFn()
+	if current != nil && current.Holder != holder && now.Before(current.Expiry) {
+		return time.Time{}, nil
+	}
+
+	expiry := now.Add(ttl)
+	if err := l.write(&state{Holder: holder, Expiry: expiry}); err != nil {
+		return time.Time{}, err
+	}
+
+	return expiry, nil
+}
+
+// Release implements the Lease interface.
+func (l *FileLease) Release(holder string) error {
+	unlock, err := l.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	current, err := l.read()
+	if err != nil || current == nil || current.Holder != holder {
+		return err
+	}
+
+	return os.Remove(l.path)
+}
+
+// lock creates the lock file exclusively, and returns a function to remove it.
+func (l *FileLease) lock() (func(), error) {
+	var (
+		lockPath = l.path + ".lock"
+		deadline = time.Now().Add(lockTimeout)
+	)
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(lockPath) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("failed to create the lease file lock: %w", err)
+		}
+
+		// Take over the lock file left by a crashed holder.
+		if info, err := os.Stat(lockPath); err == nil && time.Since(info.ModTime()) > staleLockAge {
+			takeOverStaleLock(lockPath, info)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, errLockTimeout
+		}
+		time.Sleep(lockRetryInterval)
+	}
+}
+
+// takeOverStaleLock removes the given stale lock file, which has been left by a crashed holder. The lock file
+// is atomically renamed to a unique path first, so when multiple holders take over the same stale lock at the
+// same time, only one of them can move it away, and if the lock file moved away turns out to be a fresh one
+// created by another holder in the meantime, it's put back.
+func takeOverStaleLock(lockPath string, stale os.FileInfo) {
+	movedPath := fmt.Sprintf("%s.stale-%d-%d", lockPath, os.Getpid(), time.Now().UnixNano())
+	if err := os.Rename(lockPath, movedPath); err != nil {
+		// Already taken over by another holder.
+		return
+	}
+	defer os.Remove(movedPath)
+
+	// The inode of a removed lock file may be reused, so the modification time is compared as well.
+	if moved, err := os.Stat(movedPath); err == nil &&
+		(!os.SameFile(stale, moved) || !moved.ModTime().Equal(stale.ModTime())) {
+		// Link fails if the lock file has been created again, it's never overwritten.
+		if err := os.Link(movedPath, lockPath); err != nil {
+			log.Warn("Failed to restore the lease file lock", "path", lockPath, "error", err)
+		}
+	}
+}
+
+// read reads the current lease state, returns nil if there is no lease.
+func (l *FileLease) read() (*state, error) {
+	b, err := os.ReadFile(l.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read the lease file: %w", err)
+	}
+
+	s := new(state)
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, fmt.Errorf("failed to decode the lease file: %w", err)
+	}
+
+	return s, nil
+}
+
+// write atomically replaces the lease file with the given state.
+func (l *FileLease) write(s *state) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(l.path), filepath.Base(l.path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create the lease file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write the lease file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write the lease file: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), l.path)
+}
@@ -0,0 +1,106 @@
+package lease
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileLease(t *testing.T) {
+	var (
+		now   = time.Now()
+		ttl   = 30 * time.Second
+		lease = NewFileLease(filepath.Join(t.TempDir(), "prover.lease"))
+	)
+	lease.nowFn = func() time.Time { return now }
+
+	// The first holder acquires the lease.
+	expiry, err := lease.TryAcquire("primary", ttl)
+	require.Nil(t, err)
+	require.Equal(t, now.Add(ttl), expiry)
+
+	// The lease is held by another holder.
+	expiry, err = lease.TryAcquire("standby", ttl)
+	require.Nil(t, err)
+	require.True(t, expiry.IsZero())
+
+	// The holder renews the lease.
+	now = now.Add(ttl / 2)
+	expiry, err = lease.TryAcquire("primary", ttl)
+	require.Nil(t, err)
+	require.Equal(t, now.Add(ttl), expiry)
+
+	// The lease expires, another holder takes over.
+	now = now.Add(ttl)
+	expiry, err = lease.TryAcquire("standby", ttl)
+	require.Nil(t, err)
+	require.Equal(t, now.Add(ttl), expiry)
+
+	expiry, err = lease.TryAcquire("primary", ttl)
+	require.Nil(t, err)
+	require.True(t, expiry.IsZero())
+
+	// Only the holder can release the lease.
+	require.Nil(t, lease.Release("primary"))
+	expiry, err = lease.TryAcquire("primary", ttl)
+	require.Nil(t, err)
+	require.True(t, expiry.IsZero())
+
+	require.Nil(t, lease.Release("standby"))
+	expiry, err = lease.TryAcquire("primary", ttl)
+	require.Nil(t, err)
+	require.False(t, expiry.IsZero())
+}
+
+func TestFileLeaseLock(t *testing.T) {
+	lockTimeout, staleLockAge = 50*time.Millisecond, time.Minute
+	defer func() { lockTimeout, staleLockAge = 5*time.Second, 30*time.Second }()
+
+	lease := NewFileLease(filepath.Join(t.TempDir(), "prover.lease"))
+
+	// The lock is held by another process.
+	require.Nil(t, os.WriteFile(lease.path+".lock", nil, 0o600))
+	_, err := lease.TryAcquire("primary", time.Minute)
+	require.ErrorIs(t, err, errLockTimeout)
+
+	// The lock is left by a crashed process.
+	staleAt := time.Now().Add(-2 * time.Minute)
+	require.Nil(t, os.Chtimes(lease.path+".lock", staleAt, staleAt))
+	expiry, err := lease.TryAcquire("primary", time.Minute)
+	require.Nil(t, err)
+	require.False(t, expiry.IsZero())
+}
+
+func TestTakeOverStaleLock(t *testing.T) {
+	lockPath := filepath.Join(t.TempDir(), "prover.lease.lock")
+	require.Nil(t, os.WriteFile(lockPath, nil, 0o600))
+	staleAt := time.Now().Add(-2 * time.Minute)
+	require.Nil(t, os.Chtimes(lockPath, staleAt, staleAt))
+	stale, err := os.Stat(lockPath)
+	require.Nil(t, err)
+
+	// The stale lock has been taken over, and a fresh lock is created by another holder in the meantime,
+	// the fresh lock is kept.
+	require.Nil(t, os.Remove(lockPath))
+	require.Nil(t, os.WriteFile(lockPath, nil, 0o600))
+	fresh, err := os.Stat(lockPath)
+	require.Nil(t, err)
+
+	takeOverStaleLock(lockPath, stale)
+	current, err := os.Stat(lockPath)
+	require.Nil(t, err)
+	require.True(t, os.SameFile(fresh, current))
+
+	// The stale lock is removed.
+	takeOverStaleLock(lockPath, current)
+	_, err = os.Stat(lockPath)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// No renamed lock file is left.
+	entries, err := os.ReadDir(filepath.Dir(lockPath))
+	require.Nil(t, err)
+	require.Empty(t, entries)
+}
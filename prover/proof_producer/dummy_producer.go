@@ -3,10 +3,12 @@ package producer
 import (
 	"bytes"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
 )
 
 // DummyProofProducer always returns a dummy proof.
@@ -20,12 +22,20 @@ func (o *DummyProofProducer) RequestProof(
 	header *types.Header,
 	tier uint16,
 ) (*ProofWithHeader, error) {
+	proof := bytes.Repeat([]byte{0xff}, 100)
+	// The SGX proofs have a fixed length, which is checked before the submission.
+	if tier == encoding.TierSgxID {
+		proof = proof[:SGXProofLength]
+	}
+
 	return &ProofWithHeader{
 		BlockID: blockID,
 		Meta:    meta,
 		Header:  header,
-		Proof:   bytes.Repeat([]byte{0xff}, 100),
+		Proof:   proof,
 		Opts:    opts,
 		Tier:    tier,
+
+		ProducedAt: time.Now(),
 	}, nil
 }
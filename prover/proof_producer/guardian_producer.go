@@ -3,6 +3,7 @@ package producer
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -48,6 +49,8 @@ func (g *GuardianProofProducer) RequestProof(
 			Proof:   crypto.Keccak256([]byte("RETURN_LIVENESS_BOND")),
 			Opts:    opts,
 			Tier:    g.Tier(),
+
+			ProducedAt: time.Now(),
 		}, nil
 	}
 
@@ -27,9 +27,11 @@ type ProofRequestBody struct {
 type ContestRequestBody struct {
 	BlockID    *big.Int
 	ProposedIn *big.Int
-	ParentHash common.Hash
-	Meta       *bindings.TaikoDataBlockMetadata
-	Tier       uint16
+	// The hash of the L1 block the L2 block was proposed in, from the BlockProposed event, zero if unknown
+	ProposedInL1Hash common.Hash
+	ParentHash       common.Hash
+	Meta             *bindings.TaikoDataBlockMetadata
+	Tier             uint16
 }
 
 // ProofRequestOptions contains all options that need to be passed to a backend proof producer service.
@@ -55,6 +57,8 @@ type ProofWithHeader struct {
 	Proof   []byte
 	Opts    *ProofRequestOptions
 	Tier    uint16
+	// Time when the proof was produced, zero if unknown
+	ProducedAt time.Time
 }
 
 type ProofProducer interface {
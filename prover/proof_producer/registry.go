@@ -0,0 +1,100 @@
+package producer
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// ErrUnsupportedTier is returned when no proof producer is registered for the requested tier.
+var ErrUnsupportedTier = errors.New("unsupported proof tier")
+
+// ProofProducerRegistry maps the proof tiers to their proof producers, so a prover supporting multiple
+// tiers can choose the proof producer for each block.
+type ProofProducerRegistry struct {
+	mu        sync.RWMutex
+	producers map[uint16]ProofProducer
+	// Tiers to fall back to, in order, when a tier's producer is unavailable or fails
+	fallbacks map[uint16][]uint16
+}
+
+// NewProofProducerRegistry creates a new ProofProducerRegistry instance with the given producers, each
+// producer is registered for its own tier.
+func NewProofProducerRegistry(producers ...ProofProducer) *ProofProducerRegistry {
+	r := &ProofProducerRegistry{
+		producers: make(map[uint16]ProofProducer),
+		fallbacks: make(map[uint16][]uint16),
+	}
+	for _, producer := range producers {
+		r.Register(producer)
+	}
+
+	return r
+}
+
+// Register registers the given producer for its tier, the producer previously registered for the same
+// tier is replaced.
+func (r *ProofProducerRegistry) Register(producer ProofProducer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.producers[producer.Tier()] = producer
+}
+
+// Producer returns the proof producer registered for the given tier, ErrUnsupportedTier is returned if
+// there is no such producer.
+func (r *ProofProducerRegistry) Producer(tier uint16) (ProofProducer, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	producer, ok := r.producers[tier]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedTier, tier)
+	}
+
+	return producer, nil
+}
+
+// SetFallbackTiers sets the tiers to fall back to, in the given order, when the given tier's producer is
+// unavailable or fails, the fallback tiers previously set for the same tier are replaced.
+func (r *ProofProducerRegistry) SetFallbackTiers(tier uint16, fallbacks ...uint16) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.fallbacks[tier] = slices.Clone(fallbacks)
+}
+
+// Fallbacks returns the registered proof producers of the given tier's fallback tiers, in order. The
+// fallback tiers below the given minimum tier are skipped, since TaikoL1 rejects the proofs whose tier
+// is lower than the block's minimum tier.
+func (r *ProofProducerRegistry) Fallbacks(tier uint16, minTier uint16) []ProofProducer {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var producers []ProofProducer
+	for _, fallback := range r.fallbacks[tier] {
+		if fallback == tier || fallback < minTier {
+			continue
+		}
+		if producer, ok := r.producers[fallback]; ok {
+			producers = append(producers, producer)
+		}
+	}
+
+	return producers
+}
+
+// Tiers returns the registered tiers in ascending order.
+func (r *ProofProducerRegistry) Tiers() []uint16 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tiers := make([]uint16, 0, len(r.producers))
+	for tier := range r.producers {
+		tiers = append(tiers, tier)
+	}
+	slices.Sort(tiers)
+
+	return tiers
+}
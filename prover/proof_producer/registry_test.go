@@ -0,0 +1,85 @@
+package producer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+func TestProofProducerRegistry(t *testing.T) {
+	var (
+		optimistic = &OptimisticProofProducer{}
+		guardian   = NewGuardianProofProducer(false)
+		registry   = NewProofProducerRegistry(guardian, optimistic)
+	)
+
+	require.Equal(t, []uint16{encoding.TierOptimisticID, encoding.TierGuardianID}, registry.Tiers())
+
+	producer, err := registry.Producer(encoding.TierOptimisticID)
+	require.Nil(t, err)
+	require.Same(t, optimistic, producer)
+
+	producer, err = registry.Producer(encoding.TierGuardianID)
+	require.Nil(t, err)
+	require.Same(t, guardian, producer)
+
+	// Unknown tiers are never served by another producer.
+	_, err = registry.Producer(encoding.TierSgxID)
+	require.ErrorIs(t, err, ErrUnsupportedTier)
+
+	// The producer of a tier can be replaced.
+	replaced := &OptimisticProofProducer{}
+	registry.Register(replaced)
+	producer, err = registry.Producer(encoding.TierOptimisticID)
+	require.Nil(t, err)
+	require.Same(t, replaced, producer)
+}
+
+func TestProofProducerRegistryZK(t *testing.T) {
+	var (
+		sgx      = &SGXProofProducer{}
+		zk       = &ZKProofProducer{}
+		registry = NewProofProducerRegistry(sgx, zk)
+	)
+
+	require.Equal(t, []uint16{encoding.TierSgxID, encoding.TierSgxAndZkVMID}, registry.Tiers())
+
+	producer, err := registry.Producer(encoding.TierSgxAndZkVMID)
+	require.Nil(t, err)
+	require.Same(t, zk, producer)
+}
+
+func TestProofProducerRegistryFallbacks(t *testing.T) {
+	var (
+		optimistic = &OptimisticProofProducer{}
+		guardian   = NewGuardianProofProducer(false)
+		registry   = NewProofProducerRegistry(optimistic, guardian)
+	)
+
+	// No fallback by default.
+	require.Empty(t, registry.Fallbacks(encoding.TierOptimisticID, encoding.TierOptimisticID))
+
+	// The unregistered fallback tiers and the tier itself are skipped.
+	registry.SetFallbackTiers(
+		encoding.TierOptimisticID,
+		encoding.TierSgxID,
+		encoding.TierOptimisticID,
+		encoding.TierGuardianID,
+	)
+	require.Equal(
+		t,
+		[]ProofProducer{guardian},
+		registry.Fallbacks(encoding.TierOptimisticID, encoding.TierOptimisticID),
+	)
+
+	// The fallback tiers below the block's minimum tier are not eligible.
+	registry.SetFallbackTiers(encoding.TierGuardianID, encoding.TierOptimisticID)
+	require.Empty(t, registry.Fallbacks(encoding.TierGuardianID, encoding.TierGuardianID))
+	require.Equal(
+		t,
+		[]ProofProducer{optimistic},
+		registry.Fallbacks(encoding.TierGuardianID, encoding.TierOptimisticID),
+	)
+}
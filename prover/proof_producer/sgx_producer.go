@@ -21,6 +21,10 @@ import (
 	"github.com/taikoxyz/taiko-client/internal/metrics"
 )
 
+// SGXProofLength is the length of a SGX proof expected by the SGX verifier, i.e. the 4 bytes instance ID,
+// the 20 bytes new instance address and the 65 bytes signature.
+const SGXProofLength = 89
+
 // SGXProofProducer generates a SGX proof for the given block.
 type SGXProofProducer struct {
 	RaikoHostEndpoint string // a proverd RPC endpoint
@@ -101,6 +105,8 @@ func (s *SGXProofProducer) RequestProof(
 		Proof:   proof,
 		Opts:    opts,
 		Tier:    s.Tier(),
+
+		ProducedAt: time.Now(),
 	}, nil
 }
 
@@ -0,0 +1,227 @@
+package producer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+// Statuses of a proof in the ZK proving service.
+const (
+	ZKProofStatusReady   = "ready"
+	ZKProofStatusPending = "pending"
+	ZKProofStatusFailed  = "failed"
+)
+
+// ErrZKProofFailed is returned when the ZK proving service fails to generate the requested proof,
+// polling it again won't help.
+var ErrZKProofFailed = errors.New("ZK proof generation failed")
+
+// ZKProofProducer generates a ZK proof for the given block, by an external ZK proving service.
+type ZKProofProducer struct {
+	Endpoint         string // the ZK proving service's HTTP endpoint
+	L1Endpoint       string // a L1 node RPC endpoint
+	L1BeaconEndpoint string // a L1 beacon node RPC endpoint
+	L2Endpoint       string // a L2 execution engine's RPC endpoint
+	// Timeout of each HTTP request to the proving service, 0 means no timeout
+	RequestTimeout time.Duration
+	// Interval between the polls of a proof which is still generating, 0 means the default interval
+	PollingInterval time.Duration
+	// Tier of the produced proofs, 0 means the SGX + zkVM tier
+	ProofTier uint16
+	Dummy     bool
+	DummyProofProducer
+}
+
+// ZKRequestProofBody represents the JSON body for requesting the proof from the ZK proving service.
+type ZKRequestProofBody struct {
+	Tier        uint16      `json:"tier"`
+	Block       *big.Int    `json:"block"`
+	L2RPC       string      `json:"l2Rpc"`
+	L1RPC       string      `json:"l1Rpc"`
+	L1BeaconRPC string      `json:"l1BeaconRpc"`
+	Prover      string      `json:"prover"`
+	MetaHash    common.Hash `json:"metaHash"`
+	BlockHash   common.Hash `json:"blockHash"`
+	Graffiti    string      `json:"graffiti"`
+}
+
+// ZKRequestProofResponse represents the JSON body of the ZK proving service's response, the proof is
+// only set when the status is ready, and the error is only set when the status is failed.
+type ZKRequestProofResponse struct {
+	Status string `json:"status"`
+	Proof  string `json:"proof"`
+	Error  string `json:"error,omitempty"`
+}
+
+// RequestProof implements the ProofProducer interface.
+func (z *ZKProofProducer) RequestProof(
+	ctx context.Context,
+	opts *ProofRequestOptions,
+	blockID *big.Int,
+	meta *bindings.TaikoDataBlockMetadata,
+	header *types.Header,
+) (*ProofWithHeader, error) {
+	log.Info(
+		"Request proof from ZK proving service",
+		"blockID", blockID,
+		"coinbase", meta.Coinbase,
+		"height", header.Number,
+		"hash", header.Hash(),
+		"tier", z.Tier(),
+	)
+
+	if z.Dummy {
+		return z.DummyProofProducer.RequestProof(opts, blockID, meta, header, z.Tier())
+	}
+
+	proof, err := z.pollProof(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProofWithHeader{
+		BlockID: blockID,
+		Header:  header,
+		Meta:    meta,
+		Proof:   proof,
+		Opts:    opts,
+		Tier:    z.Tier(),
+
+		ProducedAt: time.Now(),
+	}, nil
+}
+
+// pollProof keeps polling the ZK proving service until the requested proof is ready, or the service
+// fails to generate it.
+func (z *ZKProofProducer) pollProof(ctx context.Context, opts *ProofRequestOptions) ([]byte, error) {
+	var (
+		proof    []byte
+		start    = time.Now()
+		interval = z.PollingInterval
+	)
+	if interval == 0 {
+		interval = proofPollingInterval
+	}
+
+	if err := backoff.Retry(func() error {
+		if ctx.Err() != nil {
+			return backoff.Permanent(ctx.Err())
+		}
+
+		output, err := z.requestProof(ctx, opts)
+		if err != nil {
+			log.Error("Failed to request ZK proof", "height", opts.BlockID, "error", err, "endpoint", z.Endpoint)
+			return err
+		}
+
+		switch output.Status {
+		case ZKProofStatusPending:
+			log.Info("Proof generating", "height", opts.BlockID, "time", time.Since(start), "producer", "ZKProofProducer")
+			return errProofGenerating
+		case ZKProofStatusFailed:
+			return backoff.Permanent(fmt.Errorf("%w: height %d, %s", ErrZKProofFailed, opts.BlockID, output.Error))
+		case ZKProofStatusReady:
+			if proof, err = decodeZKProof(output.Proof); err != nil {
+				return backoff.Permanent(err)
+			}
+			log.Info("Proof generated", "height", opts.BlockID, "time", time.Since(start), "producer", "ZKProofProducer")
+			return nil
+		default:
+			return fmt.Errorf("unknown ZK proof status: %s", output.Status)
+		}
+	}, backoff.WithContext(backoff.NewConstantBackOff(interval), ctx)); err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
+// requestProof sends a request to the ZK proving service to try to get the requested proof.
+func (z *ZKProofProducer) requestProof(
+	ctx context.Context,
+	opts *ProofRequestOptions,
+) (*ZKRequestProofResponse, error) {
+	reqBody := ZKRequestProofBody{
+		Tier:        z.Tier(),
+		Block:       opts.BlockID,
+		L2RPC:       z.L2Endpoint,
+		L1RPC:       z.L1Endpoint,
+		L1BeaconRPC: z.L1BeaconEndpoint,
+		Prover:      opts.ProverAddress.Hex()[2:],
+		MetaHash:    opts.MetaHash,
+		BlockHash:   opts.BlockHash,
+		Graffiti:    opts.Graffiti,
+	}
+
+	jsonValue, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if z.RequestTimeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, z.RequestTimeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, z.Endpoint, bytes.NewBuffer(jsonValue))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to request ZK proof, id: %d, statusCode: %d", opts.BlockID, res.StatusCode)
+	}
+
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var output ZKRequestProofResponse
+	if err := json.Unmarshal(resBytes, &output); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// decodeZKProof decodes the given hex encoded proof, which can't be empty.
+func decodeZKProof(proof string) ([]byte, error) {
+	b := common.FromHex(proof)
+	if len(b) == 0 {
+		return nil, fmt.Errorf("%w: empty proof", ErrZKProofFailed)
+	}
+
+	return b, nil
+}
+
+// Tier implements the ProofProducer interface.
+func (z *ZKProofProducer) Tier() uint16 {
+	if z.ProofTier == 0 {
+		return encoding.TierSgxAndZkVMID
+	}
+	return z.ProofTier
+}
@@ -0,0 +1,120 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+// newZKProvingService creates a fake ZK proving service, which responds with the given responses in
+// order, and keeps responding with the last one.
+func newZKProvingService(t *testing.T, responses ...*ZKRequestProofResponse) (*httptest.Server, *atomic.Int64) {
+	var polls atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body ZKRequestProofBody
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, encoding.TierSgxAndZkVMID, body.Tier)
+
+		i := int(polls.Add(1)) - 1
+		if i >= len(responses) {
+			i = len(responses) - 1
+		}
+		require.Nil(t, json.NewEncoder(w).Encode(responses[i]))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &polls
+}
+
+func TestZKProducerRequestProof(t *testing.T) {
+	srv, polls := newZKProvingService(
+		t,
+		&ZKRequestProofResponse{Status: ZKProofStatusPending},
+		&ZKRequestProofResponse{Status: ZKProofStatusPending},
+		&ZKRequestProofResponse{Status: ZKProofStatusReady, Proof: "0x0102"},
+	)
+
+	var (
+		producer = &ZKProofProducer{Endpoint: srv.URL, PollingInterval: time.Millisecond, RequestTimeout: time.Second}
+		blockID  = common.Big32
+		header   = &types.Header{Number: common.Big32}
+	)
+	res, err := producer.RequestProof(
+		context.Background(),
+		&ProofRequestOptions{BlockID: blockID},
+		blockID,
+		&bindings.TaikoDataBlockMetadata{},
+		header,
+	)
+	require.Nil(t, err)
+
+	require.Equal(t, blockID, res.BlockID)
+	require.Equal(t, header, res.Header)
+	require.Equal(t, encoding.TierSgxAndZkVMID, res.Tier)
+	require.Equal(t, []byte{0x01, 0x02}, res.Proof)
+	require.Equal(t, int64(3), polls.Load())
+}
+
+func TestZKProducerRequestProofFailed(t *testing.T) {
+	srv, polls := newZKProvingService(
+		t,
+		&ZKRequestProofResponse{Status: ZKProofStatusPending},
+		&ZKRequestProofResponse{Status: ZKProofStatusFailed, Error: "out of memory"},
+	)
+
+	producer := &ZKProofProducer{Endpoint: srv.URL, PollingInterval: time.Millisecond}
+	_, err := producer.RequestProof(
+		context.Background(),
+		&ProofRequestOptions{BlockID: common.Big1},
+		common.Big1,
+		&bindings.TaikoDataBlockMetadata{},
+		&types.Header{},
+	)
+	require.ErrorIs(t, err, ErrZKProofFailed)
+	require.ErrorContains(t, err, "out of memory")
+
+	// A hard failure is never polled again.
+	require.Equal(t, int64(2), polls.Load())
+}
+
+func TestZKProducerRequestProofCancelled(t *testing.T) {
+	srv, _ := newZKProvingService(t, &ZKRequestProofResponse{Status: ZKProofStatusPending})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	producer := &ZKProofProducer{Endpoint: srv.URL, PollingInterval: 10 * time.Millisecond}
+	_, err := producer.RequestProof(
+		ctx,
+		&ProofRequestOptions{BlockID: common.Big1},
+		common.Big1,
+		&bindings.TaikoDataBlockMetadata{},
+		&types.Header{},
+	)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestZKProducerDummy(t *testing.T) {
+	producer := &ZKProofProducer{Dummy: true, ProofTier: 400}
+	res, err := producer.RequestProof(
+		context.Background(),
+		&ProofRequestOptions{},
+		common.Big1,
+		&bindings.TaikoDataBlockMetadata{},
+		&types.Header{},
+	)
+	require.Nil(t, err)
+	require.Equal(t, uint16(400), res.Tier)
+	require.NotEmpty(t, res.Proof)
+}
@@ -0,0 +1,133 @@
+package prover
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+// proofRequestPriorityGrowth controls how fast a queued request's priority grows, a request
+// which reaches its proving deadline will have a priority of 2^proofRequestPriorityGrowth.
+const proofRequestPriorityGrowth = 10
+
+// proofRequestQueue is a deadline-aware priority queue of the pending proof requests. A request's
+// priority rises exponentially as it ages towards its proving deadline, so the old requests won't be
+// starved by a flood of newly proposed blocks.
+type proofRequestQueue struct {
+	mu       sync.Mutex
+	items    []*proofProducer.ProofRequestBody
+	notifyCh chan struct{}
+
+	tiersFn func() []*rpc.TierProviderTierWithID
+	nowFn   func() time.Time
+}
+
+// newProofRequestQueue creates a new proofRequestQueue instance.
+func newProofRequestQueue(tiersFn func() []*rpc.TierProviderTierWithID) *proofRequestQueue {
+	return &proofRequestQueue{notifyCh: make(chan struct{}, 1), tiersFn: tiersFn, nowFn: time.Now}
+}
+
+// Push adds a new proof request to the queue.
+func (q *proofRequestQueue) Push(req *proofProducer.ProofRequestBody) {
+	q.mu.Lock()
+	q.items = append(q.items, req)
+	q.mu.Unlock()
+
+	select {
+	case q.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+// Pop removes and returns the request with the highest priority, returns nil if the queue is empty.
+func (q *proofRequestQueue) Pop() *proofProducer.ProofRequestBody {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.items) == 0 {
+		return nil
+	}
+
+	var (
+		now         = q.nowFn()
+		tiers       = q.tiersFn()
+		idx         = 0
+		maxPriority = q.priority(q.items[0].Event, tiers, now)
+	)
+	for i := 1; i < len(q.items); i++ {
+		priority := q.priority(q.items[i].Event, tiers, now)
+		if priority > maxPriority ||
+			(priority == maxPriority && q.items[i].Event.BlockId.Cmp(q.items[idx].Event.BlockId) < 0) {
+			idx, maxPriority = i, priority
+		}
+	}
+
+	req := q.items[idx]
+	q.items = append(q.items[:idx], q.items[idx+1:]...)
+
+	// Wake up another waiting worker if there are still some requests left.
+	if len(q.items) != 0 {
+		select {
+		case q.notifyCh <- struct{}{}:
+		default:
+		}
+	}
+
+	return req
+}
+
+// Len returns the number of requests in the queue.
+func (q *proofRequestQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.items)
+}
+
+// TierCounts returns the number of queued requests of each tier.
+func (q *proofRequestQueue) TierCounts() map[uint16]int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	counts := make(map[uint16]int)
+	for _, req := range q.items {
+		counts[req.Tier]++
+	}
+	return counts
+}
+
+// Notify returns a channel which will be signalled when there are new requests in the queue.
+func (q *proofRequestQueue) Notify() <-chan struct{} {
+	return q.notifyCh
+}
+
+// priority returns the given request's current priority, which grows exponentially with the
+// ratio of the elapsed time since the block was proposed to its proving window.
+func (q *proofRequestQueue) priority(
+	e *bindings.TaikoL1ClientBlockProposed,
+	tiers []*rpc.TierProviderTierWithID,
+	now time.Time,
+) float64 {
+	var provingWindow time.Duration
+	for _, t := range tiers {
+		if e.Meta.MinTier == t.ID {
+			provingWindow = time.Duration(t.ProvingWindow) * time.Minute
+			break
+		}
+	}
+	// Unknown tier, treat the request as an urgent one.
+	if provingWindow == 0 {
+		return math.Exp2(proofRequestPriorityGrowth)
+	}
+
+	elapsed := now.Sub(time.Unix(int64(e.Meta.Timestamp), 0))
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	return math.Exp2(proofRequestPriorityGrowth * float64(elapsed) / float64(provingWindow))
+}
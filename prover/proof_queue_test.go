@@ -0,0 +1,81 @@
+package prover
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+func newTestProofRequest(blockID uint64, minTier uint16, proposedAt time.Time) *proofProducer.ProofRequestBody {
+	return &proofProducer.ProofRequestBody{
+		Tier: minTier,
+		Event: &bindings.TaikoL1ClientBlockProposed{
+			BlockId: new(big.Int).SetUint64(blockID),
+			Meta: bindings.TaikoDataBlockMetadata{
+				Id:        blockID,
+				MinTier:   minTier,
+				Timestamp: uint64(proposedAt.Unix()),
+			},
+		},
+	}
+}
+
+func TestProofRequestQueueOldRequestsFirst(t *testing.T) {
+	var (
+		now   = time.Now()
+		tiers = []*rpc.TierProviderTierWithID{
+			{ID: encoding.TierOptimisticID, ITierProviderTier: bindings.ITierProviderTier{ProvingWindow: 60}},
+			{ID: encoding.TierSgxID, ITierProviderTier: bindings.ITierProviderTier{ProvingWindow: 120}},
+		}
+		q = newProofRequestQueue(func() []*rpc.TierProviderTierWithID { return tiers })
+	)
+	q.nowFn = func() time.Time { return now }
+
+	// Interleave old and new requests.
+	q.Push(newTestProofRequest(10, encoding.TierOptimisticID, now))
+	q.Push(newTestProofRequest(1, encoding.TierOptimisticID, now.Add(-55*time.Minute)))
+	q.Push(newTestProofRequest(11, encoding.TierOptimisticID, now))
+	q.Push(newTestProofRequest(2, encoding.TierSgxID, now.Add(-100*time.Minute)))
+	q.Push(newTestProofRequest(3, encoding.TierSgxID, now.Add(-30*time.Minute)))
+	q.Push(newTestProofRequest(12, encoding.TierOptimisticID, now))
+	require.Equal(t, 6, q.Len())
+
+	var served []uint64
+	for req := q.Pop(); req != nil; req = q.Pop() {
+		served = append(served, req.Event.BlockId.Uint64())
+
+		// Each request is served before its proving deadline.
+		for _, tier := range tiers {
+			if tier.ID == req.Event.Meta.MinTier {
+				deadline := time.Unix(int64(req.Event.Meta.Timestamp), 0).
+					Add(time.Duration(tier.ProvingWindow) * time.Minute)
+				require.True(t, now.Before(deadline))
+			}
+		}
+	}
+
+	require.Equal(t, []uint64{1, 2, 3, 10, 11, 12}, served)
+	require.Zero(t, q.Len())
+}
+
+func TestProofRequestQueueUnknownTier(t *testing.T) {
+	var (
+		now = time.Now()
+		q   = newProofRequestQueue(func() []*rpc.TierProviderTierWithID { return nil })
+	)
+	q.nowFn = func() time.Time { return now }
+
+	q.Push(newTestProofRequest(2, encoding.TierOptimisticID, now))
+	q.Push(newTestProofRequest(1, encoding.TierOptimisticID, now))
+
+	require.Equal(t, uint64(1), q.Pop().Event.BlockId.Uint64())
+	require.Equal(t, uint64(2), q.Pop().Event.BlockId.Uint64())
+	require.Nil(t, q.Pop())
+}
@@ -0,0 +1,43 @@
+package submitter
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+)
+
+// BlockFilter returns whether the current prover should handle the given L2 block, so the block space
+// can be partitioned across a prover fleet.
+type BlockFilter func(blockID *big.Int) bool
+
+// ModuloBlockFilter returns a BlockFilter which only matches the block IDs satisfying
+// `blockID % shards == shard`, returns nil if shards is not greater than 1, which means all blocks
+// are handled.
+func ModuloBlockFilter(shards uint64, shard uint64) BlockFilter {
+	if shards <= 1 {
+		return nil
+	}
+
+	var (
+		n = new(big.Int).SetUint64(shards)
+		r = new(big.Int).SetUint64(shard)
+	)
+	return func(blockID *big.Int) bool {
+		return new(big.Int).Mod(blockID, n).Cmp(r) == 0
+	}
+}
+
+// skipBlock returns whether the given block should be skipped by the current prover, according to
+// the block filter.
+func (s *ProofSubmitter) skipBlock(blockID *big.Int) bool {
+	if s.blockFilter == nil || s.blockFilter(blockID) {
+		return false
+	}
+
+	log.Debug("Skip the block not handled by the current prover", "blockID", blockID, "tier", s.Tier())
+	metrics.ProverFilteredBlocksCounter.Inc(1)
+
+	return true
+}
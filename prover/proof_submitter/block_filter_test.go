@@ -0,0 +1,40 @@
+package submitter
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+func TestModuloBlockFilter(t *testing.T) {
+	require.Nil(t, ModuloBlockFilter(0, 0))
+	require.Nil(t, ModuloBlockFilter(1, 0))
+
+	var (
+		s       = &ProofSubmitter{proofProducer: &proofProducer.OptimisticProofProducer{}}
+		handled []uint64
+	)
+	require.False(t, s.skipBlock(common.Big1))
+
+	s.blockFilter = ModuloBlockFilter(3, 1)
+	for i := uint64(0); i < 10; i++ {
+		blockID := new(big.Int).SetUint64(i)
+		if !s.skipBlock(blockID) {
+			handled = append(handled, i)
+			continue
+		}
+
+		// The skipped blocks are never requested, so no RPC client is needed here.
+		require.Nil(t, s.RequestProof(
+			context.Background(),
+			&bindings.TaikoL1ClientBlockProposed{BlockId: blockID},
+		))
+	}
+	require.Equal(t, []uint64{1, 4, 7}, handled)
+}
@@ -0,0 +1,113 @@
+package submitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// ErrInsufficientContestBond is returned when the contester does not have enough Taiko token balance
+// or allowance to pay the contest bond.
+var ErrInsufficientContestBond = errors.New("insufficient contest bond")
+
+// Revert reasons of the Taiko token, when TaikoL1 fails to pull the contest bond from the contester.
+var insufficientBondReverts = []string{
+	"ERC20: transfer amount exceeds balance",
+	"ERC20: insufficient allowance",
+}
+
+// isInsufficientContestBond checks whether the given contest transaction error is caused by an
+// insufficient contest bond.
+func isInsufficientContestBond(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrInsufficientContestBond) {
+		return true
+	}
+
+	for _, revert := range insufficientBondReverts {
+		if strings.Contains(err.Error(), revert) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handleContestError handles the error of a contest transaction, an insufficient contest bond error is
+// wrapped with ErrInsufficientContestBond, or skipped with a warning if skipInsufficientBond is set,
+// since retrying won't help until the bond is topped up.
+func handleContestError(blockID *big.Int, err error, skipInsufficientBond bool) error {
+	if !isInsufficientContestBond(err) {
+		return err
+	}
+
+	metrics.ProverContestBondInsufficientCounter.Inc(1)
+	if !skipInsufficientBond {
+		return fmt.Errorf("%w: %w", ErrInsufficientContestBond, err)
+	}
+
+	log.Warn(
+		"Contester does not have enough bond, skip contesting the transition",
+		"blockID", blockID,
+		"error", err,
+	)
+	return nil
+}
+
+// CheckContestBond checks whether the contester's Taiko token balance and its allowance to TaikoL1,
+// which pulls the contest bond when a transition is contested, both cover the largest contest bond of
+// the given tiers.
+func (c *ProofContester) CheckContestBond(
+	ctx context.Context,
+	taikoL1Address common.Address,
+	tiers []*rpc.TierProviderTierWithID,
+) error {
+	opts := &bind.CallOpts{Context: ctx}
+
+	balance, err := c.rpc.TaikoToken.BalanceOf(opts, c.contesterAddress)
+	if err != nil {
+		return fmt.Errorf("failed to get contester's Taiko token balance: %w", encoding.TryParsingCustomError(err))
+	}
+
+	allowance, err := c.rpc.TaikoToken.Allowance(opts, c.contesterAddress, taikoL1Address)
+	if err != nil {
+		return fmt.Errorf("failed to get contester's Taiko token allowance: %w", encoding.TryParsingCustomError(err))
+	}
+
+	return checkContestBond(balance, allowance, tiers)
+}
+
+// checkContestBond checks whether the given balance and allowance both cover the largest contest bond of
+// the given tiers.
+func checkContestBond(balance *big.Int, allowance *big.Int, tiers []*rpc.TierProviderTierWithID) error {
+	required := new(big.Int)
+	for _, tier := range tiers {
+		if tier.ContestBond != nil && tier.ContestBond.Cmp(required) > 0 {
+			required = tier.ContestBond
+		}
+	}
+
+	if balance.Cmp(required) >= 0 && allowance.Cmp(required) >= 0 {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"%w: balance %s, allowance %s, required %s",
+		ErrInsufficientContestBond,
+		balance,
+		allowance,
+		required,
+	)
+}
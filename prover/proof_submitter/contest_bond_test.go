@@ -0,0 +1,57 @@
+package submitter
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// revertReasonError mocks a go-ethereum JSON-RPC error of a call reverted with a reason string.
+type revertReasonError struct{ reason string }
+
+func (e *revertReasonError) Error() string { return "execution reverted: " + e.reason }
+
+func TestHandleContestError(t *testing.T) {
+	for _, reason := range insufficientBondReverts {
+		err := &revertReasonError{reason: reason}
+		require.True(t, isInsufficientContestBond(err))
+
+		// Skipped if configured.
+		require.Nil(t, handleContestError(big.NewInt(256), err, true))
+
+		// Otherwise surfaced with a dedicated error.
+		handled := handleContestError(big.NewInt(256), err, false)
+		require.ErrorIs(t, handled, ErrInsufficientContestBond)
+		require.ErrorContains(t, handled, reason)
+	}
+
+	// Other errors are returned as is.
+	err := &revertReasonError{reason: "L1_INVALID_TIER"}
+	require.False(t, isInsufficientContestBond(err))
+	require.Equal(t, err, handleContestError(big.NewInt(256), err, true))
+	require.Nil(t, handleContestError(big.NewInt(256), nil, true))
+}
+
+func TestCheckContestBond(t *testing.T) {
+	tiers := []*rpc.TierProviderTierWithID{
+		{ID: 100, ITierProviderTier: bindings.ITierProviderTier{ContestBond: big.NewInt(10)}},
+		{ID: 200, ITierProviderTier: bindings.ITierProviderTier{ContestBond: big.NewInt(20)}},
+		{ID: 1000, ITierProviderTier: bindings.ITierProviderTier{ContestBond: big.NewInt(0)}},
+	}
+
+	require.Nil(t, checkContestBond(big.NewInt(20), big.NewInt(20), tiers))
+	require.Nil(t, checkContestBond(big.NewInt(0), big.NewInt(0), nil))
+
+	for _, c := range [][2]int64{{19, 20}, {20, 19}, {0, 0}} {
+		err := checkContestBond(big.NewInt(c[0]), big.NewInt(c[1]), tiers)
+		require.ErrorIs(t, err, ErrInsufficientContestBond, fmt.Sprint(c))
+		require.True(t, isInsufficientContestBond(err))
+	}
+	require.False(t, isInsufficientContestBond(errors.New("nonce too low")))
+}
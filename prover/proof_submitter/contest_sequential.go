@@ -0,0 +1,74 @@
+package submitter
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+// ContestRequest is a L2 block transition to contest.
+type ContestRequest struct {
+	BlockID    *big.Int
+	ProposedIn *big.Int
+	// The hash of the L1 block the L2 block was proposed in, from the original event, which is used to
+	// look up the L1 block instead of ProposedIn if set
+	ProposedInL1Hash common.Hash
+	ParentHash       common.Hash
+	Meta             *bindings.TaikoDataBlockMetadata
+	Tier             uint16
+}
+
+// SubmitContestsSequentially contests the given L2 block transitions one after another, each in its own
+// TaikoL1.proveBlock transaction, and returns the error of each request at the same index, so one bad
+// request won't abort the others. The transitions which have already been contested, or are duplicated
+// in the given requests, are filtered out before any contest transaction is sent.
+//
+// NOTE: the contests can't be sent in a single transaction. The TaikoL1 contract has no batch proving
+// entry, and a generic multicall transaction doesn't work either, since TaikoL1 takes the contest bond
+// from, and credits the rewards to, msg.sender, which would be the multicall contract instead of the
+// contester. So contesting several transitions together costs as much gas and as many nonces as
+// contesting them one by one.
+func (c *ProofContester) SubmitContestsSequentially(ctx context.Context, reqs []*ContestRequest) []error {
+	var (
+		errs        = make([]error, len(reqs))
+		transitions = make([]*bindings.TaikoDataTransitionState, len(reqs))
+		seen        = make(map[contestKey]struct{}, len(reqs))
+	)
+
+	// Run the pre-flight checks of all requests first, and filter out the ones not to contest.
+	for i, req := range reqs {
+		key := contestKey{blockID: req.BlockID.Uint64(), parentHash: req.ParentHash}
+		if _, ok := seen[key]; ok {
+			log.Info("Skip duplicated contest request", "blockID", req.BlockID, "parentHash", req.ParentHash)
+			continue
+		}
+		seen[key] = struct{}{}
+
+		transitions[i], errs[i] = c.checkContest(ctx, req)
+	}
+
+	var contested int
+	for i, req := range reqs {
+		if errs[i] != nil || transitions[i] == nil {
+			continue
+		}
+		if ctx.Err() != nil {
+			errs[i] = ctx.Err()
+			continue
+		}
+
+		if errs[i] = c.sendContest(ctx, req, transitions[i]); errs[i] != nil {
+			log.Error("Failed to contest a transition", "blockID", req.BlockID, "error", errs[i])
+			continue
+		}
+		contested++
+	}
+
+	log.Info("Contest transitions sequentially", "requests", len(reqs), "contested", contested)
+
+	return errs
+}
@@ -0,0 +1,127 @@
+package submitter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// pendingContestSnapshot is the snapshot of a contest which has not been resolved yet.
+type pendingContestSnapshot struct {
+	BlockID      uint64      `json:"blockID"`
+	ParentHash   common.Hash `json:"parentHash"`
+	BlockHash    common.Hash `json:"blockHash"`
+	StateRoot    common.Hash `json:"stateRoot"`
+	Tier         uint16      `json:"tier"`
+	ValidityBond *big.Int    `json:"validityBond"`
+	ContestBond  *big.Int    `json:"contestBond"`
+}
+
+// contestTrackerSnapshot is the snapshot of a ContestTracker's accounting state.
+type contestTrackerSnapshot struct {
+	Contester common.Address            `json:"contester"`
+	Pending   []*pendingContestSnapshot `json:"pending"`
+	Results   []*ContestResult          `json:"results"`
+}
+
+// SaveSnapshot atomically writes the tracked contests and the resolved contest results to the given file,
+// so the accounting state survives a crash, the file is only replaced once the snapshot is fully written.
+func (t *ContestTracker) SaveSnapshot(path string) error {
+	t.mu.Lock()
+	snapshot := &contestTrackerSnapshot{Contester: t.contester, Results: t.results}
+	for key, contest := range t.pending {
+		snapshot.Pending = append(snapshot.Pending, &pendingContestSnapshot{
+			BlockID:      key.blockID,
+			ParentHash:   key.parentHash,
+			BlockHash:    contest.blockHash,
+			StateRoot:    contest.stateRoot,
+			Tier:         contest.tier,
+			ValidityBond: contest.validityBond,
+			ContestBond:  contest.contestBond,
+		})
+	}
+	b, err := json.Marshal(snapshot)
+	t.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create the accounting snapshot: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write the accounting snapshot: %w", err)
+	}
+	// Flush the snapshot to the disk before replacing the old one, so a crash never leaves a partial snapshot.
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write the accounting snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write the accounting snapshot: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// LoadSnapshot recovers the tracked contests and the resolved contest results from the given snapshot file,
+// nothing is recovered if the file doesn't exist, or the snapshot was taken for another contester.
+func (t *ContestTracker) LoadSnapshot(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to read the accounting snapshot: %w", err)
+	}
+
+	snapshot := new(contestTrackerSnapshot)
+	if err := json.Unmarshal(b, snapshot); err != nil {
+		return fmt.Errorf("failed to decode the accounting snapshot: %w", err)
+	}
+	if snapshot.Contester != t.contester {
+		log.Warn(
+			"Ignore the accounting snapshot of another contester",
+			"path", path,
+			"contester", t.contester,
+			"snapshotContester", snapshot.Contester,
+		)
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, contest := range snapshot.Pending {
+		t.pending[contestKey{contest.BlockID, contest.ParentHash}] = &trackedContest{
+			blockHash:    contest.BlockHash,
+			stateRoot:    contest.StateRoot,
+			tier:         contest.Tier,
+			validityBond: contest.ValidityBond,
+			contestBond:  contest.ContestBond,
+		}
+	}
+	t.results = append(snapshot.Results, t.results...)
+	if len(t.results) > maxContestResults {
+		t.results = t.results[len(t.results)-maxContestResults:]
+	}
+
+	log.Info(
+		"Recovered the accounting snapshot",
+		"path", path,
+		"pending", len(snapshot.Pending),
+		"results", len(snapshot.Results),
+	)
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package submitter
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/internal/testutils"
+)
+
+func TestContestTrackerSnapshot(t *testing.T) {
+	var (
+		path       = filepath.Join(t.TempDir(), "accounting.json")
+		contester  = common.HexToAddress("0x01")
+		tracker    = NewContestTracker(contester)
+		parentHash = testutils.RandomHash()
+		contested  = &bindings.TaikoDataTransitionState{
+			BlockHash:    testutils.RandomHash(),
+			StateRoot:    testutils.RandomHash(),
+			Tier:         encoding.TierOptimisticID,
+			ValidityBond: big.NewInt(400),
+		}
+		provedEvent = func(blockID int64) *bindings.TaikoL1ClientTransitionProved {
+			return &bindings.TaikoL1ClientTransitionProved{
+				BlockId: big.NewInt(blockID),
+				Tran: bindings.TaikoDataTransition{
+					ParentHash: parentHash,
+					BlockHash:  testutils.RandomHash(),
+					StateRoot:  testutils.RandomHash(),
+				},
+				Tier: encoding.TierSgxID,
+			}
+		}
+	)
+
+	// Nothing to recover before the first snapshot.
+	require.Nil(t, tracker.LoadSnapshot(path))
+	require.Zero(t, tracker.Pending())
+
+	tracker.Track(big.NewInt(1), parentHash, contested)
+	tracker.Track(big.NewInt(2), parentHash, contested)
+	won := tracker.OnTransitionProved(provedEvent(1))
+	require.NotNil(t, won)
+	require.Nil(t, tracker.SaveSnapshot(path))
+
+	// The accounting state after the last snapshot is lost in the crash.
+	tracker.Track(big.NewInt(3), parentHash, contested)
+
+	// Recover from the last snapshot after the crash, without any graceful shutdown.
+	recovered := NewContestTracker(contester)
+	require.Nil(t, recovered.LoadSnapshot(path))
+	require.Equal(t, 1, recovered.Pending())
+	require.Len(t, recovered.Results(), 1)
+	require.Equal(t, won.BlockID, recovered.Results()[0].BlockID)
+	require.Equal(t, won.Outcome, recovered.Results()[0].Outcome)
+	require.Equal(t, won.Reward, recovered.Results()[0].Reward)
+
+	// The recovered pending contest can still be resolved.
+	result := recovered.OnTransitionProved(provedEvent(2))
+	require.NotNil(t, result)
+	require.Equal(t, ContestOutcomeWon, result.Outcome)
+	require.Equal(t, big.NewInt(100), result.Reward)
+	require.Zero(t, recovered.Pending())
+
+	// No temporary files are left behind.
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.Nil(t, err)
+	require.Len(t, entries, 1)
+
+	// The snapshot of another contester is ignored.
+	other := NewContestTracker(common.HexToAddress("0x02"))
+	require.Nil(t, other.LoadSnapshot(path))
+	require.Zero(t, other.Pending())
+	require.Empty(t, other.Results())
+
+	// A corrupted snapshot is reported.
+	require.Nil(t, os.WriteFile(path, []byte("{"), 0o600))
+	require.NotNil(t, NewContestTracker(contester).LoadSnapshot(path))
+}
@@ -0,0 +1,177 @@
+package submitter
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+)
+
+// maxContestResults is the maximum number of the latest contest results kept by a ContestTracker.
+const maxContestResults = 1024
+
+// ContestOutcome is the outcome of a resolved contest.
+type ContestOutcome string
+
+// All contest outcomes.
+const (
+	ContestOutcomeWon  ContestOutcome = "won"
+	ContestOutcomeLost ContestOutcome = "lost"
+)
+
+// ContestResult describes a resolved contest submitted by us.
+type ContestResult struct {
+	BlockID    *big.Int       `json:"blockID"`
+	ParentHash common.Hash    `json:"parentHash"`
+	Outcome    ContestOutcome `json:"outcome"`
+	// The tier of the contested proof, and the tier of the proof which resolved the contest
+	ContestedTier uint16 `json:"contestedTier"`
+	ResolvedTier  uint16 `json:"resolvedTier"`
+	// The prover of the proof which resolved the contest
+	ResolvedBy common.Address `json:"resolvedBy"`
+	// The reward paid to us if the contest is won, which is a quarter of the contested prover's validity
+	// bond, our contest bond is returned as well
+	Reward *big.Int `json:"reward"`
+	// The penalty paid by us if the contest is lost, which is our whole contest bond
+	Penalty    *big.Int  `json:"penalty"`
+	ResolvedAt time.Time `json:"resolvedAt"`
+}
+
+// contestKey identifies a transition.
+type contestKey struct {
+	blockID    uint64
+	parentHash common.Hash
+}
+
+// trackedContest is a contest submitted by us which has not been resolved yet.
+type trackedContest struct {
+	blockHash    common.Hash
+	stateRoot    common.Hash
+	tier         uint16
+	validityBond *big.Int
+	contestBond  *big.Int
+}
+
+// ContestTracker tracks the contests submitted by us until they are resolved by a higher tier proof,
+// and records their outcomes.
+type ContestTracker struct {
+	contester common.Address
+
+	mu      sync.Mutex
+	pending map[contestKey]*trackedContest
+	results []*ContestResult
+}
+
+// NewContestTracker creates a new ContestTracker instance for the given contester.
+func NewContestTracker(contester common.Address) *ContestTracker {
+	return &ContestTracker{contester: contester, pending: make(map[contestKey]*trackedContest)}
+}
+
+// Track starts tracking the contest of the given transition, which has just been submitted by us.
+func (t *ContestTracker) Track(
+	blockID *big.Int,
+	parentHash common.Hash,
+	transition *bindings.TaikoDataTransitionState,
+) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.pending[contestKey{blockID.Uint64(), parentHash}] = &trackedContest{
+		blockHash:    transition.BlockHash,
+		stateRoot:    transition.StateRoot,
+		tier:         transition.Tier,
+		validityBond: transition.ValidityBond,
+		contestBond:  new(big.Int),
+	}
+}
+
+// OnTransitionContested records the contest bond paid by us, when the given contest is submitted by us.
+func (t *ContestTracker) OnTransitionContested(e *bindings.TaikoL1ClientTransitionContested) {
+	if e.Contester != t.contester {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if contest, ok := t.pending[contestKey{e.BlockId.Uint64(), e.Tran.ParentHash}]; ok && e.ContestBond != nil {
+		contest.contestBond = e.ContestBond
+	}
+}
+
+// OnTransitionProved resolves the tracked contest of the given transition, if the transition is proved
+// with a higher tier proof, and returns the result, nil if no tracked contest is resolved.
+func (t *ContestTracker) OnTransitionProved(e *bindings.TaikoL1ClientTransitionProved) *ContestResult {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := contestKey{e.BlockId.Uint64(), e.Tran.ParentHash}
+	contest, ok := t.pending[key]
+	if !ok || e.Tier <= contest.tier {
+		return nil
+	}
+	delete(t.pending, key)
+
+	result := &ContestResult{
+		BlockID:       e.BlockId,
+		ParentHash:    e.Tran.ParentHash,
+		ContestedTier: contest.tier,
+		ResolvedTier:  e.Tier,
+		ResolvedBy:    e.Prover,
+		Reward:        new(big.Int),
+		Penalty:       new(big.Int),
+		ResolvedAt:    time.Now(),
+	}
+	// If the higher tier proof proves the contested transition, we lose the contest.
+	if e.Tran.BlockHash == contest.blockHash && e.Tran.StateRoot == contest.stateRoot {
+		result.Outcome = ContestOutcomeLost
+		result.Penalty.Set(contest.contestBond)
+		metrics.ProverContestLostCounter.Inc(1)
+	} else {
+		result.Outcome = ContestOutcomeWon
+		if contest.validityBond != nil {
+			result.Reward.Rsh(contest.validityBond, 2)
+		}
+		metrics.ProverContestWonCounter.Inc(1)
+	}
+
+	t.results = append(t.results, result)
+	if len(t.results) > maxContestResults {
+		t.results = t.results[len(t.results)-maxContestResults:]
+	}
+
+	log.Info(
+		"Contest resolved",
+		"blockID", result.BlockID,
+		"parentHash", result.ParentHash,
+		"outcome", result.Outcome,
+		"contestedTier", result.ContestedTier,
+		"resolvedTier", result.ResolvedTier,
+		"resolvedBy", result.ResolvedBy,
+		"reward", result.Reward,
+		"penalty", result.Penalty,
+	)
+
+	return result
+}
+
+// Results returns the latest resolved contests, ordered by the resolving time.
+func (t *ContestTracker) Results() []*ContestResult {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return append([]*ContestResult{}, t.results...)
+}
+
+// Pending returns the number of the contests which have not been resolved yet.
+func (t *ContestTracker) Pending() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return len(t.pending)
+}
@@ -0,0 +1,101 @@
+package submitter
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/internal/testutils"
+)
+
+func TestContestTracker(t *testing.T) {
+	var (
+		contester  = common.HexToAddress("0x01")
+		other      = common.HexToAddress("0x02")
+		tracker    = NewContestTracker(contester)
+		parentHash = testutils.RandomHash()
+		contested  = &bindings.TaikoDataTransitionState{
+			BlockHash:    testutils.RandomHash(),
+			StateRoot:    testutils.RandomHash(),
+			Tier:         encoding.TierOptimisticID,
+			ValidityBond: big.NewInt(400),
+		}
+		contestBond = big.NewInt(1000)
+	)
+
+	contestedEvent := func(blockID int64, contester common.Address) *bindings.TaikoL1ClientTransitionContested {
+		return &bindings.TaikoL1ClientTransitionContested{
+			BlockId: big.NewInt(blockID),
+			Tran: bindings.TaikoDataTransition{
+				ParentHash: parentHash,
+				BlockHash:  contested.BlockHash,
+				StateRoot:  contested.StateRoot,
+			},
+			Contester:   contester,
+			ContestBond: contestBond,
+			Tier:        contested.Tier,
+		}
+	}
+	provedEvent := func(
+		blockID int64,
+		tier uint16,
+		blockHash common.Hash,
+		stateRoot common.Hash,
+	) *bindings.TaikoL1ClientTransitionProved {
+		return &bindings.TaikoL1ClientTransitionProved{
+			BlockId: big.NewInt(blockID),
+			Tran:    bindings.TaikoDataTransition{ParentHash: parentHash, BlockHash: blockHash, StateRoot: stateRoot},
+			Prover:  other,
+			Tier:    tier,
+		}
+	}
+
+	tracker.Track(big.NewInt(1), parentHash, contested)
+	tracker.Track(big.NewInt(2), parentHash, contested)
+	tracker.OnTransitionContested(contestedEvent(1, contester))
+	tracker.OnTransitionContested(contestedEvent(2, contester))
+	// Contests by others are ignored.
+	tracker.OnTransitionContested(contestedEvent(3, other))
+	require.Equal(t, 2, tracker.Pending())
+
+	// Proofs not in a higher tier don't resolve the contests.
+	require.Nil(t, tracker.OnTransitionProved(
+		provedEvent(1, encoding.TierOptimisticID, testutils.RandomHash(), testutils.RandomHash()),
+	))
+	require.Nil(t, tracker.OnTransitionProved(
+		provedEvent(3, encoding.TierSgxID, testutils.RandomHash(), testutils.RandomHash()),
+	))
+	require.Equal(t, 2, tracker.Pending())
+
+	// The contested transition is proven to be invalid, we win the contest.
+	won := tracker.OnTransitionProved(
+		provedEvent(1, encoding.TierSgxID, testutils.RandomHash(), testutils.RandomHash()),
+	)
+	require.NotNil(t, won)
+	require.Equal(t, ContestOutcomeWon, won.Outcome)
+	require.Equal(t, big.NewInt(100), won.Reward)
+	require.Zero(t, won.Penalty.Sign())
+	require.Equal(t, encoding.TierOptimisticID, won.ContestedTier)
+	require.Equal(t, encoding.TierSgxID, won.ResolvedTier)
+	require.Equal(t, other, won.ResolvedBy)
+
+	// The contested transition is proven to be valid, we lose the contest.
+	lost := tracker.OnTransitionProved(
+		provedEvent(2, encoding.TierSgxID, contested.BlockHash, contested.StateRoot),
+	)
+	require.NotNil(t, lost)
+	require.Equal(t, ContestOutcomeLost, lost.Outcome)
+	require.Zero(t, lost.Reward.Sign())
+	require.Equal(t, contestBond, lost.Penalty)
+
+	// Resolved contests are not tracked anymore.
+	require.Zero(t, tracker.Pending())
+	require.Nil(t, tracker.OnTransitionProved(
+		provedEvent(1, encoding.TierGuardianID, testutils.RandomHash(), testutils.RandomHash()),
+	))
+	require.Equal(t, []*ContestResult{won, lost}, tracker.Results())
+}
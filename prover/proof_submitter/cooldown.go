@@ -0,0 +1,102 @@
+package submitter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/taikoxyz/taiko-client/internal/utils"
+)
+
+// maxSubmissionCooldownDoublings is the maximum number of times a block's submission cooldown
+// will be doubled after repeated failures.
+const maxSubmissionCooldownDoublings = 6
+
+// submissionCooldown records the failed proof submissions of a single block.
+type submissionCooldown struct {
+	failures int
+	retryAt  time.Time
+}
+
+// submissionCooldowns keeps the per-block cooldowns after failed proof submissions, so a block
+// whose submission keeps failing for a transient reason will not hammer the chain. A block's
+// cooldown doubles on each repeated failure.
+type submissionCooldowns struct {
+	mu     sync.Mutex
+	base   time.Duration
+	blocks map[uint64]*submissionCooldown
+	nowFn  func() time.Time
+}
+
+// newSubmissionCooldowns creates a new submissionCooldowns instance, returns nil if the
+// given base cooldown is zero, which means no cooldown.
+func newSubmissionCooldowns(base time.Duration) *submissionCooldowns {
+	if base == 0 {
+		return nil
+	}
+
+	return &submissionCooldowns{base: base, blocks: make(map[uint64]*submissionCooldown), nowFn: time.Now}
+}
+
+// wait blocks until the cooldown of the given block is over.
+func (c *submissionCooldowns) wait(ctx context.Context, blockID uint64) error {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	cooldown, ok := c.blocks[blockID]
+	var remaining time.Duration
+	if ok {
+		remaining = cooldown.retryAt.Sub(c.nowFn())
+	}
+	c.mu.Unlock()
+
+	if remaining <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(remaining)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// fail records a failed submission of the given block, and returns the block's new cooldown.
+func (c *submissionCooldowns) fail(blockID uint64) time.Duration {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cooldown, ok := c.blocks[blockID]
+	if !ok {
+		cooldown = new(submissionCooldown)
+		c.blocks[blockID] = cooldown
+	}
+
+	duration := c.base << utils.Min(cooldown.failures, maxSubmissionCooldownDoublings)
+	cooldown.failures++
+	cooldown.retryAt = c.nowFn().Add(duration)
+
+	return duration
+}
+
+// reset removes the cooldown of the given block.
+func (c *submissionCooldowns) reset(blockID uint64) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.blocks, blockID)
+}
@@ -0,0 +1,121 @@
+package submitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+var errProvingWindowClosed = errors.New("proving window closed")
+
+// withGraceWindow returns a copy of the given context whose deadline is extended by the given grace
+// window, it is still cancelled as soon as the parent context is cancelled for any other reason.
+// The given context is returned as it is if the grace window is zero or it has no deadline.
+func withGraceWindow(ctx context.Context, grace time.Duration) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	if grace == 0 || !ok {
+		return ctx, func() {}
+	}
+
+	graceCtx, cancel := context.WithDeadline(context.WithoutCancel(ctx), deadline.Add(grace))
+	stop := context.AfterFunc(ctx, func() {
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			cancel()
+		}
+	})
+
+	return graceCtx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// produceProof requests a proof from the block's proof producer, once a slot of the request pool is available.
+// If the given context has a deadline, the producer is allowed to run within the grace window after it,
+// and the second return value reports whether the proof arrived late, after the given context's deadline.
+func (s *ProofSubmitter) produceProof(
+	ctx context.Context,
+	opts *proofProducer.ProofRequestOptions,
+	event *bindings.TaikoL1ClientBlockProposed,
+	header *types.Header,
+) (*proofProducer.ProofWithHeader, bool, error) {
+	// Retrying won't help if there is no proof producer for the block, nor any fallback.
+	fallbacks := s.fallbackProducers(&event.Meta)
+	producer, err := s.producer(&event.Meta)
+	if err != nil {
+		if len(fallbacks) == 0 {
+			return nil, false, backoff.Permanent(err)
+		}
+		log.Warn("Proof producer unavailable, fall back", "blockID", event.BlockId, "fallbackTier", fallbacks[0].Tier())
+		producer, fallbacks = fallbacks[0], fallbacks[1:]
+	}
+
+	release, err := s.requestPool.acquire(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	defer release()
+
+	producerCtx, cancel := withGraceWindow(ctx, s.graceWindow)
+	defer cancel()
+
+	result, err := producer.RequestProof(producerCtx, opts, event.BlockId, &event.Meta, header)
+	for err != nil && len(fallbacks) != 0 && producerCtx.Err() == nil {
+		log.Warn(
+			"Proof producer failed, fall back",
+			"blockID", event.BlockId,
+			"tier", producer.Tier(),
+			"fallbackTier", fallbacks[0].Tier(),
+			"error", err,
+		)
+		producer, fallbacks = fallbacks[0], fallbacks[1:]
+		result, err = producer.RequestProof(producerCtx, opts, event.BlockId, &event.Meta, header)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return result, ctx.Err() != nil, nil
+}
+
+// acceptLateProof checks whether a proof which arrived after the request's deadline can still be
+// submitted, i.e. the block's proving window is still open.
+func (s *ProofSubmitter) acceptLateProof(ctx context.Context, event *bindings.TaikoL1ClientBlockProposed) error {
+	tiers, err := s.rpc.GetTiers(context.WithoutCancel(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to get tiers: %w", err)
+	}
+	if !provingWindowOpen(&event.Meta, tiers, time.Now()) {
+		return fmt.Errorf("%w: late proof of block %d discarded", errProvingWindowClosed, event.BlockId)
+	}
+
+	log.Info("Accepting a late proof within the grace window", "blockID", event.BlockId, "minTier", event.Meta.MinTier)
+	metrics.ProverLateProofAcceptedCounter.Inc(1)
+
+	return nil
+}
+
+// provingWindowOpen checks whether the proving window of the block with the given metadata is still open.
+func provingWindowOpen(meta *bindings.TaikoDataBlockMetadata, tiers []*rpc.TierProviderTierWithID, now time.Time) bool {
+	for _, t := range tiers {
+		if t.ID != meta.MinTier {
+			continue
+		}
+
+		expiredAt := new(big.Int).SetUint64(meta.Timestamp + uint64(t.ProvingWindow)*60)
+		return big.NewInt(now.Unix()).Cmp(expiredAt) <= 0
+	}
+
+	return false
+}
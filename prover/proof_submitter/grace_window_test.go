@@ -0,0 +1,179 @@
+package submitter
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	producer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+// slowProofProducer is a ProofProducer implementation for testing, which takes the given time to
+// produce a proof, and respects the context.
+type slowProofProducer struct {
+	producer.DummyProofProducer
+	delay time.Duration
+}
+
+func (p *slowProofProducer) RequestProof(
+	ctx context.Context,
+	opts *producer.ProofRequestOptions,
+	blockID *big.Int,
+	meta *bindings.TaikoDataBlockMetadata,
+	header *types.Header,
+) (*producer.ProofWithHeader, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(p.delay):
+	}
+
+	return p.DummyProofProducer.RequestProof(opts, blockID, meta, header, p.Tier())
+}
+
+func (p *slowProofProducer) Tier() uint16 {
+	return encoding.TierOptimisticID
+}
+
+// failingProofProducer is a ProofProducer implementation for testing, which always fails.
+type failingProofProducer struct {
+	tier uint16
+	err  error
+}
+
+func (p *failingProofProducer) RequestProof(
+	_ context.Context,
+	_ *producer.ProofRequestOptions,
+	_ *big.Int,
+	_ *bindings.TaikoDataBlockMetadata,
+	_ *types.Header,
+) (*producer.ProofWithHeader, error) {
+	return nil, p.err
+}
+
+func (p *failingProofProducer) Tier() uint16 {
+	return p.tier
+}
+
+func TestProduceProofFallback(t *testing.T) {
+	var (
+		errFailed = errors.New("producer failed")
+		failing   = &failingProofProducer{tier: encoding.TierOptimisticID, err: errFailed}
+		guardian  = producer.NewGuardianProofProducer(false)
+		opts      = &producer.ProofRequestOptions{}
+		event     = &bindings.TaikoL1ClientBlockProposed{
+			BlockId: common.Big1,
+			Meta:    bindings.TaikoDataBlockMetadata{MinTier: encoding.TierOptimisticID},
+		}
+	)
+
+	registry := producer.NewProofProducerRegistry(failing, guardian)
+	submitter := &ProofSubmitter{}
+	submitter.SetProducerRegistry(registry)
+
+	// No fallback tier.
+	_, _, err := submitter.produceProof(context.Background(), opts, event, &types.Header{})
+	require.ErrorIs(t, err, errFailed)
+
+	// The preferred tier's producer fails, the fallback tier is used.
+	registry.SetFallbackTiers(encoding.TierOptimisticID, encoding.TierGuardianID)
+	proof, late, err := submitter.produceProof(context.Background(), opts, event, &types.Header{})
+	require.Nil(t, err)
+	require.False(t, late)
+	require.Equal(t, encoding.TierGuardianID, proof.Tier)
+
+	// The preferred tier's producer is unavailable, the fallback tier is used.
+	registry = producer.NewProofProducerRegistry(guardian)
+	registry.SetFallbackTiers(encoding.TierOptimisticID, encoding.TierGuardianID)
+	submitter.SetProducerRegistry(registry)
+	proof, _, err = submitter.produceProof(context.Background(), opts, event, &types.Header{})
+	require.Nil(t, err)
+	require.Equal(t, encoding.TierGuardianID, proof.Tier)
+
+	// The fallback tiers below the block's minimum tier are not eligible.
+	event.Meta.MinTier = encoding.TierSgxID
+	registry.SetFallbackTiers(encoding.TierSgxID, encoding.TierOptimisticID)
+	registry.Register(failing)
+	_, _, err = submitter.produceProof(context.Background(), opts, event, &types.Header{})
+	require.ErrorIs(t, err, producer.ErrUnsupportedTier)
+}
+
+func TestProduceProofGraceWindow(t *testing.T) {
+	var (
+		event = &bindings.TaikoL1ClientBlockProposed{BlockId: common.Big1}
+		opts  = &producer.ProofRequestOptions{}
+	)
+	produce := func(delay time.Duration, grace time.Duration) (*producer.ProofWithHeader, bool, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		submitter := &ProofSubmitter{proofProducer: &slowProofProducer{delay: delay}, graceWindow: grace}
+		return submitter.produceProof(ctx, opts, event, &types.Header{})
+	}
+
+	// In time.
+	proof, late, err := produce(10*time.Millisecond, 0)
+	require.Nil(t, err)
+	require.NotNil(t, proof)
+	require.False(t, late)
+
+	// The producer finishes just past the deadline, without a grace window.
+	_, _, err = produce(80*time.Millisecond, 0)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The producer finishes just past the deadline, within the grace window.
+	proof, late, err = produce(80*time.Millisecond, 200*time.Millisecond)
+	require.Nil(t, err)
+	require.NotNil(t, proof)
+	require.True(t, late)
+
+	// The producer finishes after the grace window.
+	_, _, err = produce(500*time.Millisecond, 100*time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestGraceWindowCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	graceCtx, graceCancel := withGraceWindow(ctx, time.Minute)
+	defer graceCancel()
+
+	deadline, ok := graceCtx.Deadline()
+	require.True(t, ok)
+	require.Greater(t, time.Until(deadline), time.Minute)
+
+	// Cancelling the parent context for any other reason cancels the grace window too.
+	cancel()
+	select {
+	case <-graceCtx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("grace window context not cancelled")
+	}
+}
+
+func TestProvingWindowOpen(t *testing.T) {
+	var (
+		now   = time.Now()
+		tiers = []*rpc.TierProviderTierWithID{{ID: encoding.TierOptimisticID}}
+		meta  = &bindings.TaikoDataBlockMetadata{MinTier: encoding.TierOptimisticID}
+	)
+	tiers[0].ProvingWindow = 10
+
+	meta.Timestamp = uint64(now.Add(-5 * time.Minute).Unix())
+	require.True(t, provingWindowOpen(meta, tiers, now))
+
+	meta.Timestamp = uint64(now.Add(-15 * time.Minute).Unix())
+	require.False(t, provingWindowOpen(meta, tiers, now))
+
+	// Unknown tier.
+	meta.MinTier = encoding.TierSgxID
+	require.False(t, provingWindowOpen(meta, tiers, now))
+}
@@ -0,0 +1,46 @@
+package submitter
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+// ErrGraffitiTooLong is returned when the given graffiti doesn't fit in 32 bytes.
+var ErrGraffitiTooLong = errors.New("graffiti too long")
+
+// Graffiti is the graffiti attached to the submitted transitions, which can be updated at runtime,
+// it is safe for concurrent use.
+type Graffiti struct {
+	mu    sync.RWMutex
+	value [32]byte
+}
+
+// NewGraffiti creates a new Graffiti instance with the given initial graffiti, which is truncated
+// to 32 bytes.
+func NewGraffiti(graffiti string) *Graffiti {
+	return &Graffiti{value: rpc.StringToBytes32(graffiti)}
+}
+
+// Set updates the graffiti, the transitions submitted afterwards carry the new graffiti.
+func (g *Graffiti) Set(graffiti string) error {
+	if len(graffiti) > 32 {
+		return fmt.Errorf("%w: %d bytes, max 32 bytes", ErrGraffitiTooLong, len(graffiti))
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.value = rpc.StringToBytes32(graffiti)
+	return nil
+}
+
+// Bytes32 returns the current graffiti.
+func (g *Graffiti) Bytes32() [32]byte {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.value
+}
@@ -0,0 +1,38 @@
+package submitter
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/pkg/rpc"
+)
+
+func TestGraffiti(t *testing.T) {
+	graffiti := NewGraffiti("initial")
+	require.Equal(t, rpc.StringToBytes32("initial"), graffiti.Bytes32())
+
+	require.Nil(t, graffiti.Set("rotated"))
+	require.Equal(t, rpc.StringToBytes32("rotated"), graffiti.Bytes32())
+
+	require.ErrorIs(t, graffiti.Set(strings.Repeat("a", 33)), ErrGraffitiTooLong)
+	require.Equal(t, rpc.StringToBytes32("rotated"), graffiti.Bytes32())
+
+	// Concurrent updates and reads.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			require.Nil(t, graffiti.Set("concurrent"))
+		}()
+		go func() {
+			defer wg.Done()
+			graffiti.Bytes32()
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, rpc.StringToBytes32("concurrent"), graffiti.Bytes32())
+}
@@ -14,8 +14,13 @@ import (
 type Submitter interface {
 	RequestProof(ctx context.Context, event *bindings.TaikoL1ClientBlockProposed) error
 	SubmitProof(ctx context.Context, proofWithHeader *proofProducer.ProofWithHeader) error
+	SubmitProofs(ctx context.Context, proofs []*proofProducer.ProofWithHeader) []error
 	Producer() proofProducer.ProofProducer
 	Tier() uint16
+	// OnBlockVerified and OnTransitionContested clear the remembered proof requests of the blocks which
+	// have been verified or contested, so they won't be skipped as duplicates.
+	OnBlockVerified(blockID *big.Int)
+	OnTransitionContested(blockID *big.Int)
 }
 
 // Contester is the interface for contesting proofs of the L2 blocks.
@@ -28,4 +33,13 @@ type Contester interface {
 		meta *bindings.TaikoDataBlockMetadata,
 		tier uint16,
 	) error
+	SubmitContestByHash(
+		ctx context.Context,
+		blockID *big.Int,
+		proposedInL1Hash common.Hash,
+		parentHash common.Hash,
+		meta *bindings.TaikoDataBlockMetadata,
+		tier uint16,
+	) error
+	SubmitContestsSequentially(ctx context.Context, reqs []*ContestRequest) []error
 }
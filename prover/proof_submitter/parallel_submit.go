@@ -0,0 +1,79 @@
+package submitter
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/pkg/sender"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+// parallelSubmissions returns the number of the independent proofs which can be submitted in parallel,
+// the proofs are only submitted in parallel with the pooled nonce strategy, since the other strategies
+// have to send the transactions one by one.
+func parallelSubmissions(concurrency uint64, nonceStrategy sender.NonceStrategy) uint64 {
+	if concurrency <= 1 {
+		return 1
+	}
+	if nonceStrategy != sender.NonceStrategyPooled {
+		log.Warn(
+			"Parallel proof submission requires the pooled nonce strategy, submit the proofs one by one",
+			"concurrency", concurrency,
+			"nonceStrategy", nonceStrategy,
+		)
+		return 1
+	}
+
+	return concurrency
+}
+
+// SubmitProofs submits the given proofs of the independent blocks, in parallel if the submission
+// concurrency allows, the returned errors are in the same order as the proofs, so a failed proof
+// submission doesn't affect the others.
+func (s *ProofSubmitter) SubmitProofs(ctx context.Context, proofs []*proofProducer.ProofWithHeader) []error {
+	return submitInParallel(ctx, proofs, s.submissionConcurrency, s.SubmitProof)
+}
+
+// submitInParallel submits the given proofs with the given submit function, with at most the given
+// number of the submissions in flight.
+func submitInParallel(
+	ctx context.Context,
+	proofs []*proofProducer.ProofWithHeader,
+	concurrency uint64,
+	submit func(ctx context.Context, proofWithHeader *proofProducer.ProofWithHeader) error,
+) []error {
+	errs := make([]error, len(proofs))
+	if concurrency <= 1 {
+		for i, proofWithHeader := range proofs {
+			errs[i] = submit(ctx, proofWithHeader)
+		}
+		return errs
+	}
+
+	var (
+		wg    sync.WaitGroup
+		slots = make(chan struct{}, concurrency)
+	)
+	for i, proofWithHeader := range proofs {
+		select {
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		case slots <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int, proofWithHeader *proofProducer.ProofWithHeader) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			errs[i] = submit(ctx, proofWithHeader)
+		}(i, proofWithHeader)
+	}
+	wg.Wait()
+
+	return errs
+}
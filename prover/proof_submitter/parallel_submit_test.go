@@ -0,0 +1,86 @@
+package submitter
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/pkg/sender"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+func TestParallelSubmissions(t *testing.T) {
+	require.Equal(t, uint64(1), parallelSubmissions(0, sender.NonceStrategyPooled))
+	require.Equal(t, uint64(1), parallelSubmissions(1, sender.NonceStrategyPooled))
+	require.Equal(t, uint64(1), parallelSubmissions(4, sender.NonceStrategySequential))
+	require.Equal(t, uint64(1), parallelSubmissions(4, sender.NonceStrategyExternal))
+	require.Equal(t, uint64(4), parallelSubmissions(4, sender.NonceStrategyPooled))
+}
+
+func TestSubmitInParallel(t *testing.T) {
+	var proofs []*proofProducer.ProofWithHeader
+	for i := 0; i < 8; i++ {
+		proofs = append(proofs, &proofProducer.ProofWithHeader{BlockID: big.NewInt(int64(i))})
+	}
+
+	var inFlight, maxInFlight, submitted atomic.Int64
+	errs := submitInParallel(
+		context.Background(),
+		proofs,
+		3,
+		func(_ context.Context, _ *proofProducer.ProofWithHeader) error {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				prev := maxInFlight.Load()
+				if n <= prev || maxInFlight.CompareAndSwap(prev, n) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+			submitted.Add(1)
+			return nil
+		},
+	)
+
+	require.Len(t, errs, len(proofs))
+	for _, err := range errs {
+		require.Nil(t, err)
+	}
+	require.Equal(t, int64(len(proofs)), submitted.Load())
+	require.Greater(t, maxInFlight.Load(), int64(1))
+	require.LessOrEqual(t, maxInFlight.Load(), int64(3))
+}
+
+func TestSubmitInParallelErrorIsolation(t *testing.T) {
+	var proofs []*proofProducer.ProofWithHeader
+	for i := 0; i < 4; i++ {
+		proofs = append(proofs, &proofProducer.ProofWithHeader{BlockID: big.NewInt(int64(i))})
+	}
+
+	errFailed := errors.New("failed")
+	for _, concurrency := range []uint64{1, 4} {
+		errs := submitInParallel(
+			context.Background(),
+			proofs,
+			concurrency,
+			func(_ context.Context, proofWithHeader *proofProducer.ProofWithHeader) error {
+				if proofWithHeader.BlockID.Uint64() == 2 {
+					return errFailed
+				}
+				return nil
+			},
+		)
+
+		require.Nil(t, errs[0])
+		require.Nil(t, errs[1])
+		require.ErrorIs(t, errs[2], errFailed)
+		require.Nil(t, errs[3])
+	}
+}
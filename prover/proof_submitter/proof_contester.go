@@ -2,9 +2,12 @@ package submitter
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -19,12 +22,44 @@ import (
 
 var _ Contester = (*ProofContester)(nil)
 
+// ContestAction is the action the contester would take for a transition.
+type ContestAction string
+
+// All available contest actions.
+const (
+	ContestActionContest ContestAction = "contest"
+	ContestActionSkip    ContestAction = "skip"
+)
+
+// ContestReport describes what the contester would do for a transition and why.
+type ContestReport struct {
+	BlockID    *big.Int      `json:"blockID"`
+	ParentHash common.Hash   `json:"parentHash"`
+	Action     ContestAction `json:"action"`
+	Reason     string        `json:"reason"`
+	// The on-chain transition, nil if the transition lookup failed
+	Transition *bindings.TaikoDataTransitionState `json:"transition,omitempty"`
+	// The local L2 block and the L1 block it was proposed in, only set when the transition is contestable
+	BlockHash        common.Hash    `json:"blockHash"`
+	StateRoot        common.Hash    `json:"stateRoot"`
+	ProposedIn       uint64         `json:"proposedIn"`
+	ProposedInL1Hash common.Hash    `json:"proposedInL1Hash"`
+	Contester        common.Address `json:"contester"`
+}
+
 // ProofContester is responsible for contesting wrong L2 transitions.
 type ProofContester struct {
-	rpc       *rpc.Client
-	txBuilder *transaction.ProveBlockTxBuilder
-	sender    *transaction.Sender
-	graffiti  [32]byte
+	rpc              *rpc.Client
+	txBuilder        *transaction.ProveBlockTxBuilder
+	sender           *transaction.Sender
+	contesterAddress common.Address
+	graffiti         *Graffiti
+	// Whether to skip the contests which failed due to an insufficient contest bond
+	skipInsufficientBond bool
+	tracker              *ContestTracker
+	// Retry policy of the transition lookups failed due to the transport errors
+	lookupRetryInterval time.Duration
+	lookupMaxRetrys     uint64
 }
 
 // NewProofContester creates a new ProofContester instance.
@@ -35,13 +70,45 @@ func NewProofContester(
 	builder *transaction.ProveBlockTxBuilder,
 ) *ProofContester {
 	return &ProofContester{
-		rpc:       rpcClient,
-		txBuilder: builder,
-		sender:    transaction.NewSender(rpcClient, txSender),
-		graffiti:  rpc.StringToBytes32(graffiti),
+		rpc:              rpcClient,
+		txBuilder:        builder,
+		sender:           transaction.NewSender(rpcClient, txSender),
+		contesterAddress: txSender.Address(),
+		graffiti:         NewGraffiti(graffiti),
+		tracker:          NewContestTracker(txSender.Address()),
 	}
 }
 
+// Tracker returns the tracker of the contests submitted by this contester.
+func (c *ProofContester) Tracker() *ContestTracker {
+	return c.tracker
+}
+
+// SetGraffiti sets the graffiti attached to the contest transitions, so it can be shared and updated
+// at runtime.
+func (c *ProofContester) SetGraffiti(graffiti *Graffiti) {
+	c.graffiti = graffiti
+}
+
+// SetTransitionLookupRetry sets the retry policy of the pre-flight transition lookups, which failed
+// due to the transport errors, e.g. a briefly flaky L1 node.
+func (c *ProofContester) SetTransitionLookupRetry(interval time.Duration, maxRetrys uint64) {
+	c.lookupRetryInterval = interval
+	c.lookupMaxRetrys = maxRetrys
+}
+
+// SetGasPriceCeilings sets the gas price ceilings of the tiers, the contests are deferred while the L1
+// base fee exceeds the ceiling of the contested transition's tier.
+func (c *ProofContester) SetGasPriceCeilings(ceilings map[uint16]*big.Int) {
+	c.sender.SetGasPriceCeilings(ceilings)
+}
+
+// SetSkipInsufficientBond sets whether to skip the contests which failed due to an insufficient contest
+// bond with a warning, instead of returning an error.
+func (c *ProofContester) SetSkipInsufficientBond(skip bool) {
+	c.skipInsufficientBond = skip
+}
+
 // SubmitContest submits a TaikoL1.proveBlock transaction to contest a L2 block transition.
 func (c *ProofContester) SubmitContest(
 	ctx context.Context,
@@ -51,68 +118,155 @@ func (c *ProofContester) SubmitContest(
 	meta *bindings.TaikoDataBlockMetadata,
 	tier uint16,
 ) error {
-	// Ensure the transition has not been contested yet.
-	transition, err := c.rpc.TaikoL1.GetTransition(
-		&bind.CallOpts{Context: ctx},
-		blockID.Uint64(),
-		parentHash,
+	req := &ContestRequest{
+		BlockID:    blockID,
+		ProposedIn: proposedIn,
+		ParentHash: parentHash,
+		Meta:       meta,
+		Tier:       tier,
+	}
+
+	transition, err := c.checkContest(ctx, req)
+	if err != nil || transition == nil {
+		return err
+	}
+
+	return c.sendContest(ctx, req, transition)
+}
+
+// SubmitContestByHash submits a TaikoL1.proveBlock transaction to contest a L2 block transition, the
+// L1 block the L2 block was proposed in is looked up by the given hash from the original event, instead
+// of by number, an error wrapping ErrProposingL1BlockReorged is returned if the L1 block has been reorged.
+func (c *ProofContester) SubmitContestByHash(
+	ctx context.Context,
+	blockID *big.Int,
+	proposedInL1Hash common.Hash,
+	parentHash common.Hash,
+	meta *bindings.TaikoDataBlockMetadata,
+	tier uint16,
+) error {
+	req := &ContestRequest{
+		BlockID:          blockID,
+		ProposedInL1Hash: proposedInL1Hash,
+		ParentHash:       parentHash,
+		Meta:             meta,
+		Tier:             tier,
+	}
+
+	transition, err := c.checkContest(ctx, req)
+	if err != nil || transition == nil {
+		return err
+	}
+
+	return c.sendContest(ctx, req, transition)
+}
+
+// checkContest ensures the given transition has not been contested yet, and returns the on-chain
+// transition, nil is returned if the transition should not be contested.
+func (c *ProofContester) checkContest(
+	ctx context.Context,
+	req *ContestRequest,
+) (*bindings.TaikoDataTransitionState, error) {
+	transition, err := getTransitionWithRetry(
+		ctx,
+		backoff.WithMaxRetries(backoff.NewConstantBackOff(c.lookupRetryInterval), c.lookupMaxRetrys),
+		func() (bindings.TaikoDataTransitionState, error) {
+			return c.rpc.TaikoL1.GetTransition(&bind.CallOpts{Context: ctx}, req.BlockID.Uint64(), req.ParentHash)
+		},
 	)
 	if err != nil {
-		if !strings.Contains(encoding.TryParsingCustomError(err).Error(), "L1_") {
-			log.Warn(
-				"Failed to get transition",
-				"blockID", blockID,
-				"parentHash", parentHash,
-				"error", encoding.TryParsingCustomError(err),
-			)
-			return nil
-		}
-		return err
+		log.Warn(
+			"Failed to get transition",
+			"blockID", req.BlockID,
+			"parentHash", req.ParentHash,
+			"error", err,
+		)
+		return nil, err
 	}
 	// If the transition has already been contested, return early.
-	if transition.Contester != (common.Address{}) {
+	if contestable, _ := checkContestable(transition); !contestable {
 		log.Info(
 			"Transaction has already been contested",
-			"blockID", blockID,
-			"parentHash", parentHash,
+			"blockID", req.BlockID,
+			"parentHash", req.ParentHash,
 			"contester", transition.Contester,
 		)
+		return nil, nil
+	}
+
+	return transition, nil
+}
+
+// getTransitionWithRetry fetches the on-chain transition, the transport errors are retried with the given
+// backoff policy, while the protocol's custom errors, e.g. the transition doesn't exist yet, are returned
+// immediately, since retrying won't help.
+func getTransitionWithRetry(
+	ctx context.Context,
+	b backoff.BackOff,
+	getTransition func() (bindings.TaikoDataTransitionState, error),
+) (*bindings.TaikoDataTransitionState, error) {
+	var transition bindings.TaikoDataTransitionState
+	if err := backoff.Retry(func() (err error) {
+		if transition, err = getTransition(); err != nil {
+			if err = encoding.TryParsingCustomError(err); strings.Contains(err.Error(), "L1_") {
+				return backoff.Permanent(err)
+			}
+			log.Debug("Retry getting transition", "error", err)
+			return err
+		}
 		return nil
+	}, backoff.WithContext(b, ctx)); err != nil {
+		return nil, err
 	}
 
-	// Send the contest transaction.
-	header, err := c.rpc.L2.HeaderByNumber(ctx, blockID)
+	return &transition, nil
+}
+
+// sendContest sends the contest transaction of the given transition, and tracks the contest until
+// it's resolved.
+func (c *ProofContester) sendContest(
+	ctx context.Context,
+	req *ContestRequest,
+	transition *bindings.TaikoDataTransitionState,
+) error {
+	log.Info(
+		"Contest a transition",
+		"blockID", req.BlockID,
+		"parentHash", req.ParentHash,
+		"contester", c.contesterAddress,
+	)
+	header, err := c.rpc.L2.HeaderByNumber(ctx, req.BlockID)
 	if err != nil {
 		return err
 	}
 
-	l1HeaderProposedIn, err := c.rpc.L1.HeaderByNumber(ctx, proposedIn)
+	l1HeaderProposedIn, err := proposingL1Header(ctx, c.rpc.L1, req)
 	if err != nil {
 		return err
 	}
 
-	return encoding.TryParsingCustomError(
+	err = encoding.TryParsingCustomError(
 		c.sender.Send(
 			ctx,
 			&proofProducer.ProofWithHeader{
-				BlockID: blockID,
-				Meta:    meta,
+				BlockID: req.BlockID,
+				Meta:    req.Meta,
 				Header:  header,
 				Proof:   []byte{},
 				Opts: &proofProducer.ProofRequestOptions{
 					EventL1Hash: l1HeaderProposedIn.Hash(),
 					StateRoot:   header.Root,
 				},
-				Tier: tier,
+				Tier: req.Tier,
 			},
 			c.txBuilder.Build(
-				blockID,
-				meta,
+				req.BlockID,
+				req.Meta,
 				&bindings.TaikoDataTransition{
 					ParentHash: header.ParentHash,
 					BlockHash:  header.Hash(),
 					StateRoot:  header.Root,
-					Graffiti:   c.graffiti,
+					Graffiti:   c.graffiti.Bytes32(),
 				},
 				&bindings.TaikoDataTierProof{
 					Tier: transition.Tier,
@@ -122,4 +276,77 @@ func (c *ProofContester) SubmitContest(
 			),
 		),
 	)
+
+	if err != nil {
+		return handleContestError(req.BlockID, err, c.skipInsufficientBond)
+	}
+
+	// Track the contest until it's resolved.
+	c.tracker.Track(req.BlockID, req.ParentHash, transition)
+	return nil
+}
+
+// SimulateContestFlow runs the full contest decision logic for the given transition, without
+// sending any transaction, and reports what the contester would do and why.
+func (c *ProofContester) SimulateContestFlow(
+	ctx context.Context,
+	blockID *big.Int,
+	parentHash common.Hash,
+) (*ContestReport, error) {
+	report := &ContestReport{
+		BlockID:    blockID,
+		ParentHash: parentHash,
+		Action:     ContestActionSkip,
+		Contester:  c.contesterAddress,
+	}
+
+	// Look up the transition.
+	transition, err := c.rpc.TaikoL1.GetTransition(&bind.CallOpts{Context: ctx}, blockID.Uint64(), parentHash)
+	if err != nil {
+		if err = encoding.TryParsingCustomError(err); strings.Contains(err.Error(), "L1_") {
+			return nil, err
+		}
+		report.Reason = fmt.Sprintf("failed to get transition: %s", err)
+		return report, nil
+	}
+	report.Transition = &transition
+
+	// Check whether the transition has already been contested.
+	contestable, reason := checkContestable(&transition)
+	report.Reason = reason
+	if !contestable {
+		return report, nil
+	}
+
+	// Fetch the headers needed by the contest transaction.
+	header, err := c.rpc.L2.HeaderByNumber(ctx, blockID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L2 header %d: %w", blockID, err)
+	}
+	report.BlockHash = header.Hash()
+	report.StateRoot = header.Root
+
+	block, err := c.rpc.TaikoL1.GetBlock(&bind.CallOpts{Context: ctx}, blockID.Uint64())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L1 block info %d: %w", blockID, encoding.TryParsingCustomError(err))
+	}
+	report.ProposedIn = block.Blk.ProposedIn
+
+	l1HeaderProposedIn, err := c.rpc.L1.HeaderByNumber(ctx, new(big.Int).SetUint64(block.Blk.ProposedIn))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L1 header %d: %w", block.Blk.ProposedIn, err)
+	}
+	report.ProposedInL1Hash = l1HeaderProposedIn.Hash()
+	report.Action = ContestActionContest
+
+	return report, nil
+}
+
+// checkContestable checks whether the given transition can still be contested, and returns the reason.
+func checkContestable(transition *bindings.TaikoDataTransitionState) (bool, string) {
+	if transition.Contester != (common.Address{}) {
+		return false, fmt.Sprintf("transition has already been contested by %s", transition.Contester.Hex())
+	}
+
+	return true, "transition has not been contested yet"
 }
@@ -2,12 +2,22 @@ package submitter
 
 import (
 	"context"
+	"errors"
+	"math/big"
+	"os"
+	"testing"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
 
 	"github.com/taikoxyz/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
 	"github.com/taikoxyz/taiko-client/internal/testutils"
+	"github.com/taikoxyz/taiko-client/pkg/sender"
+	"github.com/taikoxyz/taiko-client/prover/proof_submitter/transaction"
 )
 
 func (s *ProofSubmitterTestSuite) TestSubmitContestNoTransition() {
@@ -22,3 +32,132 @@ func (s *ProofSubmitterTestSuite) TestSubmitContestNoTransition() {
 		),
 	)
 }
+
+func (s *ProofSubmitterTestSuite) TestSimulateContestFlow() {
+	events := s.ProposeAndInsertEmptyBlocks(s.proposer, s.calldataSyncer)
+	e := events[len(events)-1]
+
+	s.Nil(s.submitter.RequestProof(context.Background(), e))
+	proofWithHeader := <-s.proofCh
+	s.Nil(s.submitter.SubmitProof(context.Background(), proofWithHeader))
+
+	// The transition is contestable.
+	report, err := s.contester.SimulateContestFlow(context.Background(), e.BlockId, proofWithHeader.Header.ParentHash)
+	s.Nil(err)
+	s.Equal(ContestActionContest, report.Action)
+	s.NotNil(report.Transition)
+	s.Equal(common.Address{}, report.Transition.Contester)
+	s.Equal(proofWithHeader.Header.Hash(), report.BlockHash)
+	s.Equal(e.Raw.BlockNumber, report.ProposedIn)
+	s.Equal(e.Raw.BlockHash, report.ProposedInL1Hash)
+
+	// Contest the transition with another account.
+	contesterPrivKey, err := crypto.ToECDSA(common.FromHex(os.Getenv("L1_CONTRACT_OWNER_PRIVATE_KEY")))
+	s.Nil(err)
+	contestSender, err := sender.NewSender(context.Background(), &sender.Config{}, s.RPCClient.L1, contesterPrivKey)
+	s.Nil(err)
+	defer contestSender.Close()
+
+	contester := NewProofContester(s.RPCClient, contestSender, "test", transaction.NewProveBlockTxBuilder(s.RPCClient))
+	s.Nil(contester.SubmitContest(
+		context.Background(),
+		e.BlockId,
+		new(big.Int).SetUint64(e.Raw.BlockNumber),
+		proofWithHeader.Header.ParentHash,
+		&e.Meta,
+		e.Meta.MinTier,
+	))
+
+	// The transition has already been contested.
+	report, err = s.contester.SimulateContestFlow(context.Background(), e.BlockId, proofWithHeader.Header.ParentHash)
+	s.Nil(err)
+	s.Equal(ContestActionSkip, report.Action)
+	s.Equal(contester.contesterAddress, report.Transition.Contester)
+	s.Contains(report.Reason, "already been contested")
+	s.Equal(common.Hash{}, report.BlockHash)
+}
+
+func TestCheckContestable(t *testing.T) {
+	contestable, reason := checkContestable(&bindings.TaikoDataTransitionState{})
+	require.True(t, contestable)
+	require.NotEmpty(t, reason)
+
+	contester := common.HexToAddress("0x1234")
+	contestable, reason = checkContestable(&bindings.TaikoDataTransitionState{Contester: contester})
+	require.False(t, contestable)
+	require.Contains(t, reason, contester.Hex())
+}
+
+func TestGetTransitionWithRetry(t *testing.T) {
+	var (
+		calls      int
+		transition = bindings.TaikoDataTransitionState{Tier: encoding.TierOptimisticID}
+		policy     = func() backoff.BackOff {
+			calls = 0
+			return backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Millisecond), 3)
+		}
+	)
+
+	// Transient transport errors are retried.
+	res, err := getTransitionWithRetry(context.Background(), policy(), func() (bindings.TaikoDataTransitionState, error) {
+		if calls++; calls < 3 {
+			return bindings.TaikoDataTransitionState{}, errors.New("connection refused")
+		}
+		return transition, nil
+	})
+	require.Nil(t, err)
+	require.Equal(t, transition, *res)
+	require.Equal(t, 3, calls)
+
+	// Give up after exhausting the retries, instead of skipping the contest.
+	_, err = getTransitionWithRetry(context.Background(), policy(), func() (bindings.TaikoDataTransitionState, error) {
+		calls++
+		return bindings.TaikoDataTransitionState{}, errors.New("connection refused")
+	})
+	require.ErrorContains(t, err, "connection refused")
+	require.Equal(t, 4, calls)
+
+	// The protocol's custom errors are not retried.
+	_, err = getTransitionWithRetry(context.Background(), policy(), func() (bindings.TaikoDataTransitionState, error) {
+		calls++
+		return bindings.TaikoDataTransitionState{}, errors.New("L1_TRANSITION_NOT_FOUND")
+	})
+	require.ErrorContains(t, err, "L1_TRANSITION_NOT_FOUND")
+	require.Equal(t, 1, calls)
+}
+
+func (s *ProofSubmitterTestSuite) TestSubmitContestsSequentiallyNoTransition() {
+	s.Empty(s.contester.SubmitContestsSequentially(context.Background(), nil))
+
+	parentHash := testutils.RandomHash()
+	reqs := []*ContestRequest{
+		{
+			BlockID:    common.Big256,
+			ProposedIn: common.Big1,
+			ParentHash: parentHash,
+			Meta:       &bindings.TaikoDataBlockMetadata{},
+			Tier:       encoding.TierOptimisticID,
+		},
+		{
+			BlockID:    common.Big257,
+			ProposedIn: common.Big1,
+			ParentHash: testutils.RandomHash(),
+			Meta:       &bindings.TaikoDataBlockMetadata{},
+			Tier:       encoding.TierOptimisticID,
+		},
+		// Duplicated request is filtered out.
+		{
+			BlockID:    common.Big256,
+			ProposedIn: common.Big1,
+			ParentHash: parentHash,
+			Meta:       &bindings.TaikoDataBlockMetadata{},
+			Tier:       encoding.TierOptimisticID,
+		},
+	}
+
+	errs := s.contester.SubmitContestsSequentially(context.Background(), reqs)
+	s.Len(errs, len(reqs))
+	s.NotNil(errs[0])
+	s.NotNil(errs[1])
+	s.Nil(errs[2])
+}
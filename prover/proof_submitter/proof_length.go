@@ -0,0 +1,67 @@
+package submitter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+// ErrMalformedProof is returned when a proof doesn't match the encoding expected by its tier's verifier,
+// so its submission would revert in `proveBlock`.
+var ErrMalformedProof = errors.New("malformed proof")
+
+// proofLengthRule is the range of the proof lengths accepted by a tier's verifier, 0 means no limit.
+type proofLengthRule struct {
+	min int
+	max int
+}
+
+// Proof length rules of the tiers, the tiers without a rule accept the proofs of any length, e.g. the
+// optimistic tier, whose verifier ignores the proof.
+var proofLengthRules = map[uint16]proofLengthRule{
+	encoding.TierSgxID:      {min: proofProducer.SGXProofLength, max: proofProducer.SGXProofLength},
+	encoding.TierGuardianID: {min: 1},
+}
+
+// validateProofLength checks the length of the given proof against the rule of its tier.
+func validateProofLength(proofWithHeader *proofProducer.ProofWithHeader) error {
+	rule, ok := proofLengthRules[proofWithHeader.Tier]
+	if !ok {
+		return nil
+	}
+
+	length := len(proofWithHeader.Proof)
+	switch {
+	case rule.min != 0 && rule.min == rule.max && length != rule.min:
+		return fmt.Errorf(
+			"%w: blockID %d, tier %d, proof length %d, expected %d",
+			ErrMalformedProof,
+			proofWithHeader.BlockID,
+			proofWithHeader.Tier,
+			length,
+			rule.min,
+		)
+	case rule.min != 0 && length < rule.min:
+		return fmt.Errorf(
+			"%w: blockID %d, tier %d, proof length %d, expected at least %d",
+			ErrMalformedProof,
+			proofWithHeader.BlockID,
+			proofWithHeader.Tier,
+			length,
+			rule.min,
+		)
+	case rule.max != 0 && length > rule.max:
+		return fmt.Errorf(
+			"%w: blockID %d, tier %d, proof length %d, expected at most %d",
+			ErrMalformedProof,
+			proofWithHeader.BlockID,
+			proofWithHeader.Tier,
+			length,
+			rule.max,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package submitter
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	producer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+func TestValidateProofLength(t *testing.T) {
+	proof := func(tier uint16, length int) *producer.ProofWithHeader {
+		return &producer.ProofWithHeader{BlockID: common.Big1, Tier: tier, Proof: bytes.Repeat([]byte{0xff}, length)}
+	}
+
+	// The optimistic verifier ignores the proof.
+	require.Nil(t, validateProofLength(proof(encoding.TierOptimisticID, 0)))
+	require.Nil(t, validateProofLength(proof(encoding.TierOptimisticID, 100)))
+
+	// The SGX proofs have a fixed length.
+	require.Nil(t, validateProofLength(proof(encoding.TierSgxID, producer.SGXProofLength)))
+	require.ErrorIs(t, validateProofLength(proof(encoding.TierSgxID, 0)), ErrMalformedProof)
+	require.ErrorIs(t, validateProofLength(proof(encoding.TierSgxID, producer.SGXProofLength-1)), ErrMalformedProof)
+	require.ErrorIs(t, validateProofLength(proof(encoding.TierSgxID, 100)), ErrMalformedProof)
+
+	// The guardian proofs can't be empty.
+	require.Nil(t, validateProofLength(proof(encoding.TierGuardianID, 32)))
+	require.ErrorIs(t, validateProofLength(proof(encoding.TierGuardianID, 0)), ErrMalformedProof)
+
+	// The dummy proofs are valid.
+	for _, tier := range []uint16{encoding.TierOptimisticID, encoding.TierSgxID, encoding.TierGuardianID} {
+		res, err := (&producer.DummyProofProducer{}).RequestProof(
+			&producer.ProofRequestOptions{},
+			common.Big1,
+			nil,
+			nil,
+			tier,
+		)
+		require.Nil(t, err)
+		require.Nil(t, validateProofLength(res))
+	}
+}
@@ -4,8 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
+	"strings"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/taikoxyz/taiko-client/bindings"
@@ -18,7 +24,34 @@ import (
 	"github.com/taikoxyz/taiko-client/prover/proof_submitter/transaction"
 )
 
-var _ Submitter = (*ProofSubmitter)(nil)
+var (
+	_ Submitter = (*ProofSubmitter)(nil)
+	// ErrInconsistentProofBundle is returned when the header or metadata in a proof bundle
+	// does not belong to the block the proof is generated for.
+	ErrInconsistentProofBundle = errors.New("inconsistent proof bundle")
+	// ErrSubmittedProofNotReflected is returned when the on-chain transition does not match the
+	// submitted proof after the proof submission transaction has been confirmed.
+	ErrSubmittedProofNotReflected = errors.New("submitted proof not reflected on-chain")
+	// ErrProofTooOld is returned when a proof was produced too long ago, it may reference a
+	// stale state and should be requested again.
+	ErrProofTooOld = errors.New("proof too old")
+	// ErrNotAssignedProver is returned when the block has been assigned to another prover, so the
+	// proof submission is abandoned.
+	ErrNotAssignedProver = errors.New("not the assigned prover")
+	// ErrRequestProofTimeout is returned when a proof request exceeds the submitter's own proof
+	// request timeout, rather than the caller's context deadline.
+	ErrRequestProofTimeout = errors.New("proof request timeout")
+)
+
+// Sources of the proof request timeouts.
+const (
+	requestTimeoutInternal = "internal"
+	requestTimeoutCaller   = "caller"
+)
+
+// StateRootProvider returns the post-state root which should be proven for the given L2 block with the
+// given proof tier.
+type StateRootProvider func(ctx context.Context, tier uint16, block *types.Block) (common.Hash, error)
 
 // ProofSubmitter is responsible requesting proofs for the given L2
 // blocks, and submitting the generated proofs to the TaikoL1 smart contract.
@@ -31,10 +64,59 @@ type ProofSubmitter struct {
 	sender          *transaction.Sender
 	proverAddress   common.Address
 	taikoL2Address  common.Address
-	graffiti        [32]byte
+	graffiti        *Graffiti
+	// Used to get the state root to prove, defaults to the block header's state root
+	stateRootProvider StateRootProvider
+	// Whether to read back the on-chain transition after each proof submission
+	verifySubmittedProof bool
+	// Per-block cooldowns after failed proof submissions
+	cooldowns *submissionCooldowns
+	// Maximum age of a proof to submit, 0 means no limit
+	maxProofAge time.Duration
+	// Whether to abandon the block instead of retrying when the current prover is not the assigned one
+	abandonNotAssigned bool
+	// Grace window after the request's deadline, during which a late proof is still accepted
+	graceWindow time.Duration
+	// Filters the blocks handled by the current prover, nil means all blocks are handled
+	blockFilter BlockFilter
+	// Whether to only build and log the proof submission transactions, without broadcasting them
+	dryRun bool
+	// Bounds the proof requests in flight against the proof producer, nil means no limit
+	requestPool *requestPool
+	// Skips the duplicated proof requests of the same block, nil means no deduplication
+	requestDedup *requestDeduper
+	// Chooses the proof producer by the block's minimum tier, nil means always using the proof producer
+	producerRegistry *proofProducer.ProofProducerRegistry
+	// Maximum number of the independent proofs submitted in parallel by SubmitProofs
+	submissionConcurrency uint64
+	// Timeout of each proof request, independent of the caller's context, 0 means no timeout
+	requestTimeout time.Duration
+	// Publishes the confirmed proof submissions, nil means no publishing
+	proofSubmittedCh chan<- *ProofSubmittedEvent
+	// Emits the BlockAssigned events, which carry the tier fees of the published proof submissions
+	assignmentHookAddress common.Address
 }
 
-// NewProofSubmitter creates a new ProofSubmitter instance.
+// ProofSubmitterConfig represents the optional configs of a proof submitter, the zero value of each
+// config disables the corresponding feature.
+type ProofSubmitterConfig struct {
+	VerifySubmittedProof  bool
+	StateRootProvider     StateRootProvider
+	SubmissionCooldown    time.Duration
+	MaxProofAge           time.Duration
+	AbandonNotAssigned    bool
+	GraceWindow           time.Duration
+	BlockFilter           BlockFilter
+	DryRun                bool
+	RequestConcurrency    uint64
+	OrderedResults        bool
+	RequestDedupWindow    time.Duration
+	SubmissionConcurrency uint64
+	RequestTimeout        time.Duration
+}
+
+// NewProofSubmitter creates a new ProofSubmitter instance, a nil config means all optional features
+// are disabled.
 func NewProofSubmitter(
 	rpcClient *rpc.Client,
 	proofProducer proofProducer.ProofProducer,
@@ -43,7 +125,12 @@ func NewProofSubmitter(
 	graffiti string,
 	txSender *sender.Sender,
 	builder *transaction.ProveBlockTxBuilder,
+	cfg *ProofSubmitterConfig,
 ) (*ProofSubmitter, error) {
+	if cfg == nil {
+		cfg = &ProofSubmitterConfig{}
+	}
+
 	anchorValidator, err := validator.New(taikoL2Address, rpcClient.L2.ChainID, rpcClient)
 	if err != nil {
 		return nil, err
@@ -58,12 +145,130 @@ func NewProofSubmitter(
 		sender:          transaction.NewSender(rpcClient, txSender),
 		proverAddress:   txSender.Address(),
 		taikoL2Address:  taikoL2Address,
-		graffiti:        rpc.StringToBytes32(graffiti),
+		graffiti:        NewGraffiti(graffiti),
+
+		stateRootProvider:     cfg.StateRootProvider,
+		verifySubmittedProof:  cfg.VerifySubmittedProof,
+		cooldowns:             newSubmissionCooldowns(cfg.SubmissionCooldown),
+		maxProofAge:           cfg.MaxProofAge,
+		abandonNotAssigned:    cfg.AbandonNotAssigned,
+		graceWindow:           cfg.GraceWindow,
+		blockFilter:           cfg.BlockFilter,
+		dryRun:                cfg.DryRun,
+		requestPool:           newRequestPool(cfg.RequestConcurrency, cfg.OrderedResults),
+		requestDedup:          newRequestDeduper(cfg.RequestDedupWindow),
+		submissionConcurrency: parallelSubmissions(cfg.SubmissionConcurrency, txSender.NonceStrategy),
+		requestTimeout:        cfg.RequestTimeout,
 	}, nil
 }
 
+// SetGraffiti sets the graffiti attached to the submitted transitions, so it can be shared and updated
+// at runtime.
+func (s *ProofSubmitter) SetGraffiti(graffiti *Graffiti) {
+	s.graffiti = graffiti
+}
+
+// SetGasPriceCeilings sets the gas price ceilings of the tiers, the proof submissions are deferred while
+// the L1 base fee exceeds the ceiling of their tier.
+func (s *ProofSubmitter) SetGasPriceCeilings(ceilings map[uint16]*big.Int) {
+	s.sender.SetGasPriceCeilings(ceilings)
+}
+
+// SetResubmission sets how a failed proof submission is resubmitted, with an exponential backoff plus
+// jitter, and a gas price bump on each attempt.
+func (s *ProofSubmitter) SetResubmission(resubmission *transaction.Resubmission) {
+	s.sender.SetResubmission(resubmission)
+}
+
+// SetProducerRegistry sets the proof producer registry, once set, the proof producer of each block is
+// chosen from the registry by the tier to prove the block in, and the registry's fallback tiers are used
+// when that proof producer is unavailable or fails.
+func (s *ProofSubmitter) SetProducerRegistry(registry *proofProducer.ProofProducerRegistry) {
+	s.producerRegistry = registry
+}
+
+// SetAssignmentHookAddress sets the AssignmentHook contract address, which is used to look up the tier
+// fees of the published proof submissions.
+func (s *ProofSubmitter) SetAssignmentHookAddress(address common.Address) {
+	s.assignmentHookAddress = address
+}
+
+// ProducerRegistry returns the proof producer registry set by SetProducerRegistry, nil if not set.
+func (s *ProofSubmitter) ProducerRegistry() *proofProducer.ProofProducerRegistry {
+	return s.producerRegistry
+}
+
+// proofTier returns the tier to prove the block with the given metadata in, i.e. the submitter's own tier,
+// since the prover chooses the submitter by the requested tier, which is never lower than the block's
+// minimum tier.
+func (s *ProofSubmitter) proofTier(meta *bindings.TaikoDataBlockMetadata) uint16 {
+	if s.proofProducer == nil {
+		return meta.MinTier
+	}
+
+	return max(meta.MinTier, s.proofProducer.Tier())
+}
+
+// producer returns the proof producer for the block with the given metadata.
+func (s *ProofSubmitter) producer(meta *bindings.TaikoDataBlockMetadata) (proofProducer.ProofProducer, error) {
+	if s.producerRegistry == nil {
+		return s.proofProducer, nil
+	}
+
+	return s.producerRegistry.Producer(s.proofTier(meta))
+}
+
+// fallbackProducers returns the proof producers to fall back to, in order, when the proof producer for
+// the block with the given metadata is unavailable or fails.
+func (s *ProofSubmitter) fallbackProducers(meta *bindings.TaikoDataBlockMetadata) []proofProducer.ProofProducer {
+	if s.producerRegistry == nil {
+		return nil
+	}
+
+	return s.producerRegistry.Fallbacks(s.proofTier(meta), meta.MinTier)
+}
+
+// OnBlockVerified implements the Submitter interface.
+func (s *ProofSubmitter) OnBlockVerified(blockID *big.Int) {
+	s.requestDedup.forgetUntil(blockID.Uint64())
+}
+
+// OnTransitionContested implements the Submitter interface.
+func (s *ProofSubmitter) OnTransitionContested(blockID *big.Int) {
+	s.requestDedup.forget(blockID.Uint64())
+}
+
 // RequestProof implements the Submitter interface.
-func (s *ProofSubmitter) RequestProof(ctx context.Context, event *bindings.TaikoL1ClientBlockProposed) error {
+func (s *ProofSubmitter) RequestProof(ctx context.Context, event *bindings.TaikoL1ClientBlockProposed) (err error) {
+	if s.skipBlock(event.BlockId) {
+		return nil
+	}
+
+	// Skip the duplicated request if the block is already being proven, or was just proven.
+	if !s.requestDedup.begin(event.BlockId.Uint64(), &event.Meta) {
+		return nil
+	}
+	defer func() {
+		if err != nil {
+			s.requestDedup.forget(event.BlockId.Uint64())
+			return
+		}
+		s.requestDedup.complete(event.BlockId.Uint64())
+	}()
+
+	// Bound the whole proof request by the submitter's own timeout, if set.
+	if s.requestTimeout != 0 {
+		callerCtx := ctx
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, s.requestTimeout, ErrRequestProofTimeout)
+		defer cancel()
+		defer func() { err = s.checkRequestTimeout(callerCtx, ctx, event.BlockId, err) }()
+	}
+
+	// The proofs are delivered in the order of the requests, if required.
+	turn := s.requestPool.nextTurn()
+	defer turn.finish()
+
 	l1Origin, err := s.rpc.WaitL1Origin(ctx, event.BlockId)
 	if err != nil {
 		return fmt.Errorf("failed to fetch l1Origin, blockID: %d, err: %w", event.BlockId, err)
@@ -89,7 +294,13 @@ func (s *ProofSubmitter) RequestProof(ctx context.Context, event *bindings.Taiko
 		return err
 	}
 
+	stateRoot, err := s.stateRoot(ctx, block)
+	if err != nil {
+		return fmt.Errorf("failed to get the state root to prove (id: %d): %w", event.BlockId, err)
+	}
+
 	// Request proof.
+	graffiti := s.graffiti.Bytes32()
 	opts := &proofProducer.ProofRequestOptions{
 		BlockID:            block.Number(),
 		ProverAddress:      s.proverAddress,
@@ -98,24 +309,26 @@ func (s *ProofSubmitter) RequestProof(ctx context.Context, event *bindings.Taiko
 		MetaHash:           blockInfo.Blk.MetaHash,
 		BlockHash:          block.Hash(),
 		ParentHash:         block.ParentHash(),
-		StateRoot:          block.Root(),
+		StateRoot:          stateRoot,
 		EventL1Hash:        event.Raw.BlockHash,
-		Graffiti:           common.Bytes2Hex(s.graffiti[:]),
+		Graffiti:           common.Bytes2Hex(graffiti[:]),
 		GasUsed:            block.GasUsed(),
 		ParentGasUsed:      parent.GasUsed(),
 	}
 
 	// Send the generated proof.
-	result, err := s.proofProducer.RequestProof(
-		ctx,
-		opts,
-		event.BlockId,
-		&event.Meta,
-		block.Header(),
-	)
+	result, late, err := s.produceProof(ctx, opts, event, block.Header())
 	if err != nil {
 		return fmt.Errorf("failed to request proof (id: %d): %w", event.BlockId, err)
 	}
+	if late {
+		if err := s.acceptLateProof(ctx, event); err != nil {
+			return err
+		}
+	}
+	if err := turn.wait(ctx); err != nil {
+		return err
+	}
 	s.resultCh <- result
 
 	metrics.ProverQueuedProofCounter.Inc(1)
@@ -123,6 +336,31 @@ func (s *ProofSubmitter) RequestProof(ctx context.Context, event *bindings.Taiko
 	return nil
 }
 
+// checkRequestTimeout records which timeout the failed proof request hit, if any, and marks the error
+// with ErrRequestProofTimeout if it is the submitter's own proof request timeout.
+func (s *ProofSubmitter) checkRequestTimeout(
+	callerCtx context.Context,
+	requestCtx context.Context,
+	blockID *big.Int,
+	err error,
+) error {
+	if err == nil || !errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	// The caller's context expiring first takes precedence, since it also cancels the request's context.
+	if callerCtx.Err() != nil {
+		metrics.ProverRequestProofTimeoutCounter(requestTimeoutCaller).Inc(1)
+		return err
+	}
+	if !errors.Is(context.Cause(requestCtx), ErrRequestProofTimeout) {
+		return err
+	}
+
+	metrics.ProverRequestProofTimeoutCounter(requestTimeoutInternal).Inc(1)
+	return fmt.Errorf("%w: blockID %d, timeout %s: %w", ErrRequestProofTimeout, blockID, s.requestTimeout, err)
+}
+
 // SubmitProof implements the Submitter interface.
 func (s *ProofSubmitter) SubmitProof(
 	ctx context.Context,
@@ -141,6 +379,54 @@ func (s *ProofSubmitter) SubmitProof(
 
 	metrics.ProverReceivedProofCounter.Inc(1)
 
+	if err := validateProofBundle(proofWithHeader); err != nil {
+		return backoff.Permanent(err)
+	}
+
+	// Reject the malformed proofs before building the transaction, since they would revert in `proveBlock`.
+	if err := validateProofLength(proofWithHeader); err != nil {
+		return backoff.Permanent(err)
+	}
+
+	// Discard the proof if it was produced too long ago, it should be requested again.
+	if age := time.Since(proofWithHeader.ProducedAt); s.maxProofAge != 0 &&
+		!proofWithHeader.ProducedAt.IsZero() &&
+		age > s.maxProofAge {
+		metrics.ProverProofTooOldCounter.Inc(1)
+		return backoff.Permanent(fmt.Errorf(
+			"%w: blockID %d, age %s, max age %s",
+			ErrProofTooOld,
+			proofWithHeader.BlockID,
+			age,
+			s.maxProofAge,
+		))
+	}
+
+	// Wait for the cooldown if the previous submission of this block failed.
+	blockID := proofWithHeader.BlockID.Uint64()
+	if err := s.cooldowns.wait(ctx, blockID); err != nil {
+		return err
+	}
+	defer func() {
+		// The block can be requested again if its proof is not submitted.
+		if err != nil {
+			s.requestDedup.forget(blockID)
+		}
+
+		var permanentErr *backoff.PermanentError
+		switch {
+		case err == nil:
+			s.cooldowns.reset(blockID)
+		case errors.As(err, &permanentErr):
+			s.cooldowns.reset(blockID)
+			log.Warn("Abandon the proof submission", "blockID", blockID, "error", err)
+		default:
+			if cooldown := s.cooldowns.fail(blockID); cooldown != 0 {
+				log.Info("Cool down before retrying the proof submission", "blockID", blockID, "cooldown", cooldown)
+			}
+		}
+	}()
+
 	// Get the corresponding L2 block.
 	block, err := s.rpc.L2.BlockByHash(ctx, proofWithHeader.Header.Hash())
 	if err != nil {
@@ -148,13 +434,15 @@ func (s *ProofSubmitter) SubmitProof(
 	}
 
 	if block.Transactions().Len() == 0 {
-		return fmt.Errorf("invalid block without anchor transaction, blockID %s", proofWithHeader.BlockID)
+		return backoff.Permanent(
+			fmt.Errorf("invalid block without anchor transaction, blockID %s", proofWithHeader.BlockID),
+		)
 	}
 
 	// Validate TaikoL2.anchor transaction inside the L2 block.
 	anchorTx := block.Transactions()[0]
 	if err = s.anchorValidator.ValidateAnchorTx(anchorTx); err != nil {
-		return fmt.Errorf("invalid anchor transaction: %w", err)
+		return backoff.Permanent(fmt.Errorf("invalid anchor transaction: %w", err))
 	}
 
 	// Get and validate this anchor transaction's receipt.
@@ -163,38 +451,138 @@ func (s *ProofSubmitter) SubmitProof(
 	}
 
 	// Build the TaikoL1.proveBlock transaction and send it to the L1 node.
-	if err = encoding.TryParsingCustomError(s.sender.Send(
-		ctx,
-		proofWithHeader,
-		s.txBuilder.Build(
-			proofWithHeader.BlockID,
-			proofWithHeader.Meta,
-			&bindings.TaikoDataTransition{
-				ParentHash: proofWithHeader.Header.ParentHash,
-				BlockHash:  proofWithHeader.Opts.BlockHash,
-				StateRoot:  proofWithHeader.Opts.StateRoot,
-				Graffiti:   s.graffiti,
-			},
-			&bindings.TaikoDataTierProof{
-				Tier: proofWithHeader.Tier,
-				Data: proofWithHeader.Proof,
-			},
-			proofWithHeader.Tier == encoding.TierGuardianID,
-		),
-	)); err != nil {
-		if err.Error() == transaction.ErrUnretryableSubmission.Error() {
-			return nil
-		}
-		metrics.ProverSubmissionErrorCounter.Inc(1)
+	buildTx := s.buildProveBlockTx(proofWithHeader)
+	if s.dryRun {
+		_, err := s.dryRunProof(ctx, proofWithHeader, buildTx)
+		return s.handleSubmissionError(proofWithHeader.BlockID, err)
+	}
+	confirmed, err := s.sender.SendAndConfirm(ctx, proofWithHeader, buildTx)
+	if err = s.handleSubmissionError(proofWithHeader.BlockID, err); err != nil {
 		return err
 	}
+	s.publishProofSubmitted(ctx, proofWithHeader, confirmed)
 
 	metrics.ProverSentProofCounter.Inc(1)
 	metrics.ProverLatestProvenBlockIDGauge.Update(proofWithHeader.BlockID.Int64())
 
+	// Guardian proofs are approved through the GuardianProver contract, so the on-chain
+	// transition's prover will never be the current prover.
+	if s.verifySubmittedProof && proofWithHeader.Tier != encoding.TierGuardianID {
+		if err := s.verifySubmittedTransition(ctx, proofWithHeader); err != nil {
+			// The proof submission transaction is already confirmed, so a transition which can't be read
+			// back is only reported.
+			if !errors.Is(err, ErrSubmittedProofNotReflected) {
+				log.Warn("Failed to verify the submitted proof", "blockID", proofWithHeader.BlockID, "error", err)
+				return nil
+			}
+			metrics.ProverProofNotReflectedCounter.Inc(1)
+			return err
+		}
+	}
+
 	return nil
 }
 
+// buildProveBlockTx returns the builder of the TaikoL1.proveBlock transaction of the given proof.
+func (s *ProofSubmitter) buildProveBlockTx(proofWithHeader *proofProducer.ProofWithHeader) transaction.TxBuilder {
+	return s.txBuilder.Build(
+		proofWithHeader.BlockID,
+		proofWithHeader.Meta,
+		&bindings.TaikoDataTransition{
+			ParentHash: proofWithHeader.Header.ParentHash,
+			BlockHash:  proofWithHeader.Opts.BlockHash,
+			StateRoot:  proofWithHeader.Opts.StateRoot,
+			Graffiti:   s.graffiti.Bytes32(),
+		},
+		&bindings.TaikoDataTierProof{
+			Tier: proofWithHeader.Tier,
+			Data: proofWithHeader.Proof,
+		},
+		proofWithHeader.Tier == encoding.TierGuardianID,
+	)
+}
+
+// dryRunProof builds the proof submission transaction of the given proof without broadcasting it, and
+// logs its calldata, gas estimate and the tier fee the prover is paid for the proof, which is also
+// returned, nil if the tier fee can't be determined.
+func (s *ProofSubmitter) dryRunProof(
+	ctx context.Context,
+	proofWithHeader *proofProducer.ProofWithHeader,
+	buildTx transaction.TxBuilder,
+) (*big.Int, error) {
+	tx, err := s.sender.DryRun(ctx, proofWithHeader, buildTx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+
+	tierFee, err := s.tierFee(ctx, proofWithHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info(
+		"Dry run proof submission, transaction not sent",
+		"blockID", proofWithHeader.BlockID,
+		"tier", proofWithHeader.Tier,
+		"to", tx.To(),
+		"nonce", tx.Nonce(),
+		"gasEstimate", tx.Gas(),
+		"tierFee", tierFee,
+		"gasFeeCap", tx.GasFeeCap(),
+		"gasTipCap", tx.GasTipCap(),
+		"calldata", common.Bytes2Hex(tx.Data()),
+	)
+
+	return tierFee, nil
+}
+
+// handleSubmissionError handles the error returned by a proof submission, returns nil if the
+// submission should neither be retried nor reported as failed.
+func (s *ProofSubmitter) handleSubmissionError(blockID *big.Int, err error) error {
+	if err = encoding.TryParsingCustomError(err); err == nil {
+		return nil
+	}
+
+	switch {
+	case err.Error() == transaction.ErrUnretryableSubmission.Error():
+		return nil
+	case s.abandonNotAssigned && strings.HasPrefix(err.Error(), "L1_NOT_ASSIGNED_PROVER"):
+		metrics.ProverNotAssignedAbandonedCounter.Inc(1)
+		return backoff.Permanent(fmt.Errorf("%w: blockID %d", ErrNotAssignedProver, blockID))
+	}
+
+	metrics.ProverSubmissionErrorCounter.Inc(1)
+	return err
+}
+
+// verifySubmittedTransition reads back the on-chain transition of the given proof, and checks
+// whether it is the one submitted by the current prover.
+func (s *ProofSubmitter) verifySubmittedTransition(
+	ctx context.Context,
+	proofWithHeader *proofProducer.ProofWithHeader,
+) error {
+	transition, err := s.rpc.TaikoL1.GetTransition(
+		&bind.CallOpts{Context: ctx},
+		proofWithHeader.BlockID.Uint64(),
+		proofWithHeader.Header.ParentHash,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get the submitted transition: %w", encoding.TryParsingCustomError(err))
+	}
+
+	return checkTransitionReflected(&transition, s.proverAddress, proofWithHeader)
+}
+
+// stateRoot returns the post-state root to prove for the given block, if no state root provider
+// is set, the block header's state root will be used.
+func (s *ProofSubmitter) stateRoot(ctx context.Context, block *types.Block) (common.Hash, error) {
+	if s.stateRootProvider == nil {
+		return block.Root(), nil
+	}
+
+	return s.stateRootProvider(ctx, s.Tier(), block)
+}
+
 // Producer returns the inner proof producer.
 func (s *ProofSubmitter) Producer() proofProducer.ProofProducer {
 	return s.proofProducer
@@ -204,3 +592,55 @@ func (s *ProofSubmitter) Producer() proofProducer.ProofProducer {
 func (s *ProofSubmitter) Tier() uint16 {
 	return s.proofProducer.Tier()
 }
+
+// validateProofBundle checks whether the header and metadata in the given proof bundle
+// both belong to the block with the bundle's block ID.
+func validateProofBundle(proofWithHeader *proofProducer.ProofWithHeader) error {
+	if proofWithHeader.BlockID == nil || proofWithHeader.Header == nil || proofWithHeader.Meta == nil {
+		return fmt.Errorf("%w: missing block ID, header or metadata", ErrInconsistentProofBundle)
+	}
+
+	if proofWithHeader.Header.Number == nil || proofWithHeader.Header.Number.Cmp(proofWithHeader.BlockID) != 0 {
+		return fmt.Errorf(
+			"%w: header number %v, block ID %d",
+			ErrInconsistentProofBundle,
+			proofWithHeader.Header.Number,
+			proofWithHeader.BlockID,
+		)
+	}
+
+	if !proofWithHeader.BlockID.IsUint64() || proofWithHeader.Meta.Id != proofWithHeader.BlockID.Uint64() {
+		return fmt.Errorf(
+			"%w: metadata ID %d, block ID %d",
+			ErrInconsistentProofBundle,
+			proofWithHeader.Meta.Id,
+			proofWithHeader.BlockID,
+		)
+	}
+
+	return nil
+}
+
+// checkTransitionReflected checks whether the given on-chain transition matches the submitted proof.
+func checkTransitionReflected(
+	transition *bindings.TaikoDataTransitionState,
+	proverAddress common.Address,
+	proofWithHeader *proofProducer.ProofWithHeader,
+) error {
+	if transition.Prover != proverAddress ||
+		transition.Tier != proofWithHeader.Tier ||
+		transition.BlockHash != proofWithHeader.Opts.BlockHash ||
+		transition.StateRoot != proofWithHeader.Opts.StateRoot {
+		log.Warn(
+			"Submitted proof is not reflected on-chain",
+			"blockID", proofWithHeader.BlockID,
+			"prover", transition.Prover,
+			"tier", transition.Tier,
+			"blockHash", common.Hash(transition.BlockHash),
+			"stateRoot", common.Hash(transition.StateRoot),
+		)
+		return fmt.Errorf("%w, blockID: %d", ErrSubmittedProofNotReflected, proofWithHeader.BlockID)
+	}
+
+	return nil
+}
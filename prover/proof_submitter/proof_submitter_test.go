@@ -3,13 +3,21 @@ package submitter
 import (
 	"bytes"
 	"context"
+	"errors"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/cenkalti/backoff/v4"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	gethMetrics "github.com/ethereum/go-ethereum/metrics"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/taikoxyz/taiko-client/bindings"
@@ -17,6 +25,7 @@ import (
 	"github.com/taikoxyz/taiko-client/driver/chain_syncer/beaconsync"
 	"github.com/taikoxyz/taiko-client/driver/chain_syncer/calldata"
 	"github.com/taikoxyz/taiko-client/driver/state"
+	"github.com/taikoxyz/taiko-client/internal/metrics"
 	"github.com/taikoxyz/taiko-client/internal/testutils"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
 	"github.com/taikoxyz/taiko-client/pkg/sender"
@@ -55,6 +64,7 @@ func (s *ProofSubmitterTestSuite) SetupTest() {
 		"test",
 		sender,
 		builder,
+		nil,
 	)
 	s.Nil(err)
 	s.contester = NewProofContester(
@@ -119,6 +129,15 @@ func (s *ProofSubmitterTestSuite) TestProofSubmitterRequestProofDeadlineExceeded
 	)
 }
 
+func (s *ProofSubmitterTestSuite) TestProofSubmitterRequestProofTimeout() {
+	s.submitter.requestTimeout = time.Second
+	defer func() { s.submitter.requestTimeout = 0 }()
+
+	err := s.submitter.RequestProof(context.Background(), &bindings.TaikoL1ClientBlockProposed{BlockId: common.Big256})
+	s.ErrorIs(err, ErrRequestProofTimeout)
+	s.ErrorIs(err, context.DeadlineExceeded)
+}
+
 func (s *ProofSubmitterTestSuite) TestProofSubmitterSubmitProofMetadataNotFound() {
 	s.Error(
 		s.submitter.SubmitProof(
@@ -143,6 +162,48 @@ func (s *ProofSubmitterTestSuite) TestSubmitProofs() {
 	}
 }
 
+func (s *ProofSubmitterTestSuite) TestSubmitProofNotReflected() {
+	s.submitter.verifySubmittedProof = true
+	defer func(proverAddress common.Address) {
+		s.submitter.verifySubmittedProof = false
+		s.submitter.proverAddress = proverAddress
+	}(s.submitter.proverAddress)
+
+	events := s.ProposeAndInsertEmptyBlocks(s.proposer, s.calldataSyncer)
+	e := events[len(events)-1]
+
+	s.Nil(s.submitter.RequestProof(context.Background(), e))
+	proofWithHeader := <-s.proofCh
+
+	// The on-chain transition's prover differs from the current prover.
+	s.submitter.proverAddress = common.BytesToAddress(testutils.RandomBytes(20))
+	s.ErrorIs(s.submitter.SubmitProof(context.Background(), proofWithHeader), ErrSubmittedProofNotReflected)
+}
+
+func (s *ProofSubmitterTestSuite) TestSubmitProofsSubmittedEvent() {
+	submittedCh := make(chan *ProofSubmittedEvent, 1)
+	s.submitter.SetProofSubmittedCh(submittedCh)
+	s.submitter.SetAssignmentHookAddress(common.HexToAddress(os.Getenv("ASSIGNMENT_HOOK_ADDRESS")))
+	defer s.submitter.SetProofSubmittedCh(nil)
+
+	events := s.ProposeAndInsertEmptyBlocks(s.proposer, s.calldataSyncer)
+
+	for _, e := range events {
+		s.Nil(s.submitter.RequestProof(context.Background(), e))
+		proofWithHeader := <-s.proofCh
+		s.Nil(s.submitter.SubmitProof(context.Background(), proofWithHeader))
+
+		event := <-submittedCh
+		s.Equal(e.BlockId, event.BlockID)
+		s.Equal(proofWithHeader.Tier, event.Tier)
+		s.NotEqual(common.Hash{}, event.TxHash)
+		s.NotZero(event.GasUsed)
+		s.NotNil(event.EffectiveGasPrice)
+		s.NotNil(event.ValidityBond)
+		s.NotNil(event.TierFee)
+	}
+}
+
 func (s *ProofSubmitterTestSuite) TestGuardianSubmitProofs() {
 	events := s.ProposeAndInsertEmptyBlocks(s.proposer, s.calldataSyncer)
 
@@ -154,6 +215,37 @@ func (s *ProofSubmitterTestSuite) TestGuardianSubmitProofs() {
 	}
 }
 
+func (s *ProofSubmitterTestSuite) TestSubmitProofsDryRun() {
+	s.submitter.dryRun = true
+	s.submitter.SetAssignmentHookAddress(common.HexToAddress(os.Getenv("ASSIGNMENT_HOOK_ADDRESS")))
+	defer func() { s.submitter.dryRun = false }()
+
+	events := s.ProposeAndInsertEmptyBlocks(s.proposer, s.calldataSyncer)
+
+	for _, e := range events {
+		s.Nil(s.submitter.RequestProof(context.Background(), e))
+		proofWithHeader := <-s.proofCh
+		s.Nil(s.submitter.SubmitProof(context.Background(), proofWithHeader))
+
+		// The tier fee of the block's assignment is computed.
+		tierFee, err := s.submitter.dryRunProof(
+			context.Background(),
+			proofWithHeader,
+			s.submitter.buildProveBlockTx(proofWithHeader),
+		)
+		s.Nil(err)
+		s.NotNil(tierFee)
+
+		// The proof submission transaction should not be broadcasted.
+		_, err = s.RPCClient.TaikoL1.GetTransition(
+			&bind.CallOpts{Context: context.Background()},
+			proofWithHeader.BlockID.Uint64(),
+			proofWithHeader.Header.ParentHash,
+		)
+		s.NotNil(err)
+	}
+}
+
 func (s *ProofSubmitterTestSuite) TestProofSubmitterRequestProofCancelled() {
 	ctx, cancel := context.WithCancel(context.Background())
 	go func() { time.AfterFunc(2*time.Second, func() { cancel() }) }()
@@ -164,6 +256,253 @@ func (s *ProofSubmitterTestSuite) TestProofSubmitterRequestProofCancelled() {
 	)
 }
 
+func TestValidateProofBundle(t *testing.T) {
+	proofWithHeader := &producer.ProofWithHeader{
+		BlockID: common.Big2,
+		Meta:    &bindings.TaikoDataBlockMetadata{Id: 2},
+		Header:  &types.Header{Number: common.Big2},
+		Opts:    &producer.ProofRequestOptions{},
+	}
+	require.Nil(t, validateProofBundle(proofWithHeader))
+
+	// Mismatched header.
+	proofWithHeader.Header = &types.Header{Number: common.Big3}
+	require.ErrorIs(t, validateProofBundle(proofWithHeader), ErrInconsistentProofBundle)
+
+	// Mismatched metadata.
+	proofWithHeader.Header = &types.Header{Number: common.Big2}
+	proofWithHeader.Meta = &bindings.TaikoDataBlockMetadata{Id: 1}
+	require.ErrorIs(t, validateProofBundle(proofWithHeader), ErrInconsistentProofBundle)
+
+	// Missing header number.
+	proofWithHeader.Meta = &bindings.TaikoDataBlockMetadata{Id: 2}
+	proofWithHeader.Header = &types.Header{}
+	require.ErrorIs(t, validateProofBundle(proofWithHeader), ErrInconsistentProofBundle)
+}
+
+func TestCheckTransitionReflected(t *testing.T) {
+	var (
+		prover          = common.HexToAddress("0x1234")
+		proofWithHeader = &producer.ProofWithHeader{
+			BlockID: common.Big1,
+			Tier:    encoding.TierSgxID,
+			Opts: &producer.ProofRequestOptions{
+				BlockHash: common.HexToHash("0x01"),
+				StateRoot: common.HexToHash("0x02"),
+			},
+		}
+		transition = &bindings.TaikoDataTransitionState{
+			Prover:    prover,
+			Tier:      encoding.TierSgxID,
+			BlockHash: common.HexToHash("0x01"),
+			StateRoot: common.HexToHash("0x02"),
+		}
+	)
+	require.Nil(t, checkTransitionReflected(transition, prover, proofWithHeader))
+
+	// The transition has been overwritten by another prover.
+	overwritten := *transition
+	overwritten.Prover = common.HexToAddress("0x5678")
+	require.ErrorIs(t, checkTransitionReflected(&overwritten, prover, proofWithHeader), ErrSubmittedProofNotReflected)
+
+	// The transition has been overwritten by a higher tier proof.
+	overwritten = *transition
+	overwritten.Tier = encoding.TierGuardianID
+	require.ErrorIs(t, checkTransitionReflected(&overwritten, prover, proofWithHeader), ErrSubmittedProofNotReflected)
+
+	// The state root differs.
+	overwritten = *transition
+	overwritten.StateRoot = common.HexToHash("0x03")
+	require.ErrorIs(t, checkTransitionReflected(&overwritten, prover, proofWithHeader), ErrSubmittedProofNotReflected)
+}
+
+func TestStateRootProvider(t *testing.T) {
+	block := types.NewBlockWithHeader(&types.Header{Number: common.Big1, Root: common.HexToHash("0x01")})
+
+	// Default to the header root.
+	submitter := &ProofSubmitter{proofProducer: &producer.OptimisticProofProducer{}}
+	stateRoot, err := submitter.stateRoot(context.Background(), block)
+	require.Nil(t, err)
+	require.Equal(t, block.Root(), stateRoot)
+
+	// Use the custom provider.
+	customRoot := common.HexToHash("0x02")
+	submitter.stateRootProvider = func(_ context.Context, tier uint16, b *types.Block) (common.Hash, error) {
+		require.Equal(t, encoding.TierOptimisticID, tier)
+		require.Equal(t, block.Hash(), b.Hash())
+		return customRoot, nil
+	}
+	stateRoot, err = submitter.stateRoot(context.Background(), block)
+	require.Nil(t, err)
+	require.Equal(t, customRoot, stateRoot)
+}
+
+func TestProducerRegistry(t *testing.T) {
+	var (
+		optimistic = &producer.OptimisticProofProducer{}
+		guardian   = producer.NewGuardianProofProducer(false)
+		submitter  = &ProofSubmitter{proofProducer: optimistic}
+	)
+
+	// Always use the proof producer given at creation without a registry.
+	p, err := submitter.producer(&bindings.TaikoDataBlockMetadata{MinTier: encoding.TierGuardianID})
+	require.Nil(t, err)
+	require.Same(t, optimistic, p)
+
+	// Choose the proof producer by the block's minimum tier.
+	submitter.SetProducerRegistry(producer.NewProofProducerRegistry(optimistic, guardian))
+	p, err = submitter.producer(&bindings.TaikoDataBlockMetadata{MinTier: encoding.TierGuardianID})
+	require.Nil(t, err)
+	require.Same(t, guardian, p)
+
+	// Unknown tiers are reported.
+	_, err = submitter.producer(&bindings.TaikoDataBlockMetadata{MinTier: encoding.TierSgxID})
+	require.ErrorIs(t, err, producer.ErrUnsupportedTier)
+
+	// The submitter's own tier is kept when it is higher than the block's minimum tier, e.g. for contests.
+	submitter.proofProducer = guardian
+	p, err = submitter.producer(&bindings.TaikoDataBlockMetadata{MinTier: encoding.TierOptimisticID})
+	require.Nil(t, err)
+	require.Same(t, guardian, p)
+}
+
+// unavailableL2Service is a fake `eth` namespace RPC service, which fails all block queries.
+type unavailableL2Service struct{}
+
+func (s *unavailableL2Service) ChainId() *hexutil.Big { // nolint: revive,stylecheck
+	return (*hexutil.Big)(common.Big1)
+}
+
+func (s *unavailableL2Service) GetBlockByHash(_ common.Hash, _ bool) (map[string]interface{}, error) {
+	return nil, errors.New("L2 node unavailable")
+}
+
+func TestSubmitProofCooldown(t *testing.T) {
+	server := gethRPC.NewServer()
+	require.Nil(t, server.RegisterName("eth", new(unavailableL2Service)))
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	l2, err := rpc.NewEthClient(context.Background(), httpServer.URL, time.Second)
+	require.Nil(t, err)
+
+	var (
+		cooldown        = 200 * time.Millisecond
+		submitter       = &ProofSubmitter{rpc: &rpc.Client{L2: l2}, cooldowns: newSubmissionCooldowns(cooldown)}
+		proofWithHeader = &producer.ProofWithHeader{
+			BlockID: common.Big1,
+			Meta:    &bindings.TaikoDataBlockMetadata{Id: 1},
+			Header:  &types.Header{Number: common.Big1},
+			Opts:    &producer.ProofRequestOptions{},
+		}
+	)
+
+	// The first submission fails immediately.
+	start := time.Now()
+	require.NotNil(t, submitter.SubmitProof(context.Background(), proofWithHeader))
+	require.Less(t, time.Since(start), cooldown)
+
+	// The retry waits for the cooldown.
+	start = time.Now()
+	require.NotNil(t, submitter.SubmitProof(context.Background(), proofWithHeader))
+	require.GreaterOrEqual(t, time.Since(start), cooldown)
+
+	// The cooldown doubles after a repeated failure.
+	start = time.Now()
+	require.NotNil(t, submitter.SubmitProof(context.Background(), proofWithHeader))
+	require.GreaterOrEqual(t, time.Since(start), 2*cooldown)
+
+	// Permanent errors abandon the block without a cooldown.
+	proofWithHeader.Meta = &bindings.TaikoDataBlockMetadata{Id: 2}
+	var permanentErr *backoff.PermanentError
+	require.ErrorAs(t, submitter.SubmitProof(context.Background(), proofWithHeader), &permanentErr)
+}
+
+func TestSubmitProofTooOld(t *testing.T) {
+	var (
+		submitter       = &ProofSubmitter{maxProofAge: time.Minute}
+		proofWithHeader = &producer.ProofWithHeader{
+			BlockID:    common.Big1,
+			Meta:       &bindings.TaikoDataBlockMetadata{Id: 1},
+			Header:     &types.Header{Number: common.Big1},
+			Opts:       &producer.ProofRequestOptions{},
+			ProducedAt: time.Now().Add(-2 * time.Minute),
+		}
+	)
+
+	// The aged proof is discarded before touching any RPC client.
+	err := submitter.SubmitProof(context.Background(), proofWithHeader)
+	require.ErrorIs(t, err, ErrProofTooOld)
+	var permanentErr *backoff.PermanentError
+	require.ErrorAs(t, err, &permanentErr)
+}
+
+// revertError mocks a go-ethereum JSON-RPC error of a reverted call.
+type revertError struct{ data string }
+
+func (e *revertError) Error() string          { return "execution reverted" }
+func (e *revertError) ErrorData() interface{} { return e.data }
+
+func TestHandleNotAssignedProverRevert(t *testing.T) {
+	notAssignedErr := &revertError{data: encoding.TaikoL1ABI.Errors["L1_NOT_ASSIGNED_PROVER"].ID.Hex()[:10]}
+
+	// Retry by default.
+	err := new(ProofSubmitter).handleSubmissionError(common.Big1, notAssignedErr)
+	require.EqualError(t, err, "L1_NOT_ASSIGNED_PROVER")
+	var permanentErr *backoff.PermanentError
+	require.False(t, errors.As(err, &permanentErr))
+
+	// Abandon the block if configured.
+	err = (&ProofSubmitter{abandonNotAssigned: true}).handleSubmissionError(common.Big1, notAssignedErr)
+	require.ErrorIs(t, err, ErrNotAssignedProver)
+	require.ErrorAs(t, err, &permanentErr)
+
+	// Other errors are not affected.
+	err = (&ProofSubmitter{abandonNotAssigned: true}).handleSubmissionError(common.Big1, errors.New("L1_TEST"))
+	require.EqualError(t, err, "L1_TEST")
+	require.Nil(t, new(ProofSubmitter).handleSubmissionError(common.Big1, transaction.ErrUnretryableSubmission))
+}
+
+func TestCheckRequestTimeout(t *testing.T) {
+	enabled := gethMetrics.Enabled
+	gethMetrics.Enabled = true
+	defer func() { gethMetrics.Enabled = enabled }()
+
+	internalTimeouts := metrics.ProverRequestProofTimeoutCounter(requestTimeoutInternal)
+	callerTimeouts := metrics.ProverRequestProofTimeoutCounter(requestTimeoutCaller)
+	internalCount, callerCount := internalTimeouts.Snapshot().Count(), callerTimeouts.Snapshot().Count()
+
+	s := &ProofSubmitter{requestTimeout: time.Millisecond}
+	blockID := common.Big1
+
+	// Hits the submitter's own timeout.
+	requestCtx, cancel := context.WithTimeoutCause(context.Background(), s.requestTimeout, ErrRequestProofTimeout)
+	defer cancel()
+	<-requestCtx.Done()
+	err := s.checkRequestTimeout(context.Background(), requestCtx, blockID, requestCtx.Err())
+	require.ErrorIs(t, err, ErrRequestProofTimeout)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, internalCount+1, internalTimeouts.Snapshot().Count())
+
+	// Hits the caller's deadline.
+	callerCtx, cancelCaller := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancelCaller()
+	requestCtx, cancel = context.WithTimeoutCause(callerCtx, time.Hour, ErrRequestProofTimeout)
+	defer cancel()
+	<-requestCtx.Done()
+	err = s.checkRequestTimeout(callerCtx, requestCtx, blockID, requestCtx.Err())
+	require.NotErrorIs(t, err, ErrRequestProofTimeout)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, callerCount+1, callerTimeouts.Snapshot().Count())
+
+	// Other errors are kept as they are.
+	errOther := errors.New("other")
+	require.Equal(t, errOther, s.checkRequestTimeout(context.Background(), requestCtx, blockID, errOther))
+	require.Nil(t, s.checkRequestTimeout(context.Background(), requestCtx, blockID, nil))
+	require.Equal(t, internalCount+1, internalTimeouts.Snapshot().Count())
+	require.Equal(t, callerCount+1, callerTimeouts.Snapshot().Count())
+}
+
 func TestProofSubmitterTestSuite(t *testing.T) {
 	suite.Run(t, new(ProofSubmitterTestSuite))
 }
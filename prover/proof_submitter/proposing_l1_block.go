@@ -0,0 +1,60 @@
+package submitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrProposingL1BlockReorged is returned when the L1 block a contested L2 block was proposed in is no
+// longer canonical.
+var ErrProposingL1BlockReorged = errors.New("proposing L1 block reorged")
+
+// l1HeaderReader fetches the L1 block headers.
+type l1HeaderReader interface {
+	HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// proposingL1Header fetches the header of the L1 block the contested L2 block was proposed in, by the
+// hash from the original event if set, otherwise by number.
+func proposingL1Header(ctx context.Context, l1 l1HeaderReader, req *ContestRequest) (*types.Header, error) {
+	if req.ProposedInL1Hash == (common.Hash{}) {
+		return l1.HeaderByNumber(ctx, req.ProposedIn)
+	}
+
+	return canonicalHeaderByHash(ctx, l1, req.ProposedInL1Hash)
+}
+
+// canonicalHeaderByHash fetches the L1 block header with the given hash, and ensures the block is still
+// canonical, an error wrapping ErrProposingL1BlockReorged is returned if it's not.
+func canonicalHeaderByHash(ctx context.Context, l1 l1HeaderReader, hash common.Hash) (*types.Header, error) {
+	header, err := l1.HeaderByHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return nil, fmt.Errorf("%w: L1 block %s not found", ErrProposingL1BlockReorged, hash)
+		}
+		return nil, err
+	}
+
+	canonical, err := l1.HeaderByNumber(ctx, header.Number)
+	if err != nil {
+		return nil, err
+	}
+	if canonical.Hash() != hash {
+		return nil, fmt.Errorf(
+			"%w: L1 block %d hash %s, canonical hash %s",
+			ErrProposingL1BlockReorged,
+			header.Number,
+			hash,
+			canonical.Hash(),
+		)
+	}
+
+	return header, nil
+}
@@ -0,0 +1,76 @@
+package submitter
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// testL1Chain is a l1HeaderReader implementation for testing, which can be reorged.
+type testL1Chain struct {
+	headers   map[common.Hash]*types.Header
+	canonical map[uint64]*types.Header
+}
+
+func newTestL1Chain() *testL1Chain {
+	return &testL1Chain{headers: make(map[common.Hash]*types.Header), canonical: make(map[uint64]*types.Header)}
+}
+
+// insert inserts a new canonical header with the given number, the previous header with the same number
+// is reorged out.
+func (c *testL1Chain) insert(number uint64, extra string) *types.Header {
+	header := &types.Header{Number: new(big.Int).SetUint64(number), Extra: []byte(extra)}
+	c.headers[header.Hash()] = header
+	c.canonical[number] = header
+	return header
+}
+
+func (c *testL1Chain) HeaderByHash(_ context.Context, hash common.Hash) (*types.Header, error) {
+	if header, ok := c.headers[hash]; ok {
+		return header, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func (c *testL1Chain) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	if header, ok := c.canonical[number.Uint64()]; ok {
+		return header, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func TestProposingL1Header(t *testing.T) {
+	chain := newTestL1Chain()
+	proposedIn := chain.insert(10, "original")
+	req := &ContestRequest{ProposedIn: proposedIn.Number, ProposedInL1Hash: proposedIn.Hash()}
+
+	// Both lookups find the canonical proposing L1 block.
+	header, err := proposingL1Header(context.Background(), chain, req)
+	require.Nil(t, err)
+	require.Equal(t, proposedIn.Hash(), header.Hash())
+
+	header, err = proposingL1Header(context.Background(), chain, &ContestRequest{ProposedIn: proposedIn.Number})
+	require.Nil(t, err)
+	require.Equal(t, proposedIn.Hash(), header.Hash())
+
+	// Reorg the proposing L1 block.
+	reorged := chain.insert(10, "reorged")
+
+	// The number-based lookup silently returns the new block, while the hash-based lookup detects the reorg.
+	header, err = proposingL1Header(context.Background(), chain, &ContestRequest{ProposedIn: proposedIn.Number})
+	require.Nil(t, err)
+	require.Equal(t, reorged.Hash(), header.Hash())
+
+	_, err = proposingL1Header(context.Background(), chain, req)
+	require.ErrorIs(t, err, ErrProposingL1BlockReorged)
+
+	// The reorged block is no longer known by the L1 node.
+	delete(chain.headers, proposedIn.Hash())
+	_, err = proposingL1Header(context.Background(), chain, req)
+	require.ErrorIs(t, err, ErrProposingL1BlockReorged)
+}
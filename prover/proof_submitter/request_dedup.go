@@ -0,0 +1,109 @@
+package submitter
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+)
+
+// maxDedupedRequests is the maximum number of the latest proof requests remembered by a requestDeduper.
+const maxDedupedRequests = 1024
+
+// dedupedRequest is a remembered proof request of a block.
+type dedupedRequest struct {
+	meta bindings.TaikoDataBlockMetadata
+	// Zero if the proof is still being produced
+	completedAt time.Time
+}
+
+// requestDeduper remembers the in-flight and the recently completed proof requests, so the duplicated
+// requests of the same block, e.g. caused by the re-emitted `BlockProposed` events after a reorg, won't
+// produce the same proof again. A request of the same block with different metadata is never a duplicate.
+type requestDeduper struct {
+	mu       sync.Mutex
+	window   time.Duration
+	requests *lru.BasicLRU[uint64, *dedupedRequest]
+	nowFn    func() time.Time
+}
+
+// newRequestDeduper creates a new requestDeduper instance, which remembers the completed requests within
+// the given window, returns nil if the window is zero, which means no deduplication.
+func newRequestDeduper(window time.Duration) *requestDeduper {
+	if window == 0 {
+		return nil
+	}
+
+	requests := lru.NewBasicLRU[uint64, *dedupedRequest](maxDedupedRequests)
+	return &requestDeduper{window: window, requests: &requests, nowFn: time.Now}
+}
+
+// begin marks the proof request of the given block as in-flight, returns false if it is a duplicate of an
+// in-flight or a recently completed request.
+func (d *requestDeduper) begin(blockID uint64, meta *bindings.TaikoDataBlockMetadata) bool {
+	if d == nil {
+		return true
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if req, ok := d.requests.Get(blockID); ok && req.meta == *meta {
+		if req.completedAt.IsZero() {
+			log.Info("Skip the duplicated proof request, proof is being produced", "blockID", blockID)
+			return false
+		}
+		if d.nowFn().Sub(req.completedAt) < d.window {
+			log.Info("Skip the duplicated proof request, proof was just produced", "blockID", blockID)
+			return false
+		}
+	}
+	d.requests.Add(blockID, &dedupedRequest{meta: *meta})
+
+	return true
+}
+
+// complete marks the in-flight proof request of the given block as completed.
+func (d *requestDeduper) complete(blockID uint64) {
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if req, ok := d.requests.Peek(blockID); ok {
+		req.completedAt = d.nowFn()
+	}
+}
+
+// forget forgets the proof request of the given block, so it can be requested again.
+func (d *requestDeduper) forget(blockID uint64) {
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.requests.Remove(blockID)
+}
+
+// forgetUntil forgets the proof requests of all blocks whose ID is not greater than the given block ID.
+func (d *requestDeduper) forgetUntil(blockID uint64) {
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, id := range d.requests.Keys() {
+		if id <= blockID {
+			d.requests.Remove(id)
+		}
+	}
+}
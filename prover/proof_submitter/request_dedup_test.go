@@ -0,0 +1,82 @@
+package submitter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/internal/testutils"
+)
+
+func TestRequestDeduper(t *testing.T) {
+	var (
+		now     = time.Now()
+		dedup   = newRequestDeduper(time.Minute)
+		meta    = &bindings.TaikoDataBlockMetadata{Id: 1, L1Hash: testutils.RandomHash()}
+		reorged = &bindings.TaikoDataBlockMetadata{Id: 1, L1Hash: testutils.RandomHash()}
+	)
+	dedup.nowFn = func() time.Time { return now }
+
+	// The in-flight request is not requested again.
+	require.True(t, dedup.begin(1, meta))
+	require.False(t, dedup.begin(1, meta))
+
+	// The recently completed request is not requested again within the window.
+	dedup.complete(1)
+	now = now.Add(time.Minute - time.Second)
+	require.False(t, dedup.begin(1, meta))
+	now = now.Add(time.Second)
+	require.True(t, dedup.begin(1, meta))
+
+	// The request with different metadata is not a duplicate.
+	require.True(t, dedup.begin(1, reorged))
+
+	// The forgotten requests can be requested again.
+	dedup.forget(1)
+	require.True(t, dedup.begin(1, reorged))
+	require.True(t, dedup.begin(2, meta))
+	require.True(t, dedup.begin(3, meta))
+	dedup.forgetUntil(2)
+	require.True(t, dedup.begin(1, reorged))
+	require.True(t, dedup.begin(2, meta))
+	require.False(t, dedup.begin(3, meta))
+
+	// The remembered requests are bounded.
+	for i := uint64(0); i < maxDedupedRequests; i++ {
+		require.True(t, dedup.begin(100+i, meta))
+	}
+	require.True(t, dedup.begin(3, meta))
+
+	// No deduplication if the window is zero.
+	dedup = newRequestDeduper(0)
+	require.Nil(t, dedup)
+	require.True(t, dedup.begin(1, meta))
+	require.True(t, dedup.begin(1, meta))
+	dedup.complete(1)
+	dedup.forget(1)
+	dedup.forgetUntil(1)
+}
+
+func TestRequestProofDeduplicated(t *testing.T) {
+	submitter := &ProofSubmitter{requestDedup: newRequestDeduper(time.Minute)}
+	event := &bindings.TaikoL1ClientBlockProposed{
+		BlockId: common.Big1,
+		Meta:    bindings.TaikoDataBlockMetadata{Id: 1},
+	}
+
+	// The block is being proven, the duplicated request returns without touching any RPC client.
+	require.True(t, submitter.requestDedup.begin(1, &event.Meta))
+	require.Nil(t, submitter.RequestProof(context.Background(), event))
+
+	// The block is verified, its request is not skipped anymore.
+	submitter.OnBlockVerified(common.Big1)
+	require.True(t, submitter.requestDedup.begin(1, &event.Meta))
+
+	// The block is contested, its request is not skipped anymore.
+	submitter.OnTransitionContested(common.Big1)
+	require.True(t, submitter.requestDedup.begin(1, &event.Meta))
+}
@@ -0,0 +1,107 @@
+package submitter
+
+import (
+	"context"
+	"sync"
+)
+
+// requestPool bounds the number of the proof requests in flight against the proof producer, so several
+// blocks can be proven simultaneously without overloading the producer, and optionally delivers the
+// produced proofs in the order of their requests.
+type requestPool struct {
+	// Slots of the in-flight proof requests, nil means no limit
+	slots   chan struct{}
+	ordered bool
+
+	mu sync.Mutex
+	// Closed once the latest accepted request's turn to deliver its proof is over
+	lastTurn chan struct{}
+}
+
+// newRequestPool creates a new requestPool instance, returns nil if the given concurrency is zero and
+// the proofs are not required to be delivered in order, which means no limit.
+func newRequestPool(concurrency uint64, ordered bool) *requestPool {
+	if concurrency == 0 && !ordered {
+		return nil
+	}
+
+	pool := &requestPool{ordered: ordered}
+	if concurrency != 0 {
+		pool.slots = make(chan struct{}, concurrency)
+	}
+	if ordered {
+		pool.lastTurn = make(chan struct{})
+		close(pool.lastTurn)
+	}
+
+	return pool
+}
+
+// acquire blocks until a slot is available for a new proof request, the returned function must be called
+// to release the slot once the proof request is finished.
+func (p *requestPool) acquire(ctx context.Context) (func(), error) {
+	if p == nil || p.slots == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case p.slots <- struct{}{}:
+		return func() { <-p.slots }, nil
+	}
+}
+
+// deliveryTurn is a proof request's turn to deliver its proof, which begins once all previously accepted
+// requests have delivered their proofs or failed.
+type deliveryTurn struct {
+	prev <-chan struct{}
+	done chan struct{}
+}
+
+// nextTurn returns the delivery turn of a newly accepted proof request, nil is returned if the proofs are
+// not required to be delivered in order. The turn must always be finished.
+func (p *requestPool) nextTurn() *deliveryTurn {
+	if p == nil || !p.ordered {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	turn := &deliveryTurn{prev: p.lastTurn, done: make(chan struct{})}
+	p.lastTurn = turn.done
+
+	return turn
+}
+
+// wait blocks until it is the turn to deliver the proof.
+func (t *deliveryTurn) wait(ctx context.Context) error {
+	if t == nil {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.prev:
+		return nil
+	}
+}
+
+// finish ends the turn, the next request's turn begins once the previous requests have finished too.
+func (t *deliveryTurn) finish() {
+	if t == nil {
+		return
+	}
+
+	select {
+	case <-t.prev:
+		close(t.done)
+	default:
+		go func() {
+			<-t.prev
+			close(t.done)
+		}()
+	}
+}
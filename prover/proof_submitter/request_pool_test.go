@@ -0,0 +1,85 @@
+package submitter
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestPoolDisabled(t *testing.T) {
+	pool := newRequestPool(0, false)
+	require.Nil(t, pool)
+
+	release, err := pool.acquire(context.Background())
+	require.Nil(t, err)
+	release()
+
+	turn := pool.nextTurn()
+	require.Nil(t, turn.wait(context.Background()))
+	turn.finish()
+}
+
+func TestRequestPoolAcquire(t *testing.T) {
+	pool := newRequestPool(2, false)
+
+	release1, err := pool.acquire(context.Background())
+	require.Nil(t, err)
+	release2, err := pool.acquire(context.Background())
+	require.Nil(t, err)
+
+	// No slot available.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = pool.acquire(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// A released slot can be acquired again.
+	release1()
+	release3, err := pool.acquire(context.Background())
+	require.Nil(t, err)
+	release2()
+	release3()
+}
+
+func TestRequestPoolOrderedDelivery(t *testing.T) {
+	var (
+		pool      = newRequestPool(0, true)
+		turns     = []*deliveryTurn{pool.nextTurn(), pool.nextTurn(), pool.nextTurn()}
+		delivered []int
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+	)
+
+	// The requests finish in the reverse order, the second request fails.
+	for i := len(turns) - 1; i >= 0; i-- {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer turns[i].finish()
+			if i == 1 {
+				return
+			}
+			require.Nil(t, turns[i].wait(context.Background()))
+
+			mu.Lock()
+			defer mu.Unlock()
+			delivered = append(delivered, i)
+		}(i)
+		time.Sleep(10 * time.Millisecond)
+	}
+	wg.Wait()
+
+	require.Equal(t, []int{0, 2}, delivered)
+
+	// Waiting for the turn can be cancelled.
+	blocked := pool.nextTurn()
+	defer blocked.finish()
+	next := pool.nextTurn()
+	defer next.finish()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, next.wait(ctx), context.Canceled)
+}
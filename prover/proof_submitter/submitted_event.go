@@ -0,0 +1,144 @@
+package submitter
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/pkg/sender"
+	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+// ProofSubmittedEvent is published once a proof submission transaction is confirmed, so the prover
+// operators can feed their accounting systems and reconcile the rewards.
+type ProofSubmittedEvent struct {
+	BlockID *big.Int
+	Tier    uint16
+	TxHash  common.Hash
+	// Gas used by the proof submission transaction, and its effective gas price in wei
+	GasUsed           uint64
+	EffectiveGasPrice *big.Int
+	// Validity bond of the proof's tier, locked in TaikoToken until the transition is verified, nil if the
+	// tiers can't be fetched
+	ValidityBond *big.Int
+	// Tier fee paid to this prover for the block, from the prover assignment of the block's minimum tier,
+	// nil if the block wasn't assigned to this prover, or the assignment can't be found
+	TierFee *big.Int
+}
+
+// SetProofSubmittedCh sets the channel to publish a ProofSubmittedEvent to, once each proof submission
+// transaction is confirmed. The events are dropped if the channel is full, so the proof submissions are
+// never blocked by a slow consumer.
+//
+// NOTE: the prover itself doesn't consume these events, the channel is only meant for the callers which
+// create their own ProofSubmitter.
+func (s *ProofSubmitter) SetProofSubmittedCh(ch chan<- *ProofSubmittedEvent) {
+	s.proofSubmittedCh = ch
+}
+
+// publishProofSubmitted publishes the ProofSubmittedEvent of the given confirmed proof submission
+// transaction, if a channel is set.
+func (s *ProofSubmitter) publishProofSubmitted(
+	ctx context.Context,
+	proofWithHeader *proofProducer.ProofWithHeader,
+	confirmed *sender.TxToConfirm,
+) {
+	if s.proofSubmittedCh == nil || confirmed == nil {
+		return
+	}
+
+	event := &ProofSubmittedEvent{
+		BlockID: proofWithHeader.BlockID,
+		Tier:    proofWithHeader.Tier,
+		TxHash:  confirmed.CurrentTx.Hash(),
+	}
+	if confirmed.Receipt != nil {
+		event.TxHash = confirmed.Receipt.TxHash
+		event.GasUsed = confirmed.Receipt.GasUsed
+		event.EffectiveGasPrice = confirmed.Receipt.EffectiveGasPrice
+	}
+
+	validityBond, err := s.validityBond(ctx, proofWithHeader.Tier)
+	if err != nil {
+		log.Warn("Failed to get the validity bond of the submitted proof", "blockID", event.BlockID, "error", err)
+	}
+	event.ValidityBond = validityBond
+
+	tierFee, err := s.tierFee(ctx, proofWithHeader)
+	if err != nil {
+		log.Warn("Failed to get the tier fee of the submitted proof", "blockID", event.BlockID, "error", err)
+	}
+	event.TierFee = tierFee
+
+	select {
+	case s.proofSubmittedCh <- event:
+	default:
+		log.Warn("Proof submitted events channel full, drop the event", "blockID", event.BlockID, "txHash", event.TxHash)
+	}
+}
+
+// validityBond returns the validity bond of the given tier.
+func (s *ProofSubmitter) validityBond(ctx context.Context, tier uint16) (*big.Int, error) {
+	tiers, err := s.rpc.GetTiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tiers: %w", err)
+	}
+	for _, t := range tiers {
+		if t.ID == tier {
+			return t.ValidityBond, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// tierFee returns the tier fee paid to this prover for the given block, which is the fee of the block's
+// minimum tier in the prover assignment, from the AssignmentHook's BlockAssigned event emitted when the
+// block was proposed. Nil is returned if the block wasn't assigned to this prover, or the event can't be
+// found.
+func (s *ProofSubmitter) tierFee(
+	ctx context.Context,
+	proofWithHeader *proofProducer.ProofWithHeader,
+) (*big.Int, error) {
+	if s.assignmentHookAddress == (common.Address{}) ||
+		proofWithHeader.Opts == nil ||
+		proofWithHeader.Opts.EventL1Hash == (common.Hash{}) {
+		return nil, nil
+	}
+
+	hook, err := bindings.NewAssignmentHookFilterer(s.assignmentHookAddress, s.rpc.L1)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := s.rpc.L1.FilterLogs(ctx, ethereum.FilterQuery{
+		BlockHash: &proofWithHeader.Opts.EventL1Hash,
+		Addresses: []common.Address{s.assignmentHookAddress},
+		Topics:    [][]common.Hash{{encoding.AssignmentHookABI.Events["BlockAssigned"].ID}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the BlockAssigned events: %w", err)
+	}
+
+	for _, l := range logs {
+		assigned, err := hook.ParseBlockAssigned(l)
+		if err != nil {
+			return nil, err
+		}
+		if assigned.Meta.Id != proofWithHeader.BlockID.Uint64() || assigned.AssignedProver != s.proverAddress {
+			continue
+		}
+		for _, tierFee := range assigned.Assignment.TierFees {
+			if tierFee.Tier == assigned.Meta.MinTier {
+				return tierFee.Fee, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
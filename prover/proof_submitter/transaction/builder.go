@@ -2,6 +2,7 @@ package transaction
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -23,13 +24,30 @@ type TxBuilder func(txOpts *bind.TransactOpts) (*types.Transaction, error)
 // ProveBlockTxBuilder is responsible for building ProveBlock transactions.
 type ProveBlockTxBuilder struct {
 	rpc *rpc.Client
+	// Proof serializers of the tiers whose verifiers expect a non-default proof format
+	serializers map[uint16]ProofSerializer
 }
 
 // NewProveBlockTxBuilder creates a new ProveBlockTxBuilder instance.
 func NewProveBlockTxBuilder(
 	rpc *rpc.Client,
 ) *ProveBlockTxBuilder {
-	return &ProveBlockTxBuilder{rpc: rpc}
+	return &ProveBlockTxBuilder{rpc: rpc, serializers: make(map[uint16]ProofSerializer)}
+}
+
+// SetProofSerializer sets the proof serializer of the given tier, the tiers without a serializer
+// use RawProofSerializer.
+func (a *ProveBlockTxBuilder) SetProofSerializer(tier uint16, serializer ProofSerializer) {
+	a.serializers[tier] = serializer
+}
+
+// proofSerializer returns the proof serializer of the given tier.
+func (a *ProveBlockTxBuilder) proofSerializer(tier uint16) ProofSerializer {
+	if serializer, ok := a.serializers[tier]; ok {
+		return serializer
+	}
+
+	return new(RawProofSerializer)
 }
 
 // Build creates a new TaikoL1.ProveBlock transaction with the given nonce.
@@ -56,8 +74,14 @@ func (a *ProveBlockTxBuilder) Build(
 			"guardian", guardian,
 		)
 
+		proof, err := a.proofSerializer(tierProof.Tier).Serialize(tierProof.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize tier %d proof: %w", tierProof.Tier, err)
+		}
+		serializedProof := &bindings.TaikoDataTierProof{Tier: tierProof.Tier, Data: proof}
+
 		if !guardian {
-			input, err := encoding.EncodeProveBlockInput(meta, transition, tierProof)
+			input, err := encoding.EncodeProveBlockInput(meta, transition, serializedProof)
 			if err != nil {
 				return nil, err
 			}
@@ -68,7 +92,7 @@ func (a *ProveBlockTxBuilder) Build(
 				return nil, ErrUnretryableSubmission
 			}
 		} else {
-			if tx, err = a.rpc.GuardianProver.Approve(txOpts, *meta, *transition, *tierProof); err != nil {
+			if tx, err = a.rpc.GuardianProver.Approve(txOpts, *meta, *transition, *serializedProof); err != nil {
 				if isSubmitProofTxErrorRetryable(err, blockID) {
 					return nil, err
 				}
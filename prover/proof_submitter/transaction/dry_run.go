@@ -0,0 +1,30 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	producer "github.com/taikoxyz/taiko-client/prover/proof_producer"
+)
+
+// DryRun runs the same checks as Send, and builds the proof submission transaction without broadcasting
+// it, the transaction's gas limit is always estimated by the L1 node. Nil is returned if the proof is no
+// longer needed to be submitted.
+func (s *Sender) DryRun(
+	ctx context.Context,
+	proofWithHeader *producer.ProofWithHeader,
+	buildTx TxBuilder,
+) (*types.Transaction, error) {
+	// Check if this proof is still needed to be submitted.
+	ok, err := s.validateProof(ctx, proofWithHeader)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	opts := s.innerSender.GetOpts(ctx)
+	opts.GasLimit = 0
+	opts.NoSend = true
+
+	return buildTx(opts)
+}
@@ -0,0 +1,87 @@
+package transaction
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+)
+
+// gasPriceCeilingPollInterval is the interval to check the L1 base fee again, when a proof submission is
+// deferred due to the base fee exceeding its tier's gas price ceiling.
+var gasPriceCeilingPollInterval = 12 * time.Second
+
+// SetGasPriceCeilings sets the gas price ceilings of the tiers, the proof submissions and contests of a
+// tier are deferred while the L1 base fee exceeds its ceiling, the tiers without a ceiling are never
+// deferred.
+func (s *Sender) SetGasPriceCeilings(ceilings map[uint16]*big.Int) {
+	s.gasPriceCeilings = ceilings
+}
+
+// waitForGasPriceCeiling blocks until the L1 base fee doesn't exceed the gas price ceiling of the given tier.
+func (s *Sender) waitForGasPriceCeiling(ctx context.Context, tier uint16) error {
+	ceiling, ok := s.gasPriceCeilings[tier]
+	if !ok || ceiling == nil {
+		return nil
+	}
+
+	deferred, err := waitForBaseFee(ctx, tier, ceiling, func(ctx context.Context) (*big.Int, error) {
+		head, err := s.rpc.L1.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		return head.BaseFee, nil
+	})
+	if deferred > 0 {
+		metrics.ProverSubmissionDeferredHistogram().Update(deferred.Milliseconds())
+	}
+
+	return err
+}
+
+// waitForBaseFee keeps polling the L1 base fee until it doesn't exceed the given ceiling, and returns how
+// long the submission has been deferred.
+func waitForBaseFee(
+	ctx context.Context,
+	tier uint16,
+	ceiling *big.Int,
+	baseFee func(ctx context.Context) (*big.Int, error),
+) (time.Duration, error) {
+	var (
+		start  = time.Now()
+		logged bool
+	)
+	for {
+		fee, err := baseFee(ctx)
+		if err != nil {
+			return time.Since(start), err
+		}
+		// The base fee is nil before the London fork.
+		if fee == nil || fee.Cmp(ceiling) <= 0 {
+			if logged {
+				log.Info("L1 base fee drops below the gas price ceiling, resume proof submission", "tier", tier)
+				return time.Since(start), nil
+			}
+			return 0, nil
+		}
+
+		if !logged {
+			log.Warn(
+				"L1 base fee exceeds the gas price ceiling, defer proof submission",
+				"tier", tier,
+				"baseFee", fee,
+				"ceiling", ceiling,
+			)
+			logged = true
+		}
+
+		select {
+		case <-ctx.Done():
+			return time.Since(start), ctx.Err()
+		case <-time.After(gasPriceCeilingPollInterval):
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+func TestWaitForBaseFee(t *testing.T) {
+	defer func(interval time.Duration) { gasPriceCeilingPollInterval = interval }(gasPriceCeilingPollInterval)
+	gasPriceCeilingPollInterval = 10 * time.Millisecond
+
+	var (
+		ceiling  = big.NewInt(100)
+		baseFees = []*big.Int{big.NewInt(300), big.NewInt(200), big.NewInt(100)}
+		calls    int
+		baseFee  = func(context.Context) (*big.Int, error) {
+			fee := baseFees[calls]
+			calls++
+			return fee, nil
+		}
+	)
+
+	// Deferred until the base fee drops to the ceiling.
+	deferred, err := waitForBaseFee(context.Background(), encoding.TierOptimisticID, ceiling, baseFee)
+	require.Nil(t, err)
+	require.Equal(t, 3, calls)
+	require.GreaterOrEqual(t, deferred, 2*gasPriceCeilingPollInterval)
+
+	// Not deferred if the base fee doesn't exceed the ceiling.
+	calls = 2
+	deferred, err = waitForBaseFee(context.Background(), encoding.TierOptimisticID, ceiling, baseFee)
+	require.Nil(t, err)
+	require.Zero(t, deferred)
+
+	// Never dropped, only returns when the context is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = waitForBaseFee(ctx, encoding.TierOptimisticID, ceiling, func(context.Context) (*big.Int, error) {
+		return big.NewInt(101), nil
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Base fee lookup errors are returned.
+	_, err = waitForBaseFee(
+		context.Background(),
+		encoding.TierOptimisticID,
+		ceiling,
+		func(context.Context) (*big.Int, error) { return nil, errors.New("connection refused") },
+	)
+	require.ErrorContains(t, err, "connection refused")
+}
+
+func TestWaitForGasPriceCeilingNotSet(t *testing.T) {
+	s := new(Sender)
+	require.Nil(t, s.waitForGasPriceCeiling(context.Background(), encoding.TierOptimisticID))
+
+	s.SetGasPriceCeilings(map[uint16]*big.Int{encoding.TierSgxID: big.NewInt(1)})
+	require.Nil(t, s.waitForGasPriceCeiling(context.Background(), encoding.TierOptimisticID))
+}
@@ -0,0 +1,128 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/pkg/sender"
+)
+
+var (
+	// Initial interval before the first resubmission, doubled after each attempt.
+	resubmissionInitialInterval = 2 * time.Second
+	// Randomization factor of the resubmission intervals, so the resubmissions of the proofs which
+	// failed together won't hit the mempool at the same time.
+	resubmissionJitter = 0.5
+)
+
+// Resubmission configures how a failed proof submission is resubmitted, with an exponential backoff plus
+// jitter, and a gas price bump on each attempt.
+type Resubmission struct {
+	// Maximum resubmission attempts after the first submission, 0 means no resubmission
+	MaxRetrys uint64
+	// Cap of the interval between two attempts
+	MaxInterval time.Duration
+	// Percentage to bump the gas fee cap and gas tip cap by on each attempt
+	GasBumpPercentage uint64
+}
+
+// SetResubmission sets how a failed proof submission is resubmitted.
+func (s *Sender) SetResubmission(resubmission *Resubmission) {
+	s.resubmission = resubmission
+}
+
+// newBackOff creates the exponential backoff with jitter between the resubmission attempts.
+func (r *Resubmission) newBackOff(ctx context.Context) backoff.BackOff {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = resubmissionInitialInterval
+	b.RandomizationFactor = resubmissionJitter
+	b.Multiplier = 2
+	b.MaxElapsedTime = 0
+	if r.MaxInterval != 0 {
+		b.MaxInterval = r.MaxInterval
+	}
+	b.Reset()
+
+	return backoff.WithContext(backoff.WithMaxRetries(b, r.MaxRetrys), ctx)
+}
+
+// resubmit calls the given send function, and calls it again on the resubmittable errors with an
+// exponential backoff plus jitter, until it succeeds or the maximum attempts are reached.
+func (r *Resubmission) resubmit(
+	ctx context.Context,
+	blockID *big.Int,
+	send func(attempt uint64) (*sender.TxToConfirm, error),
+) (*sender.TxToConfirm, error) {
+	if r == nil || r.MaxRetrys == 0 {
+		return send(0)
+	}
+
+	var (
+		confirmed *sender.TxToConfirm
+		attempt   uint64
+	)
+	err := backoff.RetryNotify(
+		func() (err error) {
+			defer func() { attempt++ }()
+			if confirmed, err = send(attempt); err != nil && !isResubmittable(err) {
+				return backoff.Permanent(err)
+			}
+			return err
+		},
+		r.newBackOff(ctx),
+		func(err error, interval time.Duration) {
+			log.Warn(
+				"Resubmit the proof with a gas bump",
+				"blockID", blockID,
+				"attempt", attempt,
+				"interval", interval,
+				"error", err,
+			)
+		},
+	)
+
+	return confirmed, err
+}
+
+// bumpGas bumps the gas fee cap and gas tip cap of the given transaction options by the configured
+// percentage for each previous attempt.
+func (r *Resubmission) bumpGas(opts *bind.TransactOpts, attempt uint64) {
+	if r == nil || attempt == 0 || r.GasBumpPercentage == 0 {
+		return
+	}
+
+	var (
+		exp         = new(big.Int).SetUint64(attempt)
+		numerator   = new(big.Int).Exp(new(big.Int).SetUint64(100+r.GasBumpPercentage), exp, nil)
+		denominator = new(big.Int).Exp(big.NewInt(100), exp, nil)
+		bump        = func(v *big.Int) *big.Int {
+			if v == nil {
+				return nil
+			}
+			return new(big.Int).Div(new(big.Int).Mul(v, numerator), denominator)
+		}
+	)
+	opts.GasFeeCap = bump(opts.GasFeeCap)
+	opts.GasTipCap = bump(opts.GasTipCap)
+	opts.GasPrice = bump(opts.GasPrice)
+}
+
+// isResubmittable checks whether a failed proof submission should be resubmitted. The transient
+// mempool errors, e.g. a replaced or timed out transaction, are resubmittable, while the rejections by
+// TaikoL1, e.g. the block has already been proven by someone else, are not.
+func isResubmittable(err error) bool {
+	if errors.Is(err, ErrUnretryableSubmission) ||
+		errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	return !strings.Contains(err.Error(), "L1_")
+}
@@ -0,0 +1,110 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/pkg/sender"
+)
+
+func TestResubmit(t *testing.T) {
+	defer func(interval time.Duration) { resubmissionInitialInterval = interval }(resubmissionInitialInterval)
+	resubmissionInitialInterval = time.Millisecond
+
+	var (
+		resubmission = &Resubmission{MaxRetrys: 3, MaxInterval: 5 * time.Millisecond}
+		errMempool   = errors.New("replacement transaction underpriced")
+		attempts     []uint64
+	)
+
+	// Transient mempool errors are resubmitted until the submission succeeds.
+	confirmed, err := resubmission.resubmit(
+		context.Background(),
+		common.Big1,
+		func(attempt uint64) (*sender.TxToConfirm, error) {
+			attempts = append(attempts, attempt)
+			if attempt < 2 {
+				return nil, errMempool
+			}
+			return &sender.TxToConfirm{ID: "confirmed"}, nil
+		},
+	)
+	require.Nil(t, err)
+	require.Equal(t, "confirmed", confirmed.ID)
+	require.Equal(t, []uint64{0, 1, 2}, attempts)
+
+	// Up to the maximum attempts.
+	attempts = nil
+	_, err = resubmission.resubmit(context.Background(), common.Big1, func(attempt uint64) (*sender.TxToConfirm, error) {
+		attempts = append(attempts, attempt)
+		return nil, errMempool
+	})
+	require.ErrorIs(t, err, errMempool)
+	require.Equal(t, []uint64{0, 1, 2, 3}, attempts)
+
+	// Never resubmitted once the block has been proven by someone else.
+	for _, errProven := range []error{ErrUnretryableSubmission, errors.New("L1_ALREADY_PROVED")} {
+		attempts = nil
+		_, err = resubmission.resubmit(context.Background(), common.Big1, func(attempt uint64) (*sender.TxToConfirm, error) {
+			attempts = append(attempts, attempt)
+			return nil, errProven
+		})
+		require.ErrorIs(t, err, errProven)
+		require.Equal(t, []uint64{0}, attempts)
+	}
+
+	// Sent only once without resubmission.
+	for _, r := range []*Resubmission{nil, {}} {
+		attempts = nil
+		_, err = r.resubmit(context.Background(), common.Big1, func(attempt uint64) (*sender.TxToConfirm, error) {
+			attempts = append(attempts, attempt)
+			return nil, errMempool
+		})
+		require.ErrorIs(t, err, errMempool)
+		require.Equal(t, []uint64{0}, attempts)
+	}
+}
+
+func TestResubmissionBackOff(t *testing.T) {
+	defer func(interval time.Duration) { resubmissionInitialInterval = interval }(resubmissionInitialInterval)
+	resubmissionInitialInterval = time.Second
+
+	b := (&Resubmission{MaxRetrys: 8, MaxInterval: 4 * time.Second}).newBackOff(context.Background())
+
+	// Exponential with jitter, up to the cap.
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second}
+	for _, interval := range expected {
+		next := b.NextBackOff()
+		require.GreaterOrEqual(t, next, time.Duration(float64(interval)*(1-resubmissionJitter)))
+		require.LessOrEqual(t, next, time.Duration(float64(interval)*(1+resubmissionJitter)))
+	}
+}
+
+func TestBumpGas(t *testing.T) {
+	resubmission := &Resubmission{GasBumpPercentage: 10}
+
+	// Not bumped on the first attempt.
+	opts := &bind.TransactOpts{GasFeeCap: big.NewInt(1000), GasTipCap: big.NewInt(100)}
+	resubmission.bumpGas(opts, 0)
+	require.Equal(t, big.NewInt(1000), opts.GasFeeCap)
+	require.Equal(t, big.NewInt(100), opts.GasTipCap)
+
+	// Compounded on each attempt.
+	opts = &bind.TransactOpts{GasFeeCap: big.NewInt(1000), GasTipCap: big.NewInt(100)}
+	resubmission.bumpGas(opts, 2)
+	require.Equal(t, big.NewInt(1210), opts.GasFeeCap)
+	require.Equal(t, big.NewInt(121), opts.GasTipCap)
+	require.Nil(t, opts.GasPrice)
+
+	// Not bumped without resubmission.
+	opts = &bind.TransactOpts{GasFeeCap: big.NewInt(1000)}
+	(*Resubmission)(nil).bumpGas(opts, 2)
+	require.Equal(t, big.NewInt(1000), opts.GasFeeCap)
+}
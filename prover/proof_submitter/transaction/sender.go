@@ -18,6 +18,10 @@ import (
 type Sender struct {
 	rpc         *rpc.Client
 	innerSender *sender.Sender
+	// Gas price ceilings of the tiers, the submissions are deferred while the L1 base fee exceeds them
+	gasPriceCeilings map[uint16]*big.Int
+	// How the failed submissions are resubmitted, nil means no resubmission
+	resubmission *Resubmission
 }
 
 // NewSender creates a new Sener instance.
@@ -37,22 +41,53 @@ func (s *Sender) Send(
 	proofWithHeader *producer.ProofWithHeader,
 	buildTx TxBuilder,
 ) error {
+	_, err := s.SendAndConfirm(ctx, proofWithHeader, buildTx)
+	return err
+}
+
+// SendAndConfirm sends the given proof to the TaikoL1 smart contract with a backoff policy, and returns
+// the confirmed transaction with its receipt, nil is returned if the proof is no longer needed.
+func (s *Sender) SendAndConfirm(
+	ctx context.Context,
+	proofWithHeader *producer.ProofWithHeader,
+	buildTx TxBuilder,
+) (*sender.TxToConfirm, error) {
+	return s.resubmission.resubmit(ctx, proofWithHeader.BlockID, func(attempt uint64) (*sender.TxToConfirm, error) {
+		return s.sendAndConfirm(ctx, proofWithHeader, buildTx, attempt)
+	})
+}
+
+// sendAndConfirm makes a single attempt to send the given proof and wait for its confirmation, the gas
+// price is bumped for the resubmission attempts.
+func (s *Sender) sendAndConfirm(
+	ctx context.Context,
+	proofWithHeader *producer.ProofWithHeader,
+	buildTx TxBuilder,
+	attempt uint64,
+) (*sender.TxToConfirm, error) {
+	// Defer the submission while the L1 base fee exceeds the tier's gas price ceiling.
+	if err := s.waitForGasPriceCeiling(ctx, proofWithHeader.Tier); err != nil {
+		return nil, err
+	}
+
 	// Check if this proof is still needed to be submitted.
 	ok, err := s.validateProof(ctx, proofWithHeader)
 	if err != nil || !ok {
-		return err
+		return nil, err
 	}
 
 	// Assemble the TaikoL1.proveBlock transaction.
-	tx, err := buildTx(s.innerSender.GetOpts(ctx))
+	opts := s.innerSender.GetOpts(ctx)
+	s.resubmission.bumpGas(opts, attempt)
+	tx, err := buildTx(opts)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Send the transaction.
-	id, err := s.innerSender.SendTransaction(tx)
+	// Send the transaction, its replacements never pay more than the tier's gas price ceiling.
+	id, err := s.innerSender.SendTransactionWithMaxGasFee(ctx, tx, s.gasPriceCeilings[proofWithHeader.Tier])
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Waiting for the transaction to be confirmed.
@@ -63,7 +98,7 @@ func (s *Sender) Send(
 			"blockID", proofWithHeader.BlockID,
 			"error", confirmationResult.Err,
 		)
-		return confirmationResult.Err
+		return nil, confirmationResult.Err
 	}
 
 	log.Info(
@@ -79,7 +114,7 @@ func (s *Sender) Send(
 
 	metrics.ProverSubmissionAcceptedCounter.Inc(1)
 
-	return nil
+	return confirmationResult, nil
 }
 
 // validateProof checks if the proof's corresponding L1 block is still in the canonical chain and if the
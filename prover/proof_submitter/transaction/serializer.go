@@ -0,0 +1,36 @@
+package transaction
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+var (
+	// Compile time checks.
+	_ ProofSerializer = (*RawProofSerializer)(nil)
+	_ ProofSerializer = (*ABIProofSerializer)(nil)
+
+	bytesType, _ = abi.NewType("bytes", "", nil)
+	bytesArgs    = abi.Arguments{{Name: "proof", Type: bytesType}}
+)
+
+// ProofSerializer packs a proof into the format expected by the verifier contract of a proof tier.
+type ProofSerializer interface {
+	Serialize(proof []byte) ([]byte, error)
+}
+
+// RawProofSerializer keeps the proof as it is, which is the default format.
+type RawProofSerializer struct{}
+
+// Serialize implements the ProofSerializer interface.
+func (s *RawProofSerializer) Serialize(proof []byte) ([]byte, error) {
+	return proof, nil
+}
+
+// ABIProofSerializer ABI-encodes the proof as a `bytes` value, for the verifiers which decode the proof
+// with `abi.decode(proof, (bytes))`.
+type ABIProofSerializer struct{}
+
+// Serialize implements the ProofSerializer interface.
+func (s *ABIProofSerializer) Serialize(proof []byte) ([]byte, error) {
+	return bytesArgs.Pack(proof)
+}
@@ -0,0 +1,44 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+func TestProofSerializers(t *testing.T) {
+	proof := common.FromHex("0x0102030405")
+
+	raw, err := new(RawProofSerializer).Serialize(proof)
+	require.Nil(t, err)
+	require.Equal(t, proof, raw)
+
+	encoded, err := new(ABIProofSerializer).Serialize(proof)
+	require.Nil(t, err)
+	require.Equal(
+		t,
+		common.FromHex(
+			"0x0000000000000000000000000000000000000000000000000000000000000020"+
+				"0000000000000000000000000000000000000000000000000000000000000005"+
+				"0102030405000000000000000000000000000000000000000000000000000000",
+		),
+		encoded,
+	)
+
+	// Empty proofs, e.g. the optimistic ones.
+	raw, err = new(RawProofSerializer).Serialize([]byte{})
+	require.Nil(t, err)
+	require.Empty(t, raw)
+}
+
+func TestProofSerializerSelection(t *testing.T) {
+	builder := NewProveBlockTxBuilder(nil)
+	require.IsType(t, new(RawProofSerializer), builder.proofSerializer(encoding.TierSgxID))
+
+	builder.SetProofSerializer(encoding.TierSgxID, new(ABIProofSerializer))
+	require.IsType(t, new(ABIProofSerializer), builder.proofSerializer(encoding.TierSgxID))
+	require.IsType(t, new(RawProofSerializer), builder.proofSerializer(encoding.TierOptimisticID))
+}
@@ -7,22 +7,29 @@ import (
 	"math/big"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/internal/errlog"
+	"github.com/taikoxyz/taiko-client/internal/metrics"
 	"github.com/taikoxyz/taiko-client/internal/version"
 	eventIterator "github.com/taikoxyz/taiko-client/pkg/chain_iterator/event_iterator"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
 	"github.com/taikoxyz/taiko-client/pkg/sender"
+	"github.com/taikoxyz/taiko-client/prover/audit"
 	handler "github.com/taikoxyz/taiko-client/prover/event_handler"
 	guardianProverHeartbeater "github.com/taikoxyz/taiko-client/prover/guardian_prover_heartbeater"
+	"github.com/taikoxyz/taiko-client/prover/lease"
 	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
 	proofSubmitter "github.com/taikoxyz/taiko-client/prover/proof_submitter"
 	"github.com/taikoxyz/taiko-client/prover/proof_submitter/transaction"
@@ -60,8 +67,13 @@ type Prover struct {
 	assignmentExpiredHandler   handler.AssignmentExpiredHandler
 
 	// Proof submitters
-	proofSubmitters []proofSubmitter.Submitter
-	proofContester  proofSubmitter.Contester
+	proofSubmitters  []proofSubmitter.Submitter
+	producerRegistry *proofProducer.ProofProducerRegistry
+	proofContester   proofSubmitter.Contester
+	// Tracks the outcomes of the contests submitted by this prover
+	contestTracker *proofSubmitter.ContestTracker
+	// Graffiti shared by the proof submitters and the contester, which can be updated at runtime
+	graffiti *proofSubmitter.Graffiti
 
 	assignmentExpiredCh chan *bindings.TaikoL1ClientBlockProposed
 	proveNotify         chan struct{}
@@ -71,6 +83,23 @@ type Prover struct {
 	proofContestCh    chan *proofProducer.ContestRequestBody
 	proofGenerationCh chan *proofProducer.ProofWithHeader
 
+	// Deadline-aware queue of the proof requests, only used when the proof requests concurrency is limited
+	proofRequestQueue *proofRequestQueue
+	// In-flight proof submissions of each tier
+	pendingSubmissions pendingSubmissions
+	// Pauses the proof production when the L2 execution engine falls behind
+	syncInterlock *syncInterlock
+	// Posts a low balance alert to the webhook
+	balanceAlerter *balanceAlerter
+	// Proving timelines of the recent blocks, for debugging the proving latency
+	provingTimelines *provingTimelines
+	// Makes sure only one prover is active in a HA setup
+	leaderElector *leaderElector
+	// Set on shutdown, no new proof requests or contests are accepted once set
+	draining atomic.Bool
+	// In-flight proof requests, waited for when draining
+	inFlightRequests atomic.Int64
+
 	ctx context.Context
 	wg  sync.WaitGroup
 }
@@ -101,13 +130,21 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 
 	// Clients
 	if p.rpc, err = rpc.NewClient(p.ctx, &rpc.ClientConfig{
-		L1Endpoint:            cfg.L1WsEndpoint,
-		L2Endpoint:            cfg.L2WsEndpoint,
-		TaikoL1Address:        cfg.TaikoL1Address,
-		TaikoL2Address:        cfg.TaikoL2Address,
-		TaikoTokenAddress:     cfg.TaikoTokenAddress,
-		GuardianProverAddress: cfg.GuardianProverAddress,
-		Timeout:               cfg.RPCTimeout,
+		L1Endpoint:              cfg.L1WsEndpoint,
+		L1FailoverEndpoints:     cfg.L1FailoverWsEndpoints,
+		L1FailoverMaxHeadLag:    cfg.L1FailoverMaxHeadLag,
+		L1FailoverCheckInterval: cfg.L1FailoverCheckInterval,
+		L2Endpoint:              cfg.L2WsEndpoint,
+		TaikoL1Address:          cfg.TaikoL1Address,
+		TaikoL2Address:          cfg.TaikoL2Address,
+		TaikoTokenAddress:       cfg.TaikoTokenAddress,
+		GuardianProverAddress:   cfg.GuardianProverAddress,
+		Timeout:                 cfg.RPCTimeout,
+		HealthCheck: rpc.HealthCheckConfig{
+			MaxL1HeadAge:          cfg.HealthMaxL1HeadAge,
+			MaxL2BlockLag:         cfg.HealthMaxL2BlockLag,
+			MaxBeaconSyncDistance: cfg.HealthMaxBeaconSyncDistance,
+		},
 	}); err != nil {
 		return err
 	}
@@ -127,6 +164,13 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 	p.proofSubmissionCh = make(chan *proofProducer.ProofRequestBody, p.cfg.Capacity)
 	p.proofContestCh = make(chan *proofProducer.ContestRequestBody, p.cfg.Capacity)
 	p.proveNotify = make(chan struct{}, 1)
+	p.proofRequestQueue = newProofRequestQueue(p.sharedState.GetTiers)
+	p.syncInterlock = newSyncInterlock(cfg.MaxSyncLag)
+	p.balanceAlerter = newBalanceAlerter(cfg.BalanceAlertWebhook, cfg.BalanceAlertThreshold)
+	p.provingTimelines = newProvingTimelines(maxProvingTimelines)
+	if cfg.LeaseFile != "" {
+		p.leaderElector = newLeaderElector(lease.NewFileLease(cfg.LeaseFile), defaultLeaseHolder(), cfg.LeaseTTL)
+	}
 
 	if err := p.initL1Current(cfg.StartingBlockID); err != nil {
 		return fmt.Errorf("initialize L1 current cursor error: %w", err)
@@ -140,9 +184,23 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 	p.sharedState.SetTiers(tiers)
 
 	senderCfg := &sender.Config{
-		ConfirmationDepth: 0,
-		MaxRetrys:         p.cfg.ProofSubmissionMaxRetry,
-		GasGrowthRate:     p.cfg.ProveBlockTxReplacementGasGrowthRate,
+		ConfirmationDepth:    0,
+		MaxRetrys:            p.cfg.ProofSubmissionMaxRetry,
+		GasGrowthRate:        p.cfg.ProveBlockTxReplacementGasGrowthRate,
+		BroadcastEndpoints:   p.cfg.BroadcastEndpoints,
+		NonceStrategy:        sender.NonceStrategy(p.cfg.TxNonceStrategy),
+		DuplicateNonceRetrys: p.cfg.TxDuplicateNonceRetrys,
+		StuckTxTimeout:       p.cfg.StuckTxTimeout,
+		StuckTxGasBumpRate:   p.cfg.StuckTxGasBumpRate,
+		GasLimitMargin:       p.cfg.GasLimitMargin,
+		GasLimitMarginCap:    p.cfg.GasLimitMarginCap,
+		MaxTxsPerSecond:      p.cfg.MaxTxsPerSecond,
+		BeforeSend:           p.leaderElector.checkActive,
+	}
+	if p.cfg.NonceStoreFile != "" {
+		if senderCfg.NonceStore, err = sender.NewFileNonceStore(p.cfg.NonceStoreFile); err != nil {
+			return err
+		}
 	}
 	if p.cfg.ProveBlockGasLimit != nil {
 		senderCfg.GasLimit = *p.cfg.ProveBlockGasLimit
@@ -155,30 +213,68 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 		senderCfg.MaxRetrys = 0
 	}
 
-	p.txSender, err = sender.NewSender(p.ctx, senderCfg, p.rpc.L1, p.cfg.L1ProverPrivKey)
-	if err != nil {
+	// The proof transactions are signed by the external signer if there is one, the private key is still
+	// required for signing the prover assignments and the guardian prover heartbeats.
+	var txSigner sender.Signer = sender.NewLocalSigner(p.cfg.L1ProverPrivKey, p.rpc.L1.ChainID)
+	if p.cfg.L1ProverSigner != "" {
+		if txSigner, err = sender.NewRemoteSigner(
+			p.ctx,
+			p.cfg.L1ProverSigner,
+			crypto.PubkeyToAddress(p.cfg.L1ProverPrivKey.PublicKey),
+			p.rpc.L1.ChainID,
+		); err != nil {
+			return err
+		}
+	}
+	if p.txSender, err = sender.NewSenderWithSigner(p.ctx, senderCfg, p.rpc.L1, txSigner); err != nil {
 		return err
 	}
 	txBuilder := transaction.NewProveBlockTxBuilder(p.rpc)
 
 	// Proof submitters
+	p.graffiti = proofSubmitter.NewGraffiti(p.cfg.Graffiti)
 	if err := p.initProofSubmitters(p.txSender, txBuilder); err != nil {
 		return err
 	}
 
-	// Proof contester
-	p.proofContester = proofSubmitter.NewProofContester(
+	// Proof contester, use a dedicated sender if a contester account is configured, to avoid the
+	// nonce contention with the proof submissions.
+	contestSender := p.txSender
+	if p.cfg.L1ContesterPrivKey != nil {
+		if contestSender, err = sender.NewSender(p.ctx, senderCfg, p.rpc.L1, p.cfg.L1ContesterPrivKey); err != nil {
+			return fmt.Errorf("failed to initialize contest sender: %w", err)
+		}
+	}
+	proofContester := proofSubmitter.NewProofContester(
 		p.rpc,
-		p.txSender,
+		contestSender,
 		p.cfg.Graffiti,
 		txBuilder,
 	)
+	proofContester.SetGraffiti(p.graffiti)
+	proofContester.SetGasPriceCeilings(p.cfg.GasPriceCeilings)
+	proofContester.SetTransitionLookupRetry(p.cfg.BackOffRetryInterval, p.cfg.BackOffMaxRetrys)
+	proofContester.SetSkipInsufficientBond(p.cfg.SkipInsufficientContestBond)
+	if p.cfg.ContesterMode {
+		// Only warn here, since the bond can still be topped up before the next contest.
+		if err := proofContester.CheckContestBond(p.ctx, p.cfg.TaikoL1Address, tiers); err != nil {
+			log.Warn("Contester bond check failed", "error", err)
+		}
+	}
+	p.proofContester = proofContester
+	p.contestTracker = proofContester.Tracker()
+	if p.cfg.AccountingSnapshotFile != "" {
+		if err := p.contestTracker.LoadSnapshot(p.cfg.AccountingSnapshotFile); err != nil {
+			return err
+		}
+	}
 
 	// Prover server
 	if p.server, err = server.New(&server.NewProverServerOpts{
 		ProverPrivateKey:      p.cfg.L1ProverPrivKey,
 		MinOptimisticTierFee:  p.cfg.MinOptimisticTierFee,
 		MinSgxTierFee:         p.cfg.MinSgxTierFee,
+		MinSgxAndZkVMTierFee:  p.cfg.MinSgxAndZkVMTierFee,
 		MinEthBalance:         p.cfg.MinEthBalance,
 		MinTaikoTokenBalance:  p.cfg.MinTaikoTokenBalance,
 		MaxExpiry:             p.cfg.MaxExpiry,
@@ -188,9 +284,23 @@ func InitFromConfig(ctx context.Context, p *Prover, cfg *Config) (err error) {
 		RPC:                   p.rpc,
 		ProtocolConfigs:       &protocolConfigs,
 		LivenessBond:          protocolConfigs.LivenessBond,
+		ConfigAPIToken:        p.cfg.ConfigAPIToken,
+		ConfigProvider:        p.cfg.Redacted,
+		WarmupMaxSyncLag:      p.cfg.AssignmentWarmupMaxSyncLag,
+		GraffitiSetter:        p.graffiti.Set,
+		BlockAuditor: audit.New(
+			p.rpc,
+			int(p.cfg.AuditConcurrency),
+			int(p.cfg.AuditBlocksPerSecond),
+		).Audit,
 	}); err != nil {
 		return err
 	}
+	if p.cfg.FeeHistoryFile != "" {
+		if err := p.server.LoadFeeHistory(p.cfg.FeeHistoryFile); err != nil {
+			return err
+		}
+	}
 
 	// Guardian prover heartbeat sender
 	if p.IsGuardianProver() && p.cfg.GuardianProverHealthCheckServerEndpoint != nil {
@@ -228,6 +338,13 @@ func (p *Prover) Start() error {
 			log.Crit("Failed to start http server", "error", err)
 		}
 	}()
+	if p.cfg.GRPCAddr != "" {
+		go func() {
+			if err := p.server.StartGRPC(p.cfg.GRPCAddr); err != nil {
+				log.Crit("Failed to start gRPC server", "error", err)
+			}
+		}()
+	}
 
 	// 3. Start the guardian prover heartbeat sender if the current prover is a guardian prover.
 	if p.IsGuardianProver() && p.cfg.GuardianProverHealthCheckServerEndpoint != nil {
@@ -244,7 +361,37 @@ func (p *Prover) Start() error {
 		go p.gurdianProverHeartbeatLoop(p.ctx)
 	}
 
-	// 4. Start the main event loop of the prover.
+	// 4. Start the proof request workers if the proof requests concurrency is limited.
+	for i := uint64(0); i < p.cfg.ProofRequestConcurrency; i++ {
+		go p.proofRequestWorker()
+	}
+
+	// 5. Start the balance runway checks.
+	if p.cfg.BalanceRunwayCheckInterval != 0 {
+		go p.balanceRunwayLoop()
+	}
+
+	// 6. Start the L2 execution engine sync lag checks, for the sync interlock and the assignment warmup.
+	if p.syncInterlock != nil || p.cfg.AssignmentWarmupMaxSyncLag != nil {
+		go p.syncInterlockLoop()
+	}
+
+	// 7. Start the leader election if the prover runs in a HA setup.
+	if p.leaderElector != nil {
+		go p.leaderElectionLoop()
+	}
+
+	// 8. Start the accounting snapshots.
+	if p.cfg.AccountingSnapshotFile != "" || p.cfg.FeeHistoryFile != "" {
+		go p.accountingSnapshotLoop()
+	}
+
+	// 9. Start the bond monitor.
+	if p.cfg.BondMonitorInterval != 0 {
+		go p.bondMonitorLoop()
+	}
+
+	// 10. Start the main event loop of the prover.
 	go p.eventLoop()
 
 	return nil
@@ -295,20 +442,47 @@ func (p *Prover) eventLoop() {
 		case <-p.ctx.Done():
 			return
 		case proofWithHeader := <-p.proofGenerationCh:
-			p.withRetry(func() error { return p.submitProofOp(proofWithHeader) })
+			if p.cfg.ProofSubmissionConcurrency > 1 {
+				p.submitProofsInParallel(p.pendingProofs(proofWithHeader))
+				continue
+			}
+			p.submitProofWithRetry(proofWithHeader)
 		case req := <-p.proofSubmissionCh:
+			if p.cfg.ProofRequestConcurrency != 0 {
+				p.proofRequestQueue.Push(req)
+				continue
+			}
 			p.withRetry(func() error { return p.requestProofOp(req.Event, req.Tier) })
 		case req := <-p.proofContestCh:
-			p.withRetry(func() error { return p.contestProofOp(req) })
+			reqs := p.pendingContestRequests(req)
+			p.withRetry(func() (err error) {
+				reqs, err = p.contestProofOp(reqs)
+				return err
+			})
 		case <-p.proveNotify:
+			if p.draining.Load() {
+				continue
+			}
 			if err := p.proveOp(); err != nil {
+				if errors.Is(err, handler.ErrReorgTooDeep) {
+					log.Crit("Prover halted due to a too deep reorg", "error", err)
+				}
 				log.Error("Prove new blocks error", "error", err)
+				errlog.Record(errlog.ComponentProver, "Prove new blocks error", err)
 			}
 		case e := <-blockVerifiedCh:
+			for _, s := range p.proofSubmitters {
+				s.OnBlockVerified(e.BlockId)
+			}
 			p.blockVerifiedHandler.Handle(e)
 		case e := <-transitionProvedCh:
+			p.contestTracker.OnTransitionProved(e)
 			p.withRetry(func() error { return p.transitionProvedHandler.Handle(p.ctx, e) })
 		case e := <-transitionContestedCh:
+			p.contestTracker.OnTransitionContested(e)
+			for _, s := range p.proofSubmitters {
+				s.OnTransitionContested(e.BlockId)
+			}
 			p.withRetry(func() error { return p.transitionContestedHandler.Handle(p.ctx, e) })
 		case e := <-p.assignmentExpiredCh:
 			p.withRetry(func() error { return p.assignmentExpiredHandler.Handle(p.ctx, e) })
@@ -320,11 +494,35 @@ func (p *Prover) eventLoop() {
 	}
 }
 
+// proofRequestWorker keeps serving the queued proof requests, the request closest to its
+// proving deadline is always served first.
+func (p *Prover) proofRequestWorker() {
+	p.wg.Add(1)
+	defer p.wg.Done()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-p.proofRequestQueue.Notify():
+			req := p.proofRequestQueue.Pop()
+			if req == nil {
+				continue
+			}
+			if err := backoff.Retry(func() error { return p.requestProofOp(req.Event, req.Tier) }, p.backoff); err != nil {
+				log.Error("Operation failed", "error", err)
+				errlog.Record(errlog.ComponentProver, "Request proof failed", err, "blockID", req.Event.BlockId)
+			}
+		}
+	}
+}
+
 // Close closes the prover instance.
 func (p *Prover) Close(ctx context.Context) {
 	if err := p.server.Shutdown(ctx); err != nil {
 		log.Error("Failed to shut down prover server", "error", err)
 	}
+	p.server.ShutdownGRPC()
 	p.wg.Wait()
 }
 
@@ -334,7 +532,7 @@ func (p *Prover) proveOp() error {
 		Client:               p.rpc.L1,
 		TaikoL1:              p.rpc.TaikoL1,
 		StartHeight:          new(big.Int).SetUint64(p.sharedState.GetL1Current().Number.Uint64()),
-		OnBlockProposedEvent: p.blockProposedHandler.Handle,
+		OnBlockProposedEvent: p.onBlockProposed,
 		BlockConfirmations:   &p.cfg.BlockConfirmations,
 	})
 	if err != nil {
@@ -345,38 +543,92 @@ func (p *Prover) proveOp() error {
 	return iter.Iter()
 }
 
-// contestProofOp performs a proof contest operation.
-func (p *Prover) contestProofOp(req *proofProducer.ContestRequestBody) error {
-	if err := p.proofContester.SubmitContest(
-		p.ctx,
-		req.BlockID,
-		req.ProposedIn,
-		req.ParentHash,
-		req.Meta,
-		req.Tier,
-	); err != nil {
-		log.Error(
-			"Request new proof contest error",
-			"blockID", req.BlockID,
-			"minTier", req.Meta.MinTier,
-			"error", err,
-		)
-		return err
+// pendingContestRequests returns the given contest request, along with all the other contest requests
+// already queued, so they can be contested together.
+func (p *Prover) pendingContestRequests(req *proofProducer.ContestRequestBody) []*proofProducer.ContestRequestBody {
+	reqs := []*proofProducer.ContestRequestBody{req}
+	for {
+		select {
+		case req := <-p.proofContestCh:
+			reqs = append(reqs, req)
+		default:
+			return reqs
+		}
 	}
+}
 
-	return nil
+// contestProofOp performs the proof contest operation of the given requests one after another, and
+// returns the requests which failed and should be retried.
+func (p *Prover) contestProofOp(
+	reqs []*proofProducer.ContestRequestBody,
+) ([]*proofProducer.ContestRequestBody, error) {
+	if p.draining.Load() {
+		return nil, backoff.Permanent(errProverDraining)
+	}
+	if err := p.waitProtocolUnpaused(); err != nil {
+		return reqs, err
+	}
+
+	contestReqs := make([]*proofSubmitter.ContestRequest, len(reqs))
+	for i, req := range reqs {
+		contestReqs[i] = &proofSubmitter.ContestRequest{
+			BlockID:          req.BlockID,
+			ProposedIn:       req.ProposedIn,
+			ProposedInL1Hash: req.ProposedInL1Hash,
+			ParentHash:       req.ParentHash,
+			Meta:             req.Meta,
+			Tier:             req.Tier,
+		}
+	}
+
+	var (
+		failed []*proofProducer.ContestRequestBody
+		errs   []error
+	)
+	for i, err := range p.proofContester.SubmitContestsSequentially(p.ctx, contestReqs) {
+		if errors.Is(err, proofSubmitter.ErrProposingL1BlockReorged) {
+			// The contested block will be handled again when its new BlockProposed event is processed.
+			log.Warn("Proposing L1 block reorged, skip the proof contest", "blockID", reqs[i].BlockID, "error", err)
+			continue
+		}
+		if err != nil {
+			log.Error(
+				"Request new proof contest error",
+				"blockID", reqs[i].BlockID,
+				"minTier", reqs[i].Meta.MinTier,
+				"error", err,
+			)
+			failed = append(failed, reqs[i])
+			errs = append(errs, err)
+		}
+	}
+
+	return failed, errors.Join(errs...)
 }
 
 // requestProofOp requests a new proof generation operation.
 func (p *Prover) requestProofOp(e *bindings.TaikoL1ClientBlockProposed, minTier uint16) error {
+	if p.draining.Load() {
+		return backoff.Permanent(errProverDraining)
+	}
+	p.inFlightRequests.Add(1)
+	defer p.inFlightRequests.Add(-1)
+
 	if p.IsGuardianProver() {
 		minTier = encoding.TierGuardianID
 	}
 	if submitter := p.selectSubmitter(minTier); submitter != nil {
+		if err := p.syncInterlock.wait(p.ctx); err != nil {
+			return err
+		}
+		if err := p.leaderElector.wait(p.ctx); err != nil {
+			return err
+		}
 		if err := submitter.RequestProof(p.ctx, e); err != nil {
 			log.Error("Request new proof error", "blockID", e.BlockId, "minTier", e.Meta.MinTier, "error", err)
 			return err
 		}
+		p.provingTimelines.record(e.BlockId, StageProofRequested)
 
 		return nil
 	}
@@ -392,24 +644,169 @@ func (p *Prover) submitProofOp(proofWithHeader *proofProducer.ProofWithHeader) e
 		return nil
 	}
 
+	if err := p.waitSubmissionAllowed(); err != nil {
+		return err
+	}
+
+	p.provingTimelines.record(proofWithHeader.BlockID, StageSubmitSent)
 	if err := submitter.SubmitProof(p.ctx, proofWithHeader); err != nil {
-		log.Error(
-			"Submit proof error",
-			"blockID", proofWithHeader.BlockID,
-			"minTier", proofWithHeader.Meta.MinTier,
-			"error", err,
-		)
+		p.onSubmitProofError(proofWithHeader, err)
+		// The proof submission transaction is already confirmed, resubmitting it would only pay the gas again.
+		if errors.Is(err, proofSubmitter.ErrSubmittedProofNotReflected) {
+			return backoff.Permanent(err)
+		}
 		return err
 	}
+	p.provingTimelines.record(proofWithHeader.BlockID, StageSubmitConfirmed)
 
 	return nil
 }
 
+// pendingProofs returns the given produced proof, along with all the other produced proofs which are
+// already waiting to be submitted.
+func (p *Prover) pendingProofs(proofWithHeader *proofProducer.ProofWithHeader) []*proofProducer.ProofWithHeader {
+	proofs := []*proofProducer.ProofWithHeader{proofWithHeader}
+	for {
+		select {
+		case proofWithHeader := <-p.proofGenerationCh:
+			proofs = append(proofs, proofWithHeader)
+		default:
+			return proofs
+		}
+	}
+}
+
+// submitProofsInParallel submits the given produced proofs through the parallel submission path of
+// their proof submitters, the proofs which fail to be submitted are then retried one by one.
+func (p *Prover) submitProofsInParallel(proofs []*proofProducer.ProofWithHeader) {
+	proofsBySubmitter := make(map[proofSubmitter.Submitter][]*proofProducer.ProofWithHeader)
+	for _, proofWithHeader := range proofs {
+		submitter := p.getSubmitterByTier(proofWithHeader.Tier)
+		if submitter == nil {
+			continue
+		}
+
+		p.provingTimelines.record(proofWithHeader.BlockID, StageProofProduced)
+		p.pendingSubmissions.add(proofWithHeader.Tier, 1)
+		proofsBySubmitter[submitter] = append(proofsBySubmitter[submitter], proofWithHeader)
+	}
+
+	for submitter, proofs := range proofsBySubmitter {
+		p.wg.Add(1)
+		go func(submitter proofSubmitter.Submitter, proofs []*proofProducer.ProofWithHeader) {
+			defer p.wg.Done()
+			p.submitProofsOp(submitter, proofs)
+		}(submitter, proofs)
+	}
+}
+
+// submitProofsOp submits the given proofs of the same tier in parallel, each failed proof is handed
+// over to the prover backoff policy, and stays counted as an in-flight submission until all its retries
+// finish.
+func (p *Prover) submitProofsOp(submitter proofSubmitter.Submitter, proofs []*proofProducer.ProofWithHeader) {
+	errs := make([]error, len(proofs))
+	if err := p.waitSubmissionAllowed(); err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+	} else {
+		for _, proofWithHeader := range proofs {
+			p.provingTimelines.record(proofWithHeader.BlockID, StageSubmitSent)
+		}
+		errs = submitter.SubmitProofs(p.ctx, proofs)
+	}
+
+	for i, proofWithHeader := range proofs {
+		if errs[i] == nil {
+			p.provingTimelines.record(proofWithHeader.BlockID, StageSubmitConfirmed)
+			p.pendingSubmissions.add(proofWithHeader.Tier, -1)
+			continue
+		}
+
+		p.onSubmitProofError(proofWithHeader, errs[i])
+		if errors.Is(errs[i], proofSubmitter.ErrSubmittedProofNotReflected) {
+			p.pendingSubmissions.add(proofWithHeader.Tier, -1)
+			continue
+		}
+		p.withRetryDone(
+			func() error { return p.submitProofOp(proofWithHeader) },
+			func() { p.pendingSubmissions.add(proofWithHeader.Tier, -1) },
+		)
+	}
+}
+
+// waitSubmissionAllowed blocks until the current prover is allowed to submit proofs.
+func (p *Prover) waitSubmissionAllowed() error {
+	// Only the active prover submits proofs, to avoid the concurrent submissions in a HA setup.
+	if err := p.leaderElector.wait(p.ctx); err != nil {
+		return err
+	}
+
+	return p.waitProtocolUnpaused()
+}
+
+// onSubmitProofError handles a failed proof submission.
+func (p *Prover) onSubmitProofError(proofWithHeader *proofProducer.ProofWithHeader, err error) {
+	log.Error(
+		"Submit proof error",
+		"blockID", proofWithHeader.BlockID,
+		"minTier", proofWithHeader.Meta.MinTier,
+		"error", err,
+	)
+	if rpc.IsProtocolPausedError(err) {
+		log.Warn("TaikoL1 paused, the proof submission will be retried", "blockID", proofWithHeader.BlockID)
+	}
+	if errors.Is(err, proofSubmitter.ErrProofTooOld) {
+		p.rerequestProof(proofWithHeader)
+	}
+}
+
+// waitProtocolUnpaused blocks until TaikoL1 is unpaused, if the paused state polling is enabled.
+func (p *Prover) waitProtocolUnpaused() error {
+	if p.cfg.ProtocolPausedPollInterval == 0 {
+		return nil
+	}
+
+	return rpc.WaitTillProtocolUnpaused(
+		p.ctx,
+		p.rpc.IsProtocolPaused,
+		p.cfg.ProtocolPausedPollInterval,
+		metrics.ProverProtocolPausedGauge,
+	)
+}
+
+// rerequestProof requests a new proof for the block of the given discarded proof.
+func (p *Prover) rerequestProof(proofWithHeader *proofProducer.ProofWithHeader) {
+	log.Info("Request a new proof for the discarded one", "blockID", proofWithHeader.BlockID)
+
+	req := &proofProducer.ProofRequestBody{
+		Tier: proofWithHeader.Tier,
+		Event: &bindings.TaikoL1ClientBlockProposed{
+			BlockId: proofWithHeader.BlockID,
+			Meta:    *proofWithHeader.Meta,
+			Raw: types.Log{
+				TxHash:    proofWithHeader.Opts.ProposeBlockTxHash,
+				BlockHash: proofWithHeader.Opts.EventL1Hash,
+			},
+		},
+	}
+
+	select {
+	case <-p.ctx.Done():
+	case p.proofSubmissionCh <- req:
+	}
+}
+
 // Name returns the application name.
 func (p *Prover) Name() string {
 	return "prover"
 }
 
+// HealthCheck checks the health of the RPC connections, it implements the utils.HealthChecker interface.
+func (p *Prover) HealthCheck(ctx context.Context) *rpc.HealthCheckResult {
+	return p.rpc.HealthCheck(ctx)
+}
+
 // selectSubmitter returns the proof submitter with the given minTier.
 func (p *Prover) selectSubmitter(minTier uint16) proofSubmitter.Submitter {
 	for _, s := range p.proofSubmitters {
@@ -449,12 +846,22 @@ func (p *Prover) ProverAddress() common.Address {
 
 // withRetry retries the given function with prover backoff policy.
 func (p *Prover) withRetry(f func() error) {
+	p.withRetryDone(f, nil)
+}
+
+// withRetryDone retries the given function with prover backoff policy, and calls the given done function,
+// if not nil, once all retries finish.
+func (p *Prover) withRetryDone(f func() error, done func()) {
 	p.wg.Add(1)
 	go func() {
 		defer p.wg.Done()
+		if done != nil {
+			defer done()
+		}
 		err := backoff.Retry(f, p.backoff)
 		if err != nil {
 			log.Error("Operation failed", "error", err)
+			errlog.Record(errlog.ComponentProver, "Operation failed", err)
 		}
 	}()
 }
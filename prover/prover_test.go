@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/taikoxyz/taiko-client/bindings"
@@ -43,7 +44,7 @@ func (s *ProverTestSuite) SetupTest() {
 	s.Nil(err)
 
 	ctx, cancel := context.WithCancel(context.Background())
-	proverServerURL := s.initProver(ctx, l1ProverPrivKey)
+	proverServerURL := s.initProver(ctx, l1ProverPrivKey, nil)
 	s.cancel = cancel
 
 	// Init driver
@@ -244,10 +245,14 @@ func (s *ProverTestSuite) TestContestWrongBlocks() {
 		close(contestedSink)
 	}()
 
-	contesterKey, err := crypto.ToECDSA(common.FromHex(os.Getenv("L1_CONTRACT_OWNER_PRIVATE_KEY")))
+	// Contest through a dedicated contest account, which is not the prover's account.
+	ownerKey, err := crypto.ToECDSA(common.FromHex(os.Getenv("L1_CONTRACT_OWNER_PRIVATE_KEY")))
+	s.Nil(err)
+	contesterKey, err := crypto.ToECDSA(common.FromHex(os.Getenv("L1_PROVER_PRIVATE_KEY")))
 	s.Nil(err)
 	s.NotNil(s.initProver(
 		context.Background(),
+		ownerKey,
 		contesterKey,
 	))
 	s.p.cfg.ContesterMode = true
@@ -256,10 +261,14 @@ func (s *ProverTestSuite) TestContestWrongBlocks() {
 	s.Greater(header.Number.Uint64(), uint64(0))
 	s.Nil(s.p.transitionProvedHandler.Handle(context.Background(), event))
 	contestReq := <-s.p.proofContestCh
-	s.Nil(s.p.contestProofOp(contestReq))
+	failed, err := s.p.contestProofOp([]*producer.ContestRequestBody{contestReq})
+	s.Nil(err)
+	s.Empty(failed)
 
 	contestedEvent := <-contestedSink
 	s.Equal(header.Number.Uint64(), contestedEvent.BlockId.Uint64())
+	s.Equal(crypto.PubkeyToAddress(contesterKey.PublicKey), contestedEvent.Contester)
+	s.NotEqual(s.p.ProverAddress(), contestedEvent.Contester)
 	s.Equal(header.Hash(), common.BytesToHash(contestedEvent.Tran.BlockHash[:]))
 	s.Equal(header.ParentHash, common.BytesToHash(contestedEvent.Tran.ParentHash[:]))
 
@@ -356,6 +365,28 @@ func (s *ProverTestSuite) TestProveOp() {
 	s.Equal(header.ParentHash, common.BytesToHash(event.Tran.ParentHash[:]))
 }
 
+func (s *ProverTestSuite) TestProvingTimeline() {
+	e := s.ProposeAndInsertValidBlock(s.proposer, s.d.ChainSyncer().CalldataSyncer())
+
+	s.Nil(s.p.proveOp())
+	req := <-s.p.proofSubmissionCh
+	s.Nil(s.p.requestProofOp(req.Event, req.Tier))
+	s.p.submitProofWithRetry(<-s.p.proofGenerationCh)
+	s.p.wg.Wait()
+
+	var stages []TimelineStage
+	for _, event := range s.p.ProvingTimeline(e.BlockId.Uint64()) {
+		stages = append(stages, event.Stage)
+	}
+	s.Equal([]TimelineStage{
+		StageProposedSeen,
+		StageProofRequested,
+		StageProofProduced,
+		StageSubmitSent,
+		StageSubmitConfirmed,
+	}, stages)
+}
+
 func (s *ProverTestSuite) TestGetBlockProofStatus() {
 	parent, err := s.p.rpc.L2.HeaderByNumber(context.Background(), nil)
 	s.Nil(err)
@@ -438,6 +469,38 @@ func (s *ProverTestSuite) TearDownTest() {
 	}
 }
 
+func TestPendingContestRequests(t *testing.T) {
+	p := &Prover{proofContestCh: make(chan *producer.ContestRequestBody, 3)}
+
+	// No other queued request.
+	first := &producer.ContestRequestBody{BlockID: common.Big1}
+	require.Equal(t, []*producer.ContestRequestBody{first}, p.pendingContestRequests(first))
+
+	// All queued requests are contested together.
+	second := &producer.ContestRequestBody{BlockID: common.Big2}
+	third := &producer.ContestRequestBody{BlockID: common.Big3}
+	p.proofContestCh <- second
+	p.proofContestCh <- third
+	require.Equal(t, []*producer.ContestRequestBody{first, second, third}, p.pendingContestRequests(first))
+	require.Empty(t, p.proofContestCh)
+}
+
+func TestPendingProofs(t *testing.T) {
+	p := &Prover{proofGenerationCh: make(chan *producer.ProofWithHeader, 3)}
+
+	// No other produced proof.
+	first := &producer.ProofWithHeader{BlockID: common.Big1}
+	require.Equal(t, []*producer.ProofWithHeader{first}, p.pendingProofs(first))
+
+	// All produced proofs are submitted together.
+	second := &producer.ProofWithHeader{BlockID: common.Big2}
+	third := &producer.ProofWithHeader{BlockID: common.Big3}
+	p.proofGenerationCh <- second
+	p.proofGenerationCh <- third
+	require.Equal(t, []*producer.ProofWithHeader{first, second, third}, p.pendingProofs(first))
+	require.Empty(t, p.proofGenerationCh)
+}
+
 func TestProverTestSuite(t *testing.T) {
 	suite.Run(t, new(ProverTestSuite))
 }
@@ -445,6 +508,7 @@ func TestProverTestSuite(t *testing.T) {
 func (s *ProverTestSuite) initProver(
 	ctx context.Context,
 	key *ecdsa.PrivateKey,
+	contesterKey *ecdsa.PrivateKey,
 ) *url.URL {
 	proverServerURL := testutils.LocalRandomProverEndpoint()
 	port, err := strconv.Atoi(proverServerURL.Port())
@@ -464,6 +528,7 @@ func (s *ProverTestSuite) initProver(
 		TaikoTokenAddress:     common.HexToAddress(os.Getenv("TAIKO_TOKEN_ADDRESS")),
 		AssignmentHookAddress: common.HexToAddress(os.Getenv("ASSIGNMENT_HOOK_ADDRESS")),
 		L1ProverPrivKey:       key,
+		L1ContesterPrivKey:    contesterKey,
 		Dummy:                 true,
 		ProveUnassignedBlocks: true,
 		Capacity:              1024,
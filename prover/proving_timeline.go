@@ -0,0 +1,104 @@
+package prover
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/taikoxyz/taiko-client/bindings"
+	eventIterator "github.com/taikoxyz/taiko-client/pkg/chain_iterator/event_iterator"
+)
+
+// maxProvingTimelines is the maximum number of recent blocks whose proving timelines are kept.
+const maxProvingTimelines = 1024
+
+// TimelineStage is a stage in a block's proving lifecycle.
+type TimelineStage string
+
+// Proving timeline stages.
+const (
+	StageProposedSeen    TimelineStage = "proposedSeen"
+	StageProofRequested  TimelineStage = "proofRequested"
+	StageProofProduced   TimelineStage = "proofProduced"
+	StageSubmitSent      TimelineStage = "submitSent"
+	StageSubmitConfirmed TimelineStage = "submitConfirmed"
+)
+
+// TimelineEvent is a timestamped stage in a block's proving timeline.
+type TimelineEvent struct {
+	Stage TimelineStage `json:"stage"`
+	Time  time.Time     `json:"time"`
+}
+
+// provingTimelines keeps the proving timelines of the recent blocks in a bounded ring buffer,
+// the oldest block's timeline is evicted when the buffer is full.
+type provingTimelines struct {
+	mu        sync.Mutex
+	timelines map[uint64][]TimelineEvent
+	ring      []uint64
+	next      int
+	nowFn     func() time.Time
+}
+
+// newProvingTimelines creates a new provingTimelines instance with the given capacity.
+func newProvingTimelines(capacity int) *provingTimelines {
+	return &provingTimelines{
+		timelines: make(map[uint64][]TimelineEvent),
+		ring:      make([]uint64, 0, capacity),
+		nowFn:     time.Now,
+	}
+}
+
+// record appends the given stage to the given block's timeline.
+func (t *provingTimelines) record(blockID *big.Int, stage TimelineStage) {
+	if t == nil || blockID == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	id := blockID.Uint64()
+	if _, ok := t.timelines[id]; !ok {
+		if len(t.ring) < cap(t.ring) {
+			t.ring = append(t.ring, id)
+		} else {
+			delete(t.timelines, t.ring[t.next])
+			t.ring[t.next] = id
+			t.next = (t.next + 1) % len(t.ring)
+		}
+	}
+
+	t.timelines[id] = append(t.timelines[id], TimelineEvent{Stage: stage, Time: t.nowFn()})
+}
+
+// get returns a copy of the given block's timeline, or nil if it is not recorded.
+func (t *provingTimelines) get(blockID uint64) []TimelineEvent {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	timeline, ok := t.timelines[blockID]
+	if !ok {
+		return nil
+	}
+
+	return append([]TimelineEvent(nil), timeline...)
+}
+
+// ProvingTimeline returns the timestamped proving stages of the given block, or nil if the block
+// is not one of the recently seen blocks.
+func (p *Prover) ProvingTimeline(blockID uint64) []TimelineEvent {
+	return p.provingTimelines.get(blockID)
+}
+
+// onBlockProposed records the newly seen BlockProposed event in the block's proving timeline,
+// and then passes it to the BlockProposed event handler.
+func (p *Prover) onBlockProposed(
+	ctx context.Context,
+	e *bindings.TaikoL1ClientBlockProposed,
+	end eventIterator.EndBlockProposedEventIterFunc,
+) error {
+	p.provingTimelines.record(e.BlockId, StageProposedSeen)
+	return p.blockProposedHandler.Handle(ctx, e, end)
+}
@@ -0,0 +1,45 @@
+package prover
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvingTimelines(t *testing.T) {
+	var (
+		timelines = newProvingTimelines(2)
+		now       = time.Unix(0, 0)
+	)
+	timelines.nowFn = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	timelines.record(big.NewInt(1), StageProposedSeen)
+	timelines.record(big.NewInt(1), StageProofRequested)
+	timelines.record(big.NewInt(2), StageProposedSeen)
+
+	timeline := timelines.get(1)
+	require.Equal(t, []TimelineEvent{
+		{Stage: StageProposedSeen, Time: time.Unix(1, 0)},
+		{Stage: StageProofRequested, Time: time.Unix(2, 0)},
+	}, timeline)
+
+	// The returned timeline is a copy.
+	timeline[0].Stage = StageSubmitConfirmed
+	require.Equal(t, StageProposedSeen, timelines.get(1)[0].Stage)
+
+	// The oldest block's timeline is evicted when the buffer is full.
+	timelines.record(big.NewInt(3), StageProposedSeen)
+	require.Nil(t, timelines.get(1))
+	require.Len(t, timelines.get(2), 1)
+	require.Len(t, timelines.get(3), 1)
+
+	timelines.record(big.NewInt(4), StageProposedSeen)
+	require.Nil(t, timelines.get(2))
+	require.Len(t, timelines.get(3), 1)
+	require.Len(t, timelines.get(4), 1)
+}
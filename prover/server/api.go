@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"net/http"
 	"time"
@@ -13,11 +14,14 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/internal/metrics"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
 )
 
 const (
 	rpcTimeout = 1 * time.Minute
+	// maxAuditBlocks is the maximum number of the L2 blocks audited in a single request.
+	maxAuditBlocks = 1024
 )
 
 // @title Taiko Prover Server API
@@ -57,13 +61,106 @@ type Status struct {
 //	@Success		200	{object} Status
 //	@Router			/status [get]
 func (s *ProverServer) GetStatus(c echo.Context) error {
-	return c.JSON(http.StatusOK, &Status{
+	return c.JSON(http.StatusOK, s.status())
+}
+
+// status returns the current prover server status.
+func (s *ProverServer) status() *Status {
+	return &Status{
 		MinOptimisticTierFee: s.minOptimisticTierFee.Uint64(),
 		MinSgxTierFee:        s.minSgxTierFee.Uint64(),
 		MinSgxAndZkVMTierFee: s.minSgxAndZkVMTierFee.Uint64(),
 		MaxExpiry:            uint64(s.maxExpiry.Seconds()),
 		Prover:               s.proverAddress.Hex(),
-	})
+	}
+}
+
+// GetConfig handles a query to the prover's effective runtime configuration, all secrets
+// in the configuration are redacted.
+//
+//	@Summary		Get the prover's effective configuration
+//	@ID			   	get-config
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object} map[string]interface{}
+//	@Router			/config [get]
+func (s *ProverServer) GetConfig(c echo.Context) error {
+	cfg, err := s.configProvider()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return c.JSON(http.StatusOK, cfg)
+}
+
+// SetGraffitiRequestBody represents a request body when updating the prover's graffiti.
+type SetGraffitiRequestBody struct {
+	Graffiti string `json:"graffiti"`
+}
+
+// SetGraffiti handles a request to update the graffiti attached to the prover's submitted transitions,
+// which takes effect without restarting the prover.
+//
+//	@Summary		Update the prover's graffiti
+//	@ID			   	set-graffiti
+//	@Param          body        body    SetGraffitiRequestBody   true    "graffiti request body"
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object} SetGraffitiRequestBody
+//	@Failure		400	{string} string "graffiti too long"
+//	@Router			/graffiti [put]
+func (s *ProverServer) SetGraffiti(c echo.Context) error {
+	req := new(SetGraffitiRequestBody)
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	if err := s.graffitiSetter(req.Graffiti); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	log.Info("Prover graffiti updated", "graffiti", req.Graffiti)
+
+	return c.JSON(http.StatusOK, req)
+}
+
+// AuditRequestQuery represents the query parameters when auditing a range of L2 blocks.
+type AuditRequestQuery struct {
+	From uint64 `query:"from"`
+	To   uint64 `query:"to"`
+}
+
+// GetAudit handles a request to audit the on-chain transitions of the given L2 blocks (both inclusive)
+// against the blocks of the local L2 execution engine.
+//
+//	@Summary		Audit the on-chain transitions of a range of L2 blocks
+//	@ID			   	get-audit
+//	@Param          from        query    int   true    "first L2 block ID"
+//	@Param          to          query    int   true    "last L2 block ID"
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object} []audit.Result
+//	@Failure		400	{string} string "invalid audit range"
+//	@Router			/audit [get]
+func (s *ProverServer) GetAudit(c echo.Context) error {
+	req := new(AuditRequestQuery)
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	if req.From > req.To || req.To-req.From >= maxAuditBlocks {
+		return echo.NewHTTPError(
+			http.StatusBadRequest,
+			fmt.Sprintf("invalid audit range [%d, %d], at most %d blocks", req.From, req.To, maxAuditBlocks),
+		)
+	}
+
+	results, err := s.blockAuditor(c.Request().Context(), req.From, req.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return c.JSON(http.StatusOK, results)
 }
 
 // ProposeBlockResponse represents the JSON response which will be returned by
@@ -90,8 +187,15 @@ type ProposeBlockResponse struct {
 //	@Failure		422		{string} string	"proof fee too low"
 //	@Failure		422		{string} string "expiry too long"
 //	@Failure		422		{string} string "prover does not have capacity"
+//	@Failure		503		{string} string "prover is warming up"
 //	@Router			/assignment [post]
 func (s *ProverServer) CreateAssignment(c echo.Context) error {
+	// The prover can't produce correct proofs before catching up after starting.
+	if !s.WarmedUp() {
+		log.Info("Prover is warming up, refuse the proof assignment", "proposerIP", c.RealIP())
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "prover is warming up")
+	}
+
 	req := new(CreateAssignmentRequestBody)
 	if err := c.Bind(req); err != nil {
 		return c.JSON(http.StatusUnprocessableEntity, err)
@@ -218,6 +322,10 @@ func (s *ProverServer) CreateAssignment(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
 
+	metrics.ProverMarketAssignmentsAcceptedCounter().Inc(1)
+	// Record the accepted tier fees for the prover market analysis.
+	s.feeHistory.record(req.TierFees)
+
 	// 8. Return the signed payload.
 	return c.JSON(http.StatusOK, &ProposeBlockResponse{
 		SignedPayload: signed,
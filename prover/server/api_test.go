@@ -1,15 +1,24 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
+	"math/big"
 	"net/http"
+	"net/http/httptest"
 	"strings"
+	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
 
 	"github.com/taikoxyz/taiko-client/bindings/encoding"
+	"github.com/taikoxyz/taiko-client/prover/audit"
 )
 
 func (s *ProverServerTestSuite) TestGetStatusSuccess() {
@@ -29,6 +38,33 @@ func (s *ProverServerTestSuite) TestGetStatusSuccess() {
 	s.NotEmpty(status.Prover)
 }
 
+func (s *ProverServerTestSuite) TestGetConfig() {
+	// Missing or invalid API token.
+	res := s.sendReq("/config")
+	defer res.Body.Close()
+	s.NotEqual(http.StatusOK, res.StatusCode)
+
+	req, err := http.NewRequest(http.MethodGet, s.testServer.URL+"/config", nil)
+	s.Nil(err)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer invalid")
+	res, err = http.DefaultClient.Do(req)
+	s.Nil(err)
+	defer res.Body.Close()
+	s.Equal(http.StatusUnauthorized, res.StatusCode)
+
+	// Valid API token.
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+testConfigAPIToken)
+	res, err = http.DefaultClient.Do(req)
+	s.Nil(err)
+	defer res.Body.Close()
+	s.Equal(http.StatusOK, res.StatusCode)
+
+	cfg := map[string]interface{}{}
+	s.Nil(json.NewDecoder(res.Body).Decode(&cfg))
+	s.Equal("<redacted>", cfg["L1ProverPrivKey"])
+	s.Equal(float64(1024), cfg["Capacity"])
+}
+
 func (s *ProverServerTestSuite) TestProposeBlockSuccess() {
 	data, err := json.Marshal(CreateAssignmentRequestBody{
 		FeeToken: (common.Address{}),
@@ -48,3 +84,100 @@ func (s *ProverServerTestSuite) TestProposeBlockSuccess() {
 	s.Nil(err)
 	s.Contains(string(b), "signedPayload")
 }
+
+func TestSetGraffiti(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	var graffiti string
+	srv, err := New(&NewProverServerOpts{
+		ProverPrivateKey:     key,
+		MinOptimisticTierFee: big.NewInt(1),
+		MinSgxTierFee:        big.NewInt(2),
+		MinSgxAndZkVMTierFee: big.NewInt(3),
+		MaxExpiry:            time.Hour,
+		ConfigAPIToken:       testConfigAPIToken,
+		GraffitiSetter: func(g string) error {
+			if len(g) > 32 {
+				return errors.New("graffiti too long")
+			}
+			graffiti = g
+			return nil
+		},
+	})
+	require.Nil(t, err)
+
+	setGraffiti := func(body string, token string) int {
+		req := httptest.NewRequest(http.MethodPut, "/graffiti", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		rec := httptest.NewRecorder()
+		srv.echo.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// Invalid API token.
+	require.Equal(t, http.StatusUnauthorized, setGraffiti(`{"graffiti":"campaign"}`, "invalid"))
+	require.Empty(t, graffiti)
+
+	// Valid API token.
+	require.Equal(t, http.StatusOK, setGraffiti(`{"graffiti":"campaign"}`, testConfigAPIToken))
+	require.Equal(t, "campaign", graffiti)
+
+	// Graffiti too long.
+	require.Equal(
+		t,
+		http.StatusBadRequest,
+		setGraffiti(`{"graffiti":"`+strings.Repeat("a", 33)+`"}`, testConfigAPIToken),
+	)
+	require.Equal(t, "campaign", graffiti)
+}
+
+func TestGetAudit(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	srv, err := New(&NewProverServerOpts{
+		ProverPrivateKey:     key,
+		MinOptimisticTierFee: big.NewInt(1),
+		MinSgxTierFee:        big.NewInt(2),
+		MinSgxAndZkVMTierFee: big.NewInt(3),
+		MaxExpiry:            time.Hour,
+		ConfigAPIToken:       testConfigAPIToken,
+		BlockAuditor: func(_ context.Context, from uint64, to uint64) ([]*audit.Result, error) {
+			var results []*audit.Result
+			for id := from; id <= to; id++ {
+				results = append(results, &audit.Result{BlockID: id, Match: id%2 == 0})
+			}
+			return results, nil
+		},
+	})
+	require.Nil(t, err)
+
+	getAudit := func(query string, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/audit?"+query, nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		rec := httptest.NewRecorder()
+		srv.echo.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Invalid API token.
+	require.Equal(t, http.StatusUnauthorized, getAudit("from=1&to=2", "invalid").Code)
+
+	// Invalid ranges.
+	require.Equal(t, http.StatusBadRequest, getAudit("from=2&to=1", testConfigAPIToken).Code)
+	require.Equal(t, http.StatusBadRequest, getAudit("from=1&to=1025", testConfigAPIToken).Code)
+
+	// The results are returned in block ID order.
+	rec := getAudit("from=1&to=3", testConfigAPIToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var results []*audit.Result
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &results))
+	require.Len(t, results, 3)
+	for i, result := range results {
+		require.Equal(t, uint64(i+1), result.BlockID)
+		require.Equal(t, result.BlockID%2 == 0, result.Match)
+	}
+}
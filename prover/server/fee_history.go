@@ -0,0 +1,232 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+const (
+	// maxFeeObservationsPerTier is the maximum number of fee observations kept for each tier.
+	maxFeeObservationsPerTier = 4096
+	// feeHistoryRetention is how long a fee observation is kept.
+	feeHistoryRetention = 7 * 24 * time.Hour
+	// maxTierFeeBits is the bit length of the largest tier fee, which is an uint128 in the prover assignment.
+	maxTierFeeBits = 128
+)
+
+// feeHistoryTiers are the tiers whose fees are recorded.
+var feeHistoryTiers = []uint16{
+	encoding.TierOptimisticID,
+	encoding.TierSgxID,
+	encoding.TierSgxAndZkVMID,
+	encoding.TierGuardianID,
+}
+
+// FeeObservation is a tier fee observed in a proof assignment request.
+type FeeObservation struct {
+	Tier uint16    `json:"tier"`
+	Fee  *big.Int  `json:"fee"`
+	Time time.Time `json:"time"`
+}
+
+// feeHistory is a bounded in-memory time series of the observed tier fees, the oldest observations
+// are dropped once a tier's history is full or the retention period has passed. Only the fees of the
+// given tiers are recorded, so the memory usage is bounded no matter what tiers are observed.
+type feeHistory struct {
+	mu        sync.RWMutex
+	maxSize   int
+	retention time.Duration
+	tiers     map[uint16][]*FeeObservation
+	nowFn     func() time.Time
+}
+
+// newFeeHistory creates a new feeHistory instance, which records the fees of the given tiers.
+func newFeeHistory(maxSize int, retention time.Duration, tiers ...uint16) *feeHistory {
+	h := &feeHistory{
+		maxSize:   maxSize,
+		retention: retention,
+		tiers:     make(map[uint16][]*FeeObservation, len(tiers)),
+		nowFn:     time.Now,
+	}
+	for _, tier := range tiers {
+		h.tiers[tier] = nil
+	}
+
+	return h
+}
+
+// record records the given tier fees observed at the current time, the fees of the unknown tiers, and the
+// fees which can't be an uint128, are ignored.
+func (h *feeHistory) record(tierFees []encoding.TierFee) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.nowFn()
+	for _, tierFee := range tierFees {
+		if tierFee.Fee == nil || tierFee.Fee.Sign() < 0 || tierFee.Fee.BitLen() > maxTierFeeBits {
+			continue
+		}
+		if _, ok := h.tiers[tierFee.Tier]; !ok {
+			continue
+		}
+
+		observations := append(h.tiers[tierFee.Tier], &FeeObservation{
+			Tier: tierFee.Tier,
+			Fee:  new(big.Int).Set(tierFee.Fee),
+			Time: now,
+		})
+		h.tiers[tierFee.Tier] = h.prune(observations, now)
+	}
+}
+
+// prune drops the observations exceeding the size limit or the retention period.
+func (h *feeHistory) prune(observations []*FeeObservation, now time.Time) []*FeeObservation {
+	start := 0
+	if len(observations) > h.maxSize {
+		start = len(observations) - h.maxSize
+	}
+	for start < len(observations) && now.Sub(observations[start].Time) > h.retention {
+		start++
+	}
+	if start == 0 {
+		return observations
+	}
+
+	// Copy the remaining observations, so the dropped ones can be garbage collected.
+	return append([]*FeeObservation(nil), observations[start:]...)
+}
+
+// query returns the fee observations of the given tier within the given time range (both inclusive),
+// in chronological order.
+func (h *feeHistory) query(tier uint16, from time.Time, to time.Time) []*FeeObservation {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	var (
+		now     = h.nowFn()
+		results []*FeeObservation
+	)
+	for _, observation := range h.tiers[tier] {
+		if now.Sub(observation.Time) > h.retention ||
+			observation.Time.Before(from) ||
+			observation.Time.After(to) {
+			continue
+		}
+		results = append(results, &FeeObservation{
+			Tier: observation.Tier,
+			Fee:  new(big.Int).Set(observation.Fee),
+			Time: observation.Time,
+		})
+	}
+
+	return results
+}
+
+// save atomically writes all fee observations to the given file, the file is only replaced once all
+// observations are fully written.
+func (h *feeHistory) save(path string) error {
+	h.mu.RLock()
+	var observations []*FeeObservation
+	for _, tierObservations := range h.tiers {
+		observations = append(observations, tierObservations...)
+	}
+	b, err := json.Marshal(observations)
+	h.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create the fee history file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write the fee history file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write the fee history file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write the fee history file: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// load recovers the fee observations from the given file, with the same limits as the recorded ones,
+// nothing is recovered if the file doesn't exist.
+func (h *feeHistory) load(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to read the fee history file: %w", err)
+	}
+
+	var observations []*FeeObservation
+	if err := json.Unmarshal(b, &observations); err != nil {
+		return fmt.Errorf("failed to decode the fee history file: %w", err)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var (
+		now       = h.nowFn()
+		recovered = make(map[uint16][]*FeeObservation)
+	)
+	for _, observation := range observations {
+		if observation == nil ||
+			observation.Fee == nil ||
+			observation.Fee.Sign() < 0 ||
+			observation.Fee.BitLen() > maxTierFeeBits {
+			continue
+		}
+		if _, ok := h.tiers[observation.Tier]; !ok {
+			continue
+		}
+		recovered[observation.Tier] = append(recovered[observation.Tier], observation)
+	}
+	for tier, tierObservations := range recovered {
+		sort.SliceStable(tierObservations, func(i, j int) bool {
+			return tierObservations[i].Time.Before(tierObservations[j].Time)
+		})
+		h.tiers[tier] = h.prune(append(tierObservations, h.tiers[tier]...), now)
+	}
+
+	log.Info("Recovered the fee history", "path", path, "observations", len(observations))
+
+	return nil
+}
+
+// SaveFeeHistory writes the observed tier fees to the given file.
+func (s *ProverServer) SaveFeeHistory(path string) error {
+	return s.feeHistory.save(path)
+}
+
+// LoadFeeHistory recovers the observed tier fees from the given file, written by SaveFeeHistory.
+func (s *ProverServer) LoadFeeHistory(path string) error {
+	return s.feeHistory.load(path)
+}
+
+// FeeHistory returns the tier fees of the given tier observed in the accepted proof assignment requests
+// within the given time range (both inclusive), in chronological order.
+func (s *ProverServer) FeeHistory(tier uint16, from time.Time, to time.Time) []*FeeObservation {
+	return s.feeHistory.query(tier, from, to)
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-client/bindings/encoding"
+)
+
+func TestFeeHistory(t *testing.T) {
+	var (
+		start   = time.Now()
+		now     = start
+		history = newFeeHistory(3, time.Hour, encoding.TierOptimisticID, encoding.TierSgxID, encoding.TierGuardianID)
+	)
+	history.nowFn = func() time.Time { return now }
+
+	for i := int64(0); i < 4; i++ {
+		history.record([]encoding.TierFee{
+			{Tier: encoding.TierOptimisticID, Fee: big.NewInt(100 + i)},
+			{Tier: encoding.TierSgxID, Fee: big.NewInt(200 + i)},
+		})
+		now = now.Add(10 * time.Minute)
+	}
+
+	// The oldest observation is dropped once the history is full.
+	observations := history.query(encoding.TierOptimisticID, start, now)
+	require.Len(t, observations, 3)
+	for i, observation := range observations {
+		require.Equal(t, encoding.TierOptimisticID, observation.Tier)
+		require.Equal(t, int64(101+i), observation.Fee.Int64())
+		require.Equal(t, start.Add(time.Duration(i+1)*10*time.Minute), observation.Time)
+	}
+
+	// Query by tier and time range.
+	observations = history.query(encoding.TierSgxID, start.Add(15*time.Minute), start.Add(25*time.Minute))
+	require.Len(t, observations, 1)
+	require.Equal(t, int64(202), observations[0].Fee.Int64())
+	require.Empty(t, history.query(encoding.TierGuardianID, start, now))
+
+	// The observations out of the retention period are dropped.
+	now = start.Add(time.Hour + 15*time.Minute)
+	observations = history.query(encoding.TierSgxID, start, now)
+	require.Len(t, observations, 2)
+	require.Equal(t, int64(202), observations[0].Fee.Int64())
+
+	history.record([]encoding.TierFee{{Tier: encoding.TierSgxID, Fee: big.NewInt(300)}})
+	require.Len(t, history.tiers[encoding.TierSgxID], 3)
+
+	now = start.Add(3 * time.Hour)
+	history.record([]encoding.TierFee{{Tier: encoding.TierSgxID, Fee: big.NewInt(400)}})
+	require.Len(t, history.tiers[encoding.TierSgxID], 1)
+}
+
+func TestFeeHistoryBounded(t *testing.T) {
+	history := newFeeHistory(3, time.Hour, encoding.TierSgxID)
+
+	history.record([]encoding.TierFee{
+		{Tier: encoding.TierSgxID, Fee: big.NewInt(1)},
+		{Tier: 12345, Fee: big.NewInt(1)},
+		{Tier: encoding.TierSgxID, Fee: big.NewInt(-1)},
+		{Tier: encoding.TierSgxID, Fee: new(big.Int).Lsh(common.Big1, maxTierFeeBits)},
+	})
+
+	require.Len(t, history.tiers, 1)
+	require.Len(t, history.tiers[encoding.TierSgxID], 1)
+	require.Equal(t, int64(1), history.tiers[encoding.TierSgxID][0].Fee.Int64())
+}
+
+func TestFeeHistorySaveLoad(t *testing.T) {
+	var (
+		path    = filepath.Join(t.TempDir(), "fee_history.json")
+		start   = time.Now()
+		now     = start
+		history = newFeeHistory(3, time.Hour, encoding.TierOptimisticID, encoding.TierSgxID)
+	)
+	history.nowFn = func() time.Time { return now }
+
+	// Nothing is recovered from a missing file.
+	require.Nil(t, history.load(path))
+	require.Empty(t, history.query(encoding.TierSgxID, start, now))
+
+	for i := int64(0); i < 2; i++ {
+		history.record([]encoding.TierFee{
+			{Tier: encoding.TierOptimisticID, Fee: big.NewInt(100 + i)},
+			{Tier: encoding.TierSgxID, Fee: big.NewInt(200 + i)},
+		})
+		now = now.Add(40 * time.Minute)
+	}
+	require.Nil(t, history.save(path))
+
+	// The recovered history only keeps the tiers it records and the observations within the retention period.
+	recovered := newFeeHistory(3, time.Hour, encoding.TierSgxID)
+	recovered.nowFn = func() time.Time { return now }
+	require.Nil(t, recovered.load(path))
+	require.Empty(t, recovered.query(encoding.TierOptimisticID, start, now))
+
+	observations := recovered.query(encoding.TierSgxID, start, now)
+	require.Len(t, observations, 1)
+	require.Equal(t, int64(201), observations[0].Fee.Int64())
+	require.True(t, start.Add(40*time.Minute).Equal(observations[0].Time))
+	require.Len(t, recovered.tiers[encoding.TierSgxID], 1)
+}
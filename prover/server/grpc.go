@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/taikoxyz/taiko-client/prover/server/statuspb"
+)
+
+// statusService implements the ProverStatus gRPC service, which mirrors the HTTP status endpoints.
+type statusService struct {
+	statuspb.UnimplementedProverStatusServer
+	srv *ProverServer
+}
+
+// GetStatus implements the ProverStatusServer interface.
+func (s *statusService) GetStatus(_ context.Context, _ *statuspb.GetStatusRequest) (*statuspb.Status, error) {
+	status := s.srv.status()
+
+	return &statuspb.Status{
+		MinOptimisticTierFee: status.MinOptimisticTierFee,
+		MinSgxTierFee:        status.MinSgxTierFee,
+		MinSgxAndZkVmTierFee: status.MinSgxAndZkVMTierFee,
+		MaxExpiry:            status.MaxExpiry,
+		Prover:               status.Prover,
+	}, nil
+}
+
+// newGRPCServer creates a new gRPC server, which serves the ProverStatus service and the standard
+// gRPC health checking service.
+func (s *ProverServer) newGRPCServer() *grpc.Server {
+	srv := grpc.NewServer()
+
+	statuspb.RegisterProverStatusServer(srv, &statusService{srv: s})
+	healthpb.RegisterHealthServer(srv, s.grpcHealth)
+
+	return srv
+}
+
+// StartGRPC starts the gRPC server.
+func (s *ProverServer) StartGRPC(address string) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	return s.grpc.Serve(listener)
+}
+
+// ShutdownGRPC shuts down the gRPC server gracefully.
+func (s *ProverServer) ShutdownGRPC() {
+	s.grpcHealth.Shutdown()
+	s.grpc.GracefulStop()
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/taikoxyz/taiko-client/prover/server/statuspb"
+)
+
+func TestGRPCServer(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	srv, err := New(&NewProverServerOpts{
+		ProverPrivateKey:     key,
+		MinOptimisticTierFee: big.NewInt(1),
+		MinSgxTierFee:        big.NewInt(2),
+		MinSgxAndZkVMTierFee: big.NewInt(3),
+		MaxExpiry:            time.Hour,
+	})
+	require.Nil(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() { require.Nil(t, srv.grpc.Serve(listener)) }()
+	defer srv.ShutdownGRPC()
+
+	conn, err := grpc.DialContext(
+		context.Background(),
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.Nil(t, err)
+	defer conn.Close()
+
+	// Health, same as `/healthz`.
+	health, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.Nil(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, health.Status)
+
+	// Status, same as `/status`.
+	status, err := statuspb.NewProverStatusClient(conn).GetStatus(context.Background(), &statuspb.GetStatusRequest{})
+	require.Nil(t, err)
+
+	expected := srv.status()
+	require.Equal(t, expected.MinOptimisticTierFee, status.MinOptimisticTierFee)
+	require.Equal(t, expected.MinSgxTierFee, status.MinSgxTierFee)
+	require.Equal(t, expected.MinSgxAndZkVMTierFee, status.MinSgxAndZkVmTierFee)
+	require.Equal(t, uint64(time.Hour.Seconds()), status.MaxExpiry)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey).Hex(), status.Prover)
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"crypto/ecdsa"
+	"crypto/subtle"
 	"math/big"
 	"net/http"
 	"os"
@@ -12,9 +13,12 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
 
 	"github.com/taikoxyz/taiko-client/bindings"
 	"github.com/taikoxyz/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-client/prover/audit"
 	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
 )
 
@@ -48,8 +52,28 @@ type ProverServer struct {
 	rpc                   *rpc.Client
 	protocolConfigs       *bindings.TaikoDataConfig
 	livenessBond          *big.Int
+	configAPIToken        string
+	configProvider        ConfigProvider
+	feeHistory            *feeHistory
+	grpc                  *grpc.Server
+	grpcHealth            *health.Server
+	// Refuses the proof assignments until the prover catches up after starting
+	warmup *warmupGate
+	// Updates the graffiti attached to the submitted transitions
+	graffitiSetter GraffitiSetter
+	// Audits the on-chain transitions of the L2 blocks against the local L2 execution engine
+	blockAuditor BlockAuditor
 }
 
+// GraffitiSetter updates the graffiti attached to the prover's submitted transitions at runtime.
+type GraffitiSetter func(graffiti string) error
+
+// BlockAuditor audits the L2 blocks in the given range (both inclusive), the results are in block ID order.
+type BlockAuditor func(ctx context.Context, fromBlockID uint64, toBlockID uint64) ([]*audit.Result, error)
+
+// ConfigProvider returns the prover's effective runtime configuration, with all secrets redacted.
+type ConfigProvider func() (map[string]interface{}, error)
+
 // NewProverServerOpts contains all configurations for creating a prover server instance.
 type NewProverServerOpts struct {
 	ProverPrivateKey      *ecdsa.PrivateKey
@@ -67,6 +91,13 @@ type NewProverServerOpts struct {
 	RPC                   *rpc.Client
 	ProtocolConfigs       *bindings.TaikoDataConfig
 	LivenessBond          *big.Int
+	ConfigAPIToken        string
+	ConfigProvider        ConfigProvider
+	// Maximum L2 execution engine sync lag to finish the warmup and start accepting proof assignments,
+	// nil means no warmup
+	WarmupMaxSyncLag *uint64
+	GraffitiSetter   GraffitiSetter
+	BlockAuditor     BlockAuditor
 }
 
 // New creates a new prover server instance.
@@ -89,11 +120,19 @@ func New(opts *NewProverServerOpts) (*ProverServer, error) {
 		rpc:                   opts.RPC,
 		protocolConfigs:       opts.ProtocolConfigs,
 		livenessBond:          opts.LivenessBond,
+		configAPIToken:        opts.ConfigAPIToken,
+		configProvider:        opts.ConfigProvider,
+		feeHistory:            newFeeHistory(maxFeeObservationsPerTier, feeHistoryRetention, feeHistoryTiers...),
+		grpcHealth:            health.NewServer(),
+		warmup:                newWarmupGate(opts.WarmupMaxSyncLag),
+		graffitiSetter:        opts.GraffitiSetter,
+		blockAuditor:          opts.BlockAuditor,
 	}
 
 	srv.echo.HideBanner = true
 	srv.configureMiddleware()
 	srv.configureRoutes()
+	srv.grpc = srv.newGRPCServer()
 
 	return srv, nil
 }
@@ -143,4 +182,21 @@ func (s *ProverServer) configureRoutes() {
 	s.echo.GET("/healthz", s.Health)
 	s.echo.GET("/status", s.GetStatus)
 	s.echo.POST("/assignment", s.CreateAssignment)
+
+	// The admin endpoints are only enabled when an API token is set.
+	if s.configAPIToken == "" {
+		return
+	}
+	auth := middleware.KeyAuth(func(key string, _ echo.Context) (bool, error) {
+		return subtle.ConstantTimeCompare([]byte(key), []byte(s.configAPIToken)) == 1, nil
+	})
+	if s.configProvider != nil {
+		s.echo.GET("/config", s.GetConfig, auth)
+	}
+	if s.graffitiSetter != nil {
+		s.echo.PUT("/graffiti", s.SetGraffiti, auth)
+	}
+	if s.blockAuditor != nil {
+		s.echo.GET("/audit", s.GetAudit, auth)
+	}
 }
@@ -21,6 +21,8 @@ import (
 	proofProducer "github.com/taikoxyz/taiko-client/prover/proof_producer"
 )
 
+const testConfigAPIToken = "test-config-api-token"
+
 type ProverServerTestSuite struct {
 	suite.Suite
 	s          *ProverServer
@@ -60,6 +62,10 @@ func (s *ProverServerTestSuite) SetupTest() {
 		RPC:                   rpcClient,
 		ProtocolConfigs:       &configs,
 		LivenessBond:          common.Big0,
+		ConfigAPIToken:        testConfigAPIToken,
+		ConfigProvider: func() (map[string]interface{}, error) {
+			return map[string]interface{}{"L1ProverPrivKey": "<redacted>", "Capacity": 1024}, nil
+		},
 	})
 	s.Nil(err)
 
@@ -0,0 +1,253 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: prover/server/statuspb/status.proto
+
+package statuspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetStatusRequest is the request of ProverStatus.GetStatus.
+type GetStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetStatusRequest) Reset() {
+	*x = GetStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prover_server_statuspb_status_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusRequest) ProtoMessage() {}
+
+func (x *GetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prover_server_statuspb_status_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_prover_server_statuspb_status_proto_rawDescGZIP(), []int{0}
+}
+
+// Status represents the current prover server status.
+type Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinOptimisticTierFee uint64 `protobuf:"varint,1,opt,name=min_optimistic_tier_fee,json=minOptimisticTierFee,proto3" json:"min_optimistic_tier_fee,omitempty"`
+	MinSgxTierFee        uint64 `protobuf:"varint,2,opt,name=min_sgx_tier_fee,json=minSgxTierFee,proto3" json:"min_sgx_tier_fee,omitempty"`
+	MinSgxAndZkVmTierFee uint64 `protobuf:"varint,3,opt,name=min_sgx_and_zk_vm_tier_fee,json=minSgxAndZkVmTierFee,proto3" json:"min_sgx_and_zk_vm_tier_fee,omitempty"`
+	// Maximum expiry of a proof assignment, in seconds.
+	MaxExpiry uint64 `protobuf:"varint,4,opt,name=max_expiry,json=maxExpiry,proto3" json:"max_expiry,omitempty"`
+	Prover    string `protobuf:"bytes,5,opt,name=prover,proto3" json:"prover,omitempty"`
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_prover_server_statuspb_status_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_prover_server_statuspb_status_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_prover_server_statuspb_status_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Status) GetMinOptimisticTierFee() uint64 {
+	if x != nil {
+		return x.MinOptimisticTierFee
+	}
+	return 0
+}
+
+func (x *Status) GetMinSgxTierFee() uint64 {
+	if x != nil {
+		return x.MinSgxTierFee
+	}
+	return 0
+}
+
+func (x *Status) GetMinSgxAndZkVmTierFee() uint64 {
+	if x != nil {
+		return x.MinSgxAndZkVmTierFee
+	}
+	return 0
+}
+
+func (x *Status) GetMaxExpiry() uint64 {
+	if x != nil {
+		return x.MaxExpiry
+	}
+	return 0
+}
+
+func (x *Status) GetProver() string {
+	if x != nil {
+		return x.Prover
+	}
+	return ""
+}
+
+var File_prover_server_statuspb_status_proto protoreflect.FileDescriptor
+
+var file_prover_server_statuspb_status_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x70, 0x62, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x74, 0x61, 0x69, 0x6b, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x70, 0x74,
+	0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6d,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x54, 0x69, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x27, 0x0a, 0x10,
+	0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x67, 0x78, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x67, 0x78, 0x54, 0x69,
+	0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x38, 0x0a, 0x1a, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x67, 0x78,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x7a, 0x6b, 0x5f, 0x76, 0x6d, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x5f,
+	0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x53, 0x67,
+	0x78, 0x41, 0x6e, 0x64, 0x5a, 0x6b, 0x56, 0x6d, 0x54, 0x69, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x32, 0x57, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x47, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x74, 0x61, 0x69, 0x6b, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x61, 0x69, 0x6b, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x61,
+	0x69, 0x6b, 0x6f, 0x78, 0x79, 0x7a, 0x2f, 0x74, 0x61, 0x69, 0x6b, 0x6f, 0x2d, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_prover_server_statuspb_status_proto_rawDescOnce sync.Once
+	file_prover_server_statuspb_status_proto_rawDescData = file_prover_server_statuspb_status_proto_rawDesc
+)
+
+func file_prover_server_statuspb_status_proto_rawDescGZIP() []byte {
+	file_prover_server_statuspb_status_proto_rawDescOnce.Do(func() {
+		file_prover_server_statuspb_status_proto_rawDescData = protoimpl.X.CompressGZIP(file_prover_server_statuspb_status_proto_rawDescData)
+	})
+	return file_prover_server_statuspb_status_proto_rawDescData
+}
+
+var file_prover_server_statuspb_status_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_prover_server_statuspb_status_proto_goTypes = []interface{}{
+	(*GetStatusRequest)(nil), // 0: taiko.prover.v1.GetStatusRequest
+	(*Status)(nil),           // 1: taiko.prover.v1.Status
+}
+var file_prover_server_statuspb_status_proto_depIdxs = []int32{
+	0, // 0: taiko.prover.v1.ProverStatus.GetStatus:input_type -> taiko.prover.v1.GetStatusRequest
+	1, // 1: taiko.prover.v1.ProverStatus.GetStatus:output_type -> taiko.prover.v1.Status
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_prover_server_statuspb_status_proto_init() }
+func file_prover_server_statuspb_status_proto_init() {
+	if File_prover_server_statuspb_status_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_prover_server_statuspb_status_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_prover_server_statuspb_status_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_prover_server_statuspb_status_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_prover_server_statuspb_status_proto_goTypes,
+		DependencyIndexes: file_prover_server_statuspb_status_proto_depIdxs,
+		MessageInfos:      file_prover_server_statuspb_status_proto_msgTypes,
+	}.Build()
+	File_prover_server_statuspb_status_proto = out.File
+	file_prover_server_statuspb_status_proto_rawDesc = nil
+	file_prover_server_statuspb_status_proto_goTypes = nil
+	file_prover_server_statuspb_status_proto_depIdxs = nil
+}
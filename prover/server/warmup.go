@@ -0,0 +1,53 @@
+package server
+
+import (
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// warmupGate refuses the proof assignments after the prover starts, until the L2 execution engine's
+// sync lag falls within the given maximum lag for the first time, since the prover can't produce
+// correct proofs before catching up.
+type warmupGate struct {
+	maxSyncLag uint64
+	warmedUp   atomic.Bool
+}
+
+// newWarmupGate creates a new warmupGate instance, the gate is open from the start if the given
+// maximum sync lag is nil, which means no warmup.
+func newWarmupGate(maxSyncLag *uint64) *warmupGate {
+	gate := new(warmupGate)
+	if maxSyncLag == nil {
+		gate.warmedUp.Store(true)
+		return gate
+	}
+
+	gate.maxSyncLag = *maxSyncLag
+	return gate
+}
+
+// update opens the gate if the given sync lag is within the maximum lag, the gate is never closed again.
+func (g *warmupGate) update(lag uint64) {
+	if g.warmedUp.Load() || lag > g.maxSyncLag {
+		return
+	}
+
+	if g.warmedUp.CompareAndSwap(false, true) {
+		log.Info("Prover warmed up, start accepting proof assignments", "syncLag", lag, "maxSyncLag", g.maxSyncLag)
+	}
+}
+
+// UpdateSyncLag reports the L2 execution engine's latest sync lag to the server, the server starts
+// accepting proof assignments once the lag falls within the warmup maximum sync lag.
+func (s *ProverServer) UpdateSyncLag(lag uint64) {
+	if s == nil {
+		return
+	}
+	s.warmup.update(lag)
+}
+
+// WarmedUp returns whether the server has finished its warmup, and accepts proof assignments.
+func (s *ProverServer) WarmedUp() bool {
+	return s.warmup.warmedUp.Load()
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignmentWarmup(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	maxSyncLag := uint64(10)
+	srv, err := New(&NewProverServerOpts{
+		ProverPrivateKey:     key,
+		MinOptimisticTierFee: big.NewInt(1),
+		MinSgxTierFee:        big.NewInt(2),
+		MinSgxAndZkVMTierFee: big.NewInt(3),
+		MaxExpiry:            time.Hour,
+		WarmupMaxSyncLag:     &maxSyncLag,
+	})
+	require.Nil(t, err)
+
+	createAssignment := func() int {
+		req := httptest.NewRequest(http.MethodPost, "/assignment", strings.NewReader("{}"))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		srv.echo.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// Refused during the warmup.
+	require.False(t, srv.WarmedUp())
+	require.Equal(t, http.StatusServiceUnavailable, createAssignment())
+
+	srv.UpdateSyncLag(maxSyncLag + 1)
+	require.False(t, srv.WarmedUp())
+	require.Equal(t, http.StatusServiceUnavailable, createAssignment())
+
+	// Accepted after the warmup, the empty request passes the gate and fails the request validation.
+	srv.UpdateSyncLag(maxSyncLag)
+	require.True(t, srv.WarmedUp())
+	require.Equal(t, http.StatusUnprocessableEntity, createAssignment())
+
+	// Never refused again once warmed up.
+	srv.UpdateSyncLag(maxSyncLag + 1)
+	require.True(t, srv.WarmedUp())
+	require.Equal(t, http.StatusUnprocessableEntity, createAssignment())
+}
+
+func TestAssignmentNoWarmup(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+
+	srv, err := New(&NewProverServerOpts{
+		ProverPrivateKey:     key,
+		MinOptimisticTierFee: big.NewInt(1),
+		MinSgxTierFee:        big.NewInt(2),
+		MinSgxAndZkVMTierFee: big.NewInt(3),
+		MaxExpiry:            time.Hour,
+	})
+	require.Nil(t, err)
+	require.True(t, srv.WarmedUp())
+}
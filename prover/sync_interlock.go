@@ -0,0 +1,121 @@
+package prover
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-client/internal/metrics"
+)
+
+// syncInterlockCheckInterval is the interval to check the L2 execution engine's sync lag.
+var syncInterlockCheckInterval = 12 * time.Second
+
+// syncInterlock pauses the proof production when the local L2 execution engine falls too far behind
+// the protocol state, since the proofs are generated based on the local L2 state.
+type syncInterlock struct {
+	mu     sync.Mutex
+	maxLag uint64
+	// Closed when the proof production resumes, nil if not paused
+	resumeCh chan struct{}
+}
+
+// newSyncInterlock creates a new syncInterlock instance, returns nil if the given maximum sync lag
+// is zero, which means no limit.
+func newSyncInterlock(maxLag uint64) *syncInterlock {
+	if maxLag == 0 {
+		return nil
+	}
+
+	return &syncInterlock{maxLag: maxLag}
+}
+
+// update updates the interlock state based on the given sync lag, returns whether the proof production
+// is paused.
+func (s *syncInterlock) update(lag uint64) bool {
+	if s == nil {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case lag > s.maxLag && s.resumeCh == nil:
+		log.Warn("L2 execution engine falls behind, pause proof production", "lag", lag, "maxLag", s.maxLag)
+		s.resumeCh = make(chan struct{})
+		metrics.ProverSyncInterlockGauge.Update(1)
+	case lag <= s.maxLag && s.resumeCh != nil:
+		log.Info("L2 execution engine catches up, resume proof production", "lag", lag, "maxLag", s.maxLag)
+		close(s.resumeCh)
+		s.resumeCh = nil
+		metrics.ProverSyncInterlockGauge.Update(0)
+	}
+
+	return s.resumeCh != nil
+}
+
+// wait blocks until the proof production is not paused.
+func (s *syncInterlock) wait(ctx context.Context) error {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	resumeCh := s.resumeCh
+	s.mu.Unlock()
+
+	if resumeCh == nil {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-resumeCh:
+		return nil
+	}
+}
+
+// syncInterlockLoop keeps checking the L2 execution engine's sync lag, and updates the sync interlock.
+func (p *Prover) syncInterlockLoop() {
+	p.wg.Add(1)
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(syncInterlockCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.checkSyncLag(p.ctx); err != nil {
+			log.Warn("Failed to check L2 execution engine sync lag", "error", err)
+		}
+
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkSyncLag fetches the L2 execution engine's sync progress, and updates the sync interlock and the
+// prover server's assignment warmup.
+func (p *Prover) checkSyncLag(ctx context.Context) error {
+	progress, err := p.rpc.L2ExecutionEngineSyncProgress(ctx)
+	if err != nil {
+		return err
+	}
+
+	var lag uint64
+	if progress.CurrentBlockID.Cmp(progress.HighestBlockID) < 0 {
+		lag = progress.HighestBlockID.Uint64() - progress.CurrentBlockID.Uint64()
+	}
+	metrics.ProverSyncLagGauge.Update(int64(lag))
+
+	p.syncInterlock.update(lag)
+	p.server.UpdateSyncLag(lag)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package prover
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncInterlock(t *testing.T) {
+	// No limit.
+	var noLimit = newSyncInterlock(0)
+	require.Nil(t, noLimit)
+	require.False(t, noLimit.update(1000))
+	require.Nil(t, noLimit.wait(context.Background()))
+
+	interlock := newSyncInterlock(10)
+	require.False(t, interlock.update(10))
+	require.Nil(t, interlock.wait(context.Background()))
+
+	// Sync falls behind, proving pauses.
+	require.True(t, interlock.update(11))
+	require.True(t, interlock.update(100))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, interlock.wait(ctx), context.DeadlineExceeded)
+
+	// Sync catches up, proving resumes.
+	resumed := make(chan error)
+	go func() { resumed <- interlock.wait(context.Background()) }()
+
+	select {
+	case <-resumed:
+		t.Fatal("proving resumed before sync catches up")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.False(t, interlock.update(5))
+
+	select {
+	case err := <-resumed:
+		require.Nil(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("proving not resumed after sync catches up")
+	}
+	require.Nil(t, interlock.wait(context.Background()))
+}